@@ -10,7 +10,7 @@ import (
 func main() {
 	controller := cli.NewController()
 	if err := controller.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		fmt.Fprintln(os.Stderr, controller.FormatError(err))
+		os.Exit(cli.ExitCode(err))
 	}
 }
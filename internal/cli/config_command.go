@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"ui-elf/internal/config"
+	"ui-elf/internal/i18n"
+	"ui-elf/internal/registry"
+	"ui-elf/internal/types"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// effectiveConfig is the output of config show --effective
+type effectiveConfig struct {
+	Sources  []string                           `yaml:"sources"`
+	Config   *config.Config                     `yaml:"config"`
+	Registry *registry.ComponentMappingRegistry `yaml:"registry"`
+}
+
+// setupConfigCommand adds the config subcommand, whose show subcommand prints the
+// configuration layers and, with --effective, the configuration they resolve to
+func (c *Controller) setupConfigCommand() {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the configuration of ui-elf",
+		Args:  cobra.NoArgs,
+	}
+
+	showCmd := &cobra.Command{
+		Use:   "show [flags]",
+		Short: "Show the configuration layers, or the effective configuration with --effective",
+		Long: `Show the configuration layers merged for a scan, lowest precedence first:
+
+  1. the built-in component mappings and flag defaults
+  2. the global config.yaml and registry.yaml in $XDG_CONFIG_HOME/ui-elf (~/.config/ui-elf)
+  3. the .ui-elf.yaml and ui-elf.registry.yaml of the scanned directory (or --config)
+  4. the --registry sources, in the order given
+  5. the command-line flags, including --map
+
+With --effective, the merged configuration, scan options and component
+mappings are printed as YAML. Scan flags given to config show are applied,
+so the output is the configuration a scan with the same flags would use.`,
+		Example: `  # List the configuration files found for the current directory
+  ui-elf config show
+
+  # Print the configuration a scan of ./app with --registry would use
+  ui-elf config show --effective --directory ./app --registry org.yaml`,
+		Args: cobra.NoArgs,
+		RunE: c.showConfig,
+	}
+
+	showCmd.Flags().Bool("effective", false, "Print the merged configuration and component mappings as YAML")
+	addScanFlags(showCmd)
+	addOutputFlags(showCmd)
+
+	configCmd.AddCommand(showCmd)
+	c.rootCmd.AddCommand(configCmd)
+}
+
+// showConfig prints the configuration layers, or the effective configuration
+func (c *Controller) showConfig(cmd *cobra.Command, args []string) error {
+	effective, err := cmd.Flags().GetBool("effective")
+	if err != nil {
+		return fmt.Errorf("failed to parse effective flag: %w", err)
+	}
+
+	options, err := c.parseFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if !effective {
+		fmt.Fprint(cmd.OutOrStdout(), formatLayers(cmd, options))
+		return nil
+	}
+
+	cfg, err := loadConfig(options)
+	if err != nil {
+		return err
+	}
+	applyScanConfig(cmd, options, cfg.Scan)
+
+	componentRegistry, err := loadRegistry(options, cfg, c.loc)
+	if err != nil {
+		return err
+	}
+
+	return writeEffectiveConfig(cmd.OutOrStdout(), options, cfg, componentRegistry, c.loc)
+}
+
+// formatLayers lists the configuration layers, lowest precedence first
+func formatLayers(cmd *cobra.Command, options *types.CLIOptions) string {
+	var sb strings.Builder
+
+	sb.WriteString("built-in: component mappings and flag defaults\n")
+
+	globalDir := config.GlobalDir()
+	fmt.Fprintf(&sb, "global config: %s\n", orNotFound(config.FindGlobal()))
+	fmt.Fprintf(&sb, "global registry: %s\n", orNotFound(registry.FindGlobalFile(globalDir)))
+
+	projectConfig := options.ConfigFile
+	if projectConfig == "" {
+		projectConfig = config.Find(options.Directory)
+	}
+	fmt.Fprintf(&sb, "project config: %s\n", orNotFound(projectConfig))
+	fmt.Fprintf(&sb, "project registry: %s\n", orNotFound(registry.FindFile(options.Directory)))
+
+	for _, source := range options.Registries {
+		fmt.Fprintf(&sb, "--registry: %s\n", source)
+	}
+
+	var flags []string
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if flag.Name != "effective" {
+			flags = append(flags, "--"+flag.Name)
+		}
+	})
+	if len(flags) > 0 {
+		fmt.Fprintf(&sb, "command line: %s\n", strings.Join(flags, " "))
+	}
+	return sb.String()
+}
+
+// orNotFound returns path, or "not found" if it is empty
+func orNotFound(path string) string {
+	if path == "" {
+		return "not found"
+	}
+	return path
+}
+
+// writeEffectiveConfig prints the sources, merged configuration with the resolved scan
+// options and the component mappings as YAML
+func writeEffectiveConfig(w io.Writer, options *types.CLIOptions, cfg *config.Config, componentRegistry *registry.ComponentMappingRegistry, loc *i18n.Localizer) error {
+	sources := append(append([]string{}, cfg.Sources...), registrySources(options)...)
+
+	effectiveCfg := *cfg
+	effectiveCfg.Scan = config.ScanConfig{
+		ComponentName:    options.ComponentNames,
+		Filter:           options.Filter,
+		Exclude:          options.Exclude,
+		Registries:       options.Registries,
+		Map:              options.Mappings,
+		MaxDepth:         &options.MaxDepth,
+		Concurrency:      &options.Concurrency,
+		PathStyle:        &options.PathStyle,
+		Sort:             &options.Sort,
+		Desc:             &options.Desc,
+		Layout:           &options.Layout,
+		Hyperlinks:       &options.Hyperlinks,
+		LinkTemplate:     &options.LinkTemplate,
+		Summary:          &options.Summary,
+		ByDirectory:      &options.ByDirectory,
+		DirectoryDepth:   &options.DirectoryDepth,
+		Top:              &options.Top,
+		Compress:         &options.Compress,
+		NoIgnore:         &options.NoIgnore,
+		Output:           &options.OutputFormat,
+		Profile:          &options.Profile,
+		ParserEngine:     &options.ParserEngine,
+		ExcludeStories:   &options.ExcludeStories,
+		IncludeTests:     &options.IncludeTests,
+		IncludeMarkdown:  &options.IncludeMarkdown,
+		IncludeAlpine:    &options.IncludeAlpine,
+		IncludeGenerated: &options.IncludeGenerated,
+		WithProps:        &options.WithProps,
+		Snippet:          &options.Snippet,
+		Context:          &options.ContextLines,
+		CountDuplicates:  &options.CountDuplicates,
+		AllLibraries:     &options.AllLibraries,
+		Libraries:        options.Libraries,
+		Match:            &options.MatchMode,
+		Ext:              options.Extensions,
+		FailIfFound:      &options.FailIfFound,
+		MaxCount:         &options.MaxCount,
+		MinCount:         &options.MinCount,
+		Badge:            &options.Badge,
+		BadgeLabel:       &options.BadgeLabel,
+	}
+	if options.ComponentType != "" {
+		effectiveCfg.Scan.ComponentType = &options.ComponentType
+	}
+	if options.OutputFile != "" {
+		effectiveCfg.Scan.OutputFile = &options.OutputFile
+	}
+	if options.GroupBy != "" {
+		effectiveCfg.Scan.GroupBy = &options.GroupBy
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(effectiveConfig{Sources: sources, Config: &effectiveCfg, Registry: componentRegistry}); err != nil {
+		return loc.Errorf("failed to encode configuration: %w", err)
+	}
+	return encoder.Close()
+}
@@ -3,10 +3,20 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"log/slog"
+	"maps"
 	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
 
+	"ui-elf/internal/config"
 	"ui-elf/internal/discovery"
+	"ui-elf/internal/i18n"
 	"ui-elf/internal/output"
+	"ui-elf/internal/project"
 	"ui-elf/internal/registry"
 	"ui-elf/internal/scanner"
 	"ui-elf/internal/types"
@@ -17,19 +27,29 @@ import (
 // Controller orchestrates the CLI operations
 type Controller struct {
 	rootCmd *cobra.Command
+	logger  *slog.Logger
+	loc     *i18n.Localizer
 }
 
 // NewController creates a new CLI controller with cobra configuration
 func NewController() *Controller {
 	c := &Controller{}
 	c.setupRootCommand()
+	c.setupListTypesCommand()
+	c.setupConfigCommand()
+	c.setupRegistryCommand()
+	c.setupInitCommand()
+	c.setupDocsCommand()
+	c.setupDiffCommand()
+	c.setupReportCommand()
+	c.setupSchemaCommand()
 	return c
 }
 
 // setupRootCommand configures the root cobra command with flags and help text
 func (c *Controller) setupRootCommand() {
 	c.rootCmd = &cobra.Command{
-		Use:   "ui-elf [flags]",
+		Use:   "ui-elf [flags] [directory...]",
 		Short: "Scan Vue.js and React codebases for specific component types",
 		Long: `UI Elf scans your codebase to locate specific component types
 (forms, buttons, dialogs, and custom components) in Vue.js and React projects.
@@ -45,56 +65,232 @@ where components are used and providing usage statistics.`,
   # Scan for custom component with directory filter
   ui-elf --component-type custom --directory . --filter src/components,src/views
 
+  # Find the usages of specific components of the project
+  ui-elf --component-type custom --component-name MyWidget,DataGrid --directory .
+
   # Scan for dialogs with both terminal and JSON output
-  ui-elf --component-type dialog --directory . --output both`,
+  ui-elf --component-type dialog --directory . --output both
+
+  # Scan several roots of a monorepo, with a summary per root
+  ui-elf --component-type dialog src/ packages/ui/ apps/admin/
+
+  # Inventory every component of the codebase, counted per type and component
+  ui-elf --component-type all --directory .
+
+  # Report deprecated components of the registry file with their replacement
+  ui-elf --component-type deprecated --directory .
+
+  # List the valid component types and the component names behind them
+  ui-elf list-types`,
+		Args: cobra.ArbitraryArgs,
 		RunE: c.run,
+		// main prints the error, with the exit code of the failure
+		SilenceErrors: true,
+		// Every command speaks the language of --lang or the locale
+		PersistentPreRunE: c.setLanguage,
 	}
 
 	// Define flags
-	c.rootCmd.Flags().StringP("component-type", "t", "", "Component type to search for (form, button, dialog, custom) [required]")
-	c.rootCmd.Flags().StringP("directory", "d", ".", "Directory to scan (default: current directory)")
-	c.rootCmd.Flags().StringSliceP("filter", "f", []string{}, "Comma-separated list of directories to include (e.g., src/components,src/views)")
-	c.rootCmd.Flags().StringP("output", "o", "terminal", "Output format: terminal, json, or both (default: terminal)")
+	addComponentFlags(c.rootCmd)
+	c.rootCmd.Flags().Bool("stdin", false, "Scan exactly the files whose paths are read from stdin, one per line, instead of discovering files (e.g. git diff --name-only | ui-elf -t dialog --stdin)")
+	c.rootCmd.Flags().Bool("stdin0", false, "Like --stdin, with NUL-delimited paths (e.g. find -print0, git diff -z)")
+	c.rootCmd.MarkFlagsMutuallyExclusive("stdin", "stdin0")
+	c.rootCmd.Flags().Bool("list-files", false, "Only discover files: print the files that would be scanned and the paths skipped with the reason, to debug --filter, --exclude, --ext and ignore files")
+	addScanFlags(c.rootCmd)
+	addOutputFlags(c.rootCmd)
 
-	// Mark required flags
-	if err := c.rootCmd.MarkFlagRequired("component-type"); err != nil {
-		fmt.Fprintf(os.Stderr, "Error marking flag required: %v\n", err)
-		os.Exit(1)
-	}
+	c.rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Log per-file progress and the files skipped or unreadable to stderr")
+	c.rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Print nothing but the results, not even warnings")
+	c.rootCmd.PersistentFlags().String("log-format", "text", "Format of the logs on stderr: text or json")
+	c.rootCmd.PersistentFlags().String("lang", "", "Language of the messages and terminal output: "+strings.Join(i18n.Languages, ", ")+" (default: from $LC_ALL, $LC_MESSAGES or $LANG, else en)")
+	c.rootCmd.MarkFlagsMutuallyExclusive("verbose", "quiet")
+}
+
+// addComponentFlags defines the flags selecting the components searched, for the commands scanning
+func addComponentFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("component-type", "t", "", "Component type to search for (e.g. form, button, dialog, modal, input, table; custom for imported components, or those named with --component-name; deprecated; all for a full inventory; or a type of the registry file) [required unless set in the configuration file]")
+	cmd.Flags().StringSlice("component-name", nil, "Components to search with --component-type custom, by name, whether imported or not (e.g. MyWidget, or MyWidget,my-dialog; kebab-case and PascalCase match each other)")
+}
+
+// addScanFlags defines the flags configuring a scan, shared by the commands resolving scan options
+func addScanFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("directory", "d", ".", "Directory to scan (default: current directory)")
+	cmd.Flags().StringSliceP("filter", "f", []string{}, "Comma-separated list of directories to include, relative to the scanned directory and glob-aware (e.g., src/components,src/views or 'packages/*/src/components')")
+	cmd.Flags().StringSlice("ext", nil, "File extensions to scan, replacing the defaults (e.g. .vue,.jsx,.tsx,.svelte,.js); '+.ext' adds to the defaults and '.svelte=.vue' parses .svelte files like .vue files")
+	cmd.Flags().StringArray("exclude", nil, "Additional path pattern not scanned, glob-aware (e.g. '**/legacy/**', '*.gen.ts'); '!pattern' removes a default exclusion such as '!test' (repeatable)")
+	cmd.Flags().Int("concurrency", runtime.NumCPU(), "Number of files parsed in parallel (default: number of CPUs)")
+	cmd.Flags().Int("max-depth", 0, "Directory levels scanned below each scanned directory, 1 for its files only (0: no limit)")
+	cmd.Flags().String("path-style", scanner.PathStyleRelative, "File paths of matches: relative (to the working directory), absolute, or repo-root (relative to the git repository root)")
+	cmd.Flags().Bool("no-ignore", false, "Also scan the files ignored by .gitignore and .ui-elfignore files")
+	cmd.Flags().Bool("exclude-stories", false, "Exclude Storybook stories files (*.stories.tsx, *.stories.jsx, *.stories.vue, ...)")
+	cmd.Flags().Bool("include-tests", false, "Also scan test files (*.test.*, *.spec.*, test, tests and __tests__ directories), excluded by default")
+	cmd.Flags().Bool("include-markdown", false, "Also scan fenced jsx/tsx/vue/html code blocks in Markdown (.md) files")
+	cmd.Flags().Bool("include-alpine", false, "Also scan HTML files for Alpine.js widgets (x-data, x-component)")
+	cmd.Flags().String("profile", "web", "Platform profile: web or react-native (react-native ignores View/Text primitives)")
+	cmd.Flags().Bool("with-props", false, "Capture the props/attributes of matched components")
+	cmd.Flags().Bool("snippet", false, "Include the source line of each match")
+	cmd.Flags().Int("context", 0, "Lines of context to include around each snippet (implies --snippet)")
+	cmd.Flags().Bool("include-generated", false, "Also scan minified and generated files (*.min.*, *.generated.*, bundles, @generated banners)")
+	cmd.Flags().Bool("count-duplicates", false, "Count every occurrence of a component repeated on the same line")
+	cmd.Flags().Bool("all-libraries", false, "Match the components of all known libraries, not only those installed per package.json")
+	cmd.Flags().StringSlice("library", []string{}, "Only report components of these libraries (e.g. quasar, or quasar,vuetify)")
+	cmd.Flags().StringArray("map", nil, "Additional component names of a type for this run, as type=Name[,Name] (repeatable, e.g. --map form=AppForm,XForm)")
+	cmd.Flags().StringArray("registry", nil, "Registry with additional component mappings: file path, http(s) URL or cmd:<command> (repeatable; takes precedence over registry files)")
+	cmd.Flags().String("config", "", "Path of the configuration file (default: .ui-elf.yaml, .ui-elf.yml, .ui-elf.json or the same names without dot in the scanned directory)")
+	cmd.Flags().String("parser-engine", scanner.EngineTokenizer, "JSX parser engine: tokenizer (heuristic JavaScript/TypeScript lexer) or regex (legacy fallback)")
+	cmd.Flags().Bool("explain", false, "Annotate each match with the registry rule that matched it (type, library, pattern) and summarize rule hits")
+	cmd.Flags().String("match", registry.MatchExact, "How component names are compared to the patterns of a type: exact, prefix (q-btn-dropdown for q-btn) or fuzzy (IconButton for Button)")
+}
+
+// addOutputFlags defines the flags writing and gating the results of a scan, for the commands printing them
+func addOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", "terminal", "Output format: terminal, json, both, stdout (JSON on stdout, messages on stderr, for piping into jq), xml or xlsx (Excel workbook with a summary and a matches sheet) (default: terminal)")
+	cmd.Flags().String("sort", output.SortByPath, "Order of the matches: path, line, component or count (usages of the component)")
+	cmd.Flags().Bool("desc", false, "Sort the matches in descending order")
+	cmd.Flags().String("group-by", "", "Group the matches with their counts: file, component, directory or library")
+	cmd.Flags().String("layout", output.LayoutFlat, "Terminal layout of the matches: flat (one line per match) or file (matches listed under each file with its count)")
+	cmd.Flags().String("hyperlinks", output.HyperlinksAuto, "Link the matches of the terminal output to their files with OSC 8 hyperlinks: auto (with --link-template, on a terminal), always or never")
+	cmd.Flags().String("link-template", "", "URL of the hyperlinked matches, with {path} (absolute path), {relpath} (relative to the repository root) and {line}, e.g. vscode://file{path}:{line} (default: "+output.DefaultLinkTemplate+")")
+	cmd.Flags().Bool("summary", false, "Print only aggregate statistics: total, unique components with their counts, files affected and top directories")
+	cmd.Flags().Bool("by-directory", false, "Add the usages and files with matches per directory to the results")
+	cmd.Flags().Int("directory-depth", 0, "Path segments the --by-directory counts are rolled up to, e.g. 2 counts src/pages/admin as src/pages (implies --by-directory; 0: full directory)")
+	cmd.Flags().Bool("compress", false, "Write the JSON or XML results gzip-compressed, to the output file with "+output.CompressedExtension+" appended")
+	cmd.Flags().Int("top", 0, "Add the N most used components and the N files with the most usages, ranked, to the results (0: no ranking)")
+	cmd.Flags().String("output-file", "", "File the JSON, XML or Excel results are written to with --output json, both, xml or xlsx, or - for stdout (default: "+output.DefaultJSONPath+", "+output.DefaultXMLPath+" or "+output.DefaultXLSXPath+")")
+	cmd.Flags().Bool("fail-if-found", false, "Exit with code 2 if any component is found, e.g. to gate deprecated components in CI")
+	cmd.Flags().Int("max-count", -1, "Exit with code 2 if more components are found (-1: no limit)")
+	cmd.Flags().Int("min-count", 0, "Exit with code 2 if fewer components are found, e.g. when expected components disappear")
+	cmd.Flags().String("badge", "", "Also write the component count as a shields.io endpoint badge JSON file (e.g. badge.json), green or red with the CI gating flags")
+	cmd.Flags().String("badge-label", "", "Label of the --badge badge, e.g. \"deprecated dialogs\" (default: \"<component type> components\")")
 }
 
 // run executes the main CLI logic
 func (c *Controller) run(cmd *cobra.Command, args []string) error {
-	// Parse flags into CLIOptions
-	options, err := c.parseFlags(cmd)
+	options, cfg, err := c.loadOptions(cmd, args)
 	if err != nil {
 		return err
 	}
 
-	// Validate options
-	if err := c.validateOptions(options); err != nil {
-		return err
+	// List the discovered files without scanning them; no component type is needed
+	if options.ListFiles {
+		return c.listFiles(cmd.OutOrStdout(), options)
 	}
 
-	// Execute the scan
-	result, err := c.executeScan(options)
+	result, err := c.scan(cmd, options, cfg)
 	if err != nil {
-		return fmt.Errorf("scan failed: %w", err)
+		return err
 	}
 
 	// Format and display output
 	if err := c.displayOutput(result, options); err != nil {
-		return fmt.Errorf("failed to display output: %w", err)
+		return c.loc.Errorf("failed to display output: %w", err)
+	}
+
+	// Fail CI gates on the component count, after the badge shows the failure; the usage is no help here
+	thresholdErr := checkThresholds(result, options, c.loc)
+	if options.Badge != "" {
+		if err := c.writeBadge(result, options, thresholdErr == nil); err != nil {
+			return err
+		}
+	}
+	if thresholdErr != nil {
+		cmd.SilenceUsage = true
+		return thresholdErr
 	}
 
 	return nil
 }
 
+// loadOptions resolves the scan options of a command from its flags, its arguments and the
+// configuration files, and creates the logger
+func (c *Controller) loadOptions(cmd *cobra.Command, args []string) (*types.CLIOptions, *config.Config, error) {
+	// Parse flags into CLIOptions
+	options, err := c.parseFlags(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Scan the directories given as arguments, if any, instead of --directory
+	if err := c.applyDirectoryArgs(cmd, options, args); err != nil {
+		return nil, nil, err
+	}
+
+	// Load the global and project configuration, providing defaults for the flags not given
+	cfg, err := loadConfig(options)
+	if err != nil {
+		return nil, nil, err
+	}
+	applyScanConfig(cmd, options, cfg.Scan)
+
+	// Log to stderr at the verbosity of --verbose and --quiet
+	c.logger, err = newLogger(cmd.ErrOrStderr(), options, c.loc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return options, cfg, nil
+}
+
+// scan builds the component registry, asks for a missing component type on a terminal,
+// validates the options and scans
+func (c *Controller) scan(cmd *cobra.Command, options *types.CLIOptions, cfg *config.Config) (*types.ScanResult, error) {
+	// Build the component registry, including the mappings of registry files and the type groups
+	componentRegistry, err := loadRegistry(options, cfg, c.loc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ask for a missing component type on a terminal; scripts and CI get the error instead
+	if options.ComponentType == "" && !options.Stdin && !options.Stdin0 && isTerminal(cmd.InOrStdin()) && isTerminal(cmd.ErrOrStderr()) {
+		choices := componentTypes(componentRegistry)
+		if len(componentRegistry.Deprecations()) == 0 {
+			choices = slices.DeleteFunc(choices, func(choice string) bool { return choice == types.ComponentTypeDeprecated })
+		}
+		options.ComponentType, err = promptChoice(cmd.InOrStdin(), cmd.ErrOrStderr(), c.loc, c.loc.Sprintf("Component type to search for:"), choices)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate options
+	if err := c.validateOptions(options, componentRegistry); err != nil {
+		return nil, err
+	}
+
+	// Execute the scan
+	result, err := c.executeScan(options, componentRegistry, cfg)
+	if err != nil {
+		return nil, c.loc.Errorf("scan failed: %w", err)
+	}
+	return result, nil
+}
+
 // parseFlags extracts flag values into CLIOptions struct
+// The component type and the stdin flags are only read from commands defining them
 func (c *Controller) parseFlags(cmd *cobra.Command) (*types.CLIOptions, error) {
-	componentType, err := cmd.Flags().GetString("component-type")
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse component-type flag: %w", err)
+	var componentType string
+	var componentNames []string
+	if cmd.Flags().Lookup("component-type") != nil {
+		var err error
+		if componentType, err = cmd.Flags().GetString("component-type"); err != nil {
+			return nil, fmt.Errorf("failed to parse component-type flag: %w", err)
+		}
+		if componentNames, err = cmd.Flags().GetStringSlice("component-name"); err != nil {
+			return nil, fmt.Errorf("failed to parse component-name flag: %w", err)
+		}
+	}
+
+	var stdin, stdin0, listFiles bool
+	if cmd.Flags().Lookup("stdin") != nil {
+		var err error
+		if stdin, err = cmd.Flags().GetBool("stdin"); err != nil {
+			return nil, fmt.Errorf("failed to parse stdin flag: %w", err)
+		}
+		if stdin0, err = cmd.Flags().GetBool("stdin0"); err != nil {
+			return nil, fmt.Errorf("failed to parse stdin0 flag: %w", err)
+		}
+		if listFiles, err = cmd.Flags().GetBool("list-files"); err != nil {
+			return nil, fmt.Errorf("failed to parse list-files flag: %w", err)
+		}
 	}
 
 	directory, err := cmd.Flags().GetString("directory")
@@ -107,30 +303,483 @@ func (c *Controller) parseFlags(cmd *cobra.Command) (*types.CLIOptions, error) {
 		return nil, fmt.Errorf("failed to parse filter flag: %w", err)
 	}
 
-	output, err := cmd.Flags().GetString("output")
+	exclude, err := cmd.Flags().GetStringArray("exclude")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse exclude flag: %w", err)
+	}
+
+	extensions, err := cmd.Flags().GetStringSlice("ext")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ext flag: %w", err)
+	}
+
+	concurrency, err := cmd.Flags().GetInt("concurrency")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse concurrency flag: %w", err)
+	}
+
+	maxDepth, err := cmd.Flags().GetInt("max-depth")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse max-depth flag: %w", err)
+	}
+
+	pathStyle, err := cmd.Flags().GetString("path-style")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path-style flag: %w", err)
+	}
+
+	noIgnore, err := cmd.Flags().GetBool("no-ignore")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse no-ignore flag: %w", err)
+	}
+
+	// Commands without the output flags, such as report, read their defaults
+	outputFlags := cmd.Flags()
+	if outputFlags.Lookup("output") == nil {
+		defaults := &cobra.Command{}
+		addOutputFlags(defaults)
+		outputFlags = defaults.Flags()
+	}
+	output, err := outputFlags.GetString("output")
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse output flag: %w", err)
 	}
 
+	outputFile, err := outputFlags.GetString("output-file")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse output-file flag: %w", err)
+	}
+
+	compress, err := outputFlags.GetBool("compress")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse compress flag: %w", err)
+	}
+
+	sortBy, err := outputFlags.GetString("sort")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sort flag: %w", err)
+	}
+
+	desc, err := outputFlags.GetBool("desc")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse desc flag: %w", err)
+	}
+
+	groupBy, err := outputFlags.GetString("group-by")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse group-by flag: %w", err)
+	}
+
+	layout, err := outputFlags.GetString("layout")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse layout flag: %w", err)
+	}
+
+	hyperlinks, err := outputFlags.GetString("hyperlinks")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse hyperlinks flag: %w", err)
+	}
+
+	linkTemplate, err := outputFlags.GetString("link-template")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse link-template flag: %w", err)
+	}
+
+	summary, err := outputFlags.GetBool("summary")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse summary flag: %w", err)
+	}
+
+	byDirectory, err := outputFlags.GetBool("by-directory")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse by-directory flag: %w", err)
+	}
+
+	directoryDepth, err := outputFlags.GetInt("directory-depth")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse directory-depth flag: %w", err)
+	}
+
+	top, err := outputFlags.GetInt("top")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse top flag: %w", err)
+	}
+
+	excludeStories, err := cmd.Flags().GetBool("exclude-stories")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse exclude-stories flag: %w", err)
+	}
+
+	includeTests, err := cmd.Flags().GetBool("include-tests")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse include-tests flag: %w", err)
+	}
+
+	includeMarkdown, err := cmd.Flags().GetBool("include-markdown")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse include-markdown flag: %w", err)
+	}
+
+	profile, err := cmd.Flags().GetString("profile")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse profile flag: %w", err)
+	}
+
+	includeAlpine, err := cmd.Flags().GetBool("include-alpine")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse include-alpine flag: %w", err)
+	}
+
+	parserEngine, err := cmd.Flags().GetString("parser-engine")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse parser-engine flag: %w", err)
+	}
+
+	withProps, err := cmd.Flags().GetBool("with-props")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse with-props flag: %w", err)
+	}
+
+	snippet, err := cmd.Flags().GetBool("snippet")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snippet flag: %w", err)
+	}
+
+	contextLines, err := cmd.Flags().GetInt("context")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse context flag: %w", err)
+	}
+
+	includeGenerated, err := cmd.Flags().GetBool("include-generated")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse include-generated flag: %w", err)
+	}
+
+	configFile, err := cmd.Flags().GetString("config")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config flag: %w", err)
+	}
+
+	countDuplicates, err := cmd.Flags().GetBool("count-duplicates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse count-duplicates flag: %w", err)
+	}
+
+	registries, err := cmd.Flags().GetStringArray("registry")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse registry flag: %w", err)
+	}
+
+	allLibraries, err := cmd.Flags().GetBool("all-libraries")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse all-libraries flag: %w", err)
+	}
+
+	mapValues, err := cmd.Flags().GetStringArray("map")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse map flag: %w", err)
+	}
+	mappings, err := parseMappings(mapValues, c.loc)
+	if err != nil {
+		return nil, err
+	}
+
+	libraries, err := cmd.Flags().GetStringSlice("library")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse library flag: %w", err)
+	}
+
+	matchMode, err := cmd.Flags().GetString("match")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse match flag: %w", err)
+	}
+
+	explain, err := cmd.Flags().GetBool("explain")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse explain flag: %w", err)
+	}
+
+	failIfFound, err := outputFlags.GetBool("fail-if-found")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fail-if-found flag: %w", err)
+	}
+
+	maxCount, err := outputFlags.GetInt("max-count")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse max-count flag: %w", err)
+	}
+
+	minCount, err := outputFlags.GetInt("min-count")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse min-count flag: %w", err)
+	}
+
+	badge, err := outputFlags.GetString("badge")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse badge flag: %w", err)
+	}
+
+	badgeLabel, err := outputFlags.GetString("badge-label")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse badge-label flag: %w", err)
+	}
+
+	verbose, err := cmd.Flags().GetBool("verbose")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse verbose flag: %w", err)
+	}
+
+	quiet, err := cmd.Flags().GetBool("quiet")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse quiet flag: %w", err)
+	}
+
+	logFormat, err := cmd.Flags().GetString("log-format")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse log-format flag: %w", err)
+	}
+
 	return &types.CLIOptions{
-		ComponentType: componentType,
-		Directory:     directory,
-		Filter:        filter,
-		OutputFormat:  output,
+		ComponentType:    componentType,
+		ComponentNames:   componentNames,
+		Directory:        directory,
+		Filter:           filter,
+		Exclude:          exclude,
+		MaxDepth:         maxDepth,
+		Concurrency:      concurrency,
+		PathStyle:        pathStyle,
+		NoIgnore:         noIgnore,
+		OutputFormat:     output,
+		OutputFile:       outputFile,
+		Compress:         compress,
+		Sort:             sortBy,
+		Desc:             desc,
+		GroupBy:          groupBy,
+		Layout:           layout,
+		Hyperlinks:       hyperlinks,
+		LinkTemplate:     linkTemplate,
+		Summary:          summary,
+		ByDirectory:      byDirectory || directoryDepth > 0,
+		DirectoryDepth:   directoryDepth,
+		Top:              top,
+		ExcludeStories:   excludeStories,
+		IncludeTests:     includeTests,
+		IncludeMarkdown:  includeMarkdown,
+		Profile:          profile,
+		IncludeAlpine:    includeAlpine,
+		ParserEngine:     parserEngine,
+		WithProps:        withProps,
+		Snippet:          snippet || contextLines > 0,
+		ContextLines:     contextLines,
+		IncludeGenerated: includeGenerated,
+		ConfigFile:       configFile,
+		CountDuplicates:  countDuplicates,
+		Registries:       registries,
+		AllLibraries:     allLibraries,
+		Mappings:         mappings,
+		Libraries:        libraries,
+		MatchMode:        matchMode,
+		Explain:          explain,
+		Extensions:       extensions,
+		FailIfFound:      failIfFound,
+		MaxCount:         maxCount,
+		MinCount:         minCount,
+		Badge:            badge,
+		BadgeLabel:       badgeLabel,
+		Stdin:            stdin,
+		Stdin0:           stdin0,
+		ListFiles:        listFiles,
+		Verbose:          verbose,
+		Quiet:            quiet,
+		LogFormat:        logFormat,
 	}, nil
 }
 
+// applyDirectoryArgs sets the scan roots to the directories given as arguments, or else to --directory,
+// expanding glob patterns such as packages/*/src
+// The configuration and package.json are looked up in the first root
+func (c *Controller) applyDirectoryArgs(cmd *cobra.Command, options *types.CLIOptions, args []string) error {
+	if len(args) == 0 {
+		args = []string{options.Directory}
+	} else if cmd.Flags().Changed("directory") {
+		return c.loc.Errorf("directories given both as arguments and with --directory: use one of them")
+	}
+
+	roots, err := discovery.ExpandRoots(args)
+	if err != nil {
+		return err
+	}
+	options.Directories = roots
+	options.Directory = roots[0]
+	return nil
+}
+
+// applyScanConfig sets the options of the scan flags not given on the command line from the configuration
+// Exclude patterns, registries and mappings of the configuration are added to those of the command line
+func applyScanConfig(cmd *cobra.Command, options *types.CLIOptions, scan config.ScanConfig) {
+	applyExtensionConfig(options, scan)
+	applyValue(cmd, "component-type", &options.ComponentType, scan.ComponentType)
+	if scan.ComponentName != nil && !cmd.Flags().Changed("component-name") {
+		options.ComponentNames = scan.ComponentName
+	}
+	if scan.Filter != nil && !cmd.Flags().Changed("filter") {
+		options.Filter = scan.Filter
+	}
+	if scan.Libraries != nil && !cmd.Flags().Changed("library") {
+		options.Libraries = scan.Libraries
+	}
+	if scan.Ext != nil && !cmd.Flags().Changed("ext") {
+		options.Extensions = scan.Ext
+	}
+	applyValue(cmd, "max-depth", &options.MaxDepth, scan.MaxDepth)
+	applyValue(cmd, "concurrency", &options.Concurrency, scan.Concurrency)
+	applyValue(cmd, "path-style", &options.PathStyle, scan.PathStyle)
+	applyValue(cmd, "no-ignore", &options.NoIgnore, scan.NoIgnore)
+	applyValue(cmd, "output", &options.OutputFormat, scan.Output)
+	applyValue(cmd, "output-file", &options.OutputFile, scan.OutputFile)
+	applyValue(cmd, "compress", &options.Compress, scan.Compress)
+	applyValue(cmd, "sort", &options.Sort, scan.Sort)
+	applyValue(cmd, "desc", &options.Desc, scan.Desc)
+	applyValue(cmd, "group-by", &options.GroupBy, scan.GroupBy)
+	applyValue(cmd, "layout", &options.Layout, scan.Layout)
+	applyValue(cmd, "hyperlinks", &options.Hyperlinks, scan.Hyperlinks)
+	applyValue(cmd, "link-template", &options.LinkTemplate, scan.LinkTemplate)
+	applyValue(cmd, "summary", &options.Summary, scan.Summary)
+	applyValue(cmd, "by-directory", &options.ByDirectory, scan.ByDirectory)
+	applyValue(cmd, "directory-depth", &options.DirectoryDepth, scan.DirectoryDepth)
+	applyValue(cmd, "top", &options.Top, scan.Top)
+	applyValue(cmd, "profile", &options.Profile, scan.Profile)
+	applyValue(cmd, "parser-engine", &options.ParserEngine, scan.ParserEngine)
+	applyValue(cmd, "exclude-stories", &options.ExcludeStories, scan.ExcludeStories)
+	applyValue(cmd, "include-tests", &options.IncludeTests, scan.IncludeTests)
+	applyValue(cmd, "include-markdown", &options.IncludeMarkdown, scan.IncludeMarkdown)
+	applyValue(cmd, "include-alpine", &options.IncludeAlpine, scan.IncludeAlpine)
+	applyValue(cmd, "include-generated", &options.IncludeGenerated, scan.IncludeGenerated)
+	applyValue(cmd, "with-props", &options.WithProps, scan.WithProps)
+	applyValue(cmd, "snippet", &options.Snippet, scan.Snippet)
+	applyValue(cmd, "context", &options.ContextLines, scan.Context)
+	applyValue(cmd, "count-duplicates", &options.CountDuplicates, scan.CountDuplicates)
+	applyValue(cmd, "all-libraries", &options.AllLibraries, scan.AllLibraries)
+	applyValue(cmd, "match", &options.MatchMode, scan.Match)
+	applyValue(cmd, "fail-if-found", &options.FailIfFound, scan.FailIfFound)
+	applyValue(cmd, "max-count", &options.MaxCount, scan.MaxCount)
+	applyValue(cmd, "min-count", &options.MinCount, scan.MinCount)
+	applyValue(cmd, "badge", &options.Badge, scan.Badge)
+	applyValue(cmd, "badge-label", &options.BadgeLabel, scan.BadgeLabel)
+
+	// Context lines imply a snippet, and a directory depth the directory counts, whichever layer set them
+	options.Snippet = options.Snippet || options.ContextLines > 0
+	options.ByDirectory = options.ByDirectory || options.DirectoryDepth > 0
+}
+
+// applyExtensionConfig adds the exclude patterns, registries and mappings of the configuration
+// before those of the command line, which take precedence
+func applyExtensionConfig(options *types.CLIOptions, scan config.ScanConfig) {
+	options.Exclude = append(slices.Clone(scan.Exclude), options.Exclude...)
+	options.Registries = append(slices.Clone(scan.Registries), options.Registries...)
+	for componentType, names := range scan.Map {
+		if options.Mappings == nil {
+			options.Mappings = make(map[string][]string)
+		}
+		componentType = strings.ToLower(strings.TrimSpace(componentType))
+		options.Mappings[componentType] = append(slices.Clone(names), options.Mappings[componentType]...)
+	}
+}
+
+// applyValue sets *option to the configured value, unless the flag is given on the command line
+func applyValue[T any](cmd *cobra.Command, flag string, option *T, value *T) {
+	if value != nil && !cmd.Flags().Changed(flag) {
+		*option = *value
+	}
+}
+
+// excludePatterns returns the default exclude patterns extended with the given ones, in order
+// A pattern prefixed with "!" removes an earlier pattern instead (e.g. "!test" scans test directories),
+// and includeTests removes the test patterns. Empty patterns, which would exclude every path, are skipped
+func excludePatterns(patterns []string, includeTests bool) []string {
+	excluded := slices.Clone(discovery.DefaultExcludePatterns)
+	if includeTests {
+		excluded = slices.DeleteFunc(excluded, func(p string) bool { return slices.Contains(discovery.TestExcludePatterns, p) })
+	}
+	for _, pattern := range patterns {
+		if pattern = strings.TrimSpace(pattern); pattern == "" || pattern == "!" {
+			continue
+		}
+		if removed, found := strings.CutPrefix(pattern, "!"); found {
+			excluded = slices.DeleteFunc(excluded, func(p string) bool { return p == removed })
+			continue
+		}
+		excluded = append(excluded, pattern)
+	}
+	return excluded
+}
+
+// parseMappings parses --map values of the form type=Name[,Name] into component names per type
+// Errors are in the language of loc
+func parseMappings(values []string, loc *i18n.Localizer) (map[string][]string, error) {
+	mappings := make(map[string][]string)
+	for _, value := range values {
+		componentType, names, found := strings.Cut(value, "=")
+		componentType = strings.ToLower(strings.TrimSpace(componentType))
+		if !found || componentType == "" {
+			return nil, loc.Errorf("invalid --map value '%s': expected type=Name[,Name]", value)
+		}
+		for _, name := range strings.Split(names, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				mappings[componentType] = append(mappings[componentType], name)
+			}
+		}
+		if len(mappings[componentType]) == 0 {
+			return nil, loc.Errorf("invalid --map value '%s': no component names given", value)
+		}
+	}
+	return mappings, nil
+}
+
+// componentTypes returns the valid component types: the types of the registry, custom, deprecated,
+// all and the type groups
+func componentTypes(componentRegistry *registry.ComponentMappingRegistry) []string {
+	validTypes := append(componentRegistry.Types(), types.ComponentTypeCustom, types.ComponentTypeDeprecated, types.ComponentTypeAll)
+	return append(validTypes, componentRegistry.Groups()...)
+}
+
 // validateOptions validates the parsed CLI options
-func (c *Controller) validateOptions(options *types.CLIOptions) error {
-	// Validate component type
-	validTypes := map[string]bool{
-		"form":   true,
-		"button": true,
-		"dialog": true,
-		"custom": true,
+// Valid component types are the types of the registry, "custom" and "deprecated", matched case-insensitively
+func (c *Controller) validateOptions(options *types.CLIOptions, componentRegistry *registry.ComponentMappingRegistry) error {
+	// Validate component type, normalized to the lower case used by the registry
+	if options.ComponentType == "" {
+		return c.loc.Errorf("required flag \"component-type\" not set: pass --component-type or set scan.componentType in the configuration file")
+	}
+	options.ComponentType = strings.ToLower(options.ComponentType)
+	validTypes := componentTypes(componentRegistry)
+	if !slices.Contains(validTypes, options.ComponentType) {
+		return c.loc.Errorf("invalid component type '%s': must be one of: %s", options.ComponentType, strings.Join(validTypes, ", "))
 	}
-	if !validTypes[options.ComponentType] {
-		return fmt.Errorf("invalid component type '%s': must be one of: form, button, dialog, custom", options.ComponentType)
+	if options.ComponentType == types.ComponentTypeDeprecated && len(componentRegistry.Deprecations()) == 0 {
+		return c.loc.Errorf("no deprecated components: list them in the deprecated section of a registry file")
+	}
+
+	// Validate component names, which name the components of a custom search
+	if len(options.ComponentNames) > 0 && options.ComponentType != types.ComponentTypeCustom {
+		return c.loc.Errorf("--component-name requires --component-type %s, not '%s'", types.ComponentTypeCustom, options.ComponentType)
+	}
+	for _, name := range options.ComponentNames {
+		if strings.TrimSpace(name) == "" || strings.ContainsAny(name, " <>/") {
+			return c.loc.Errorf("invalid component name '%s': expected a component name such as MyWidget or my-widget", name)
+		}
+	}
+
+	// Validate libraries
+	validLibraries := componentRegistry.Libraries()
+	for _, library := range options.Libraries {
+		if !slices.Contains(validLibraries, library) {
+			return c.loc.Errorf("invalid library '%s': must be one of: %s", library, strings.Join(validLibraries, ", "))
+		}
+	}
+
+	// Validate match mode
+	if options.MatchMode != registry.MatchExact && options.MatchMode != registry.MatchPrefix && options.MatchMode != registry.MatchFuzzy {
+		return c.loc.Errorf("invalid match mode '%s': must be one of: %s, %s, %s", options.MatchMode, registry.MatchExact, registry.MatchPrefix, registry.MatchFuzzy)
 	}
 
 	// Validate output format
@@ -138,14 +787,91 @@ func (c *Controller) validateOptions(options *types.CLIOptions) error {
 		"terminal": true,
 		"json":     true,
 		"both":     true,
+		"stdout":   true,
+		"xml":      true,
+		"xlsx":     true,
 	}
 	if !validOutputs[options.OutputFormat] {
-		return fmt.Errorf("invalid output format '%s': must be one of: terminal, json, both", options.OutputFormat)
+		return c.loc.Errorf("invalid output format '%s': must be one of: terminal, json, both, stdout, xml, xlsx", options.OutputFormat)
+	}
+	if !slices.Contains(output.SortKeys, options.Sort) {
+		return c.loc.Errorf("invalid sort '%s': must be one of: %s", options.Sort, strings.Join(output.SortKeys, ", "))
+	}
+	if options.GroupBy != "" && !slices.Contains(output.GroupKeys, options.GroupBy) {
+		return c.loc.Errorf("invalid group-by '%s': must be one of: %s", options.GroupBy, strings.Join(output.GroupKeys, ", "))
+	}
+	if !slices.Contains(output.Layouts, options.Layout) {
+		return c.loc.Errorf("invalid layout '%s': must be one of: %s", options.Layout, strings.Join(output.Layouts, ", "))
+	}
+	if !slices.Contains(output.HyperlinkModes, options.Hyperlinks) {
+		return c.loc.Errorf("invalid hyperlinks '%s': must be one of: %s", options.Hyperlinks, strings.Join(output.HyperlinkModes, ", "))
+	}
+	if options.Summary && options.GroupBy != "" {
+		return c.loc.Errorf("--summary cannot be combined with --group-by")
+	}
+	if options.MaxCount < -1 {
+		return c.loc.Errorf("invalid max-count %d: must be -1 (no limit) or more", options.MaxCount)
+	}
+	switch options.PathStyle {
+	case scanner.PathStyleRelative, scanner.PathStyleAbsolute, scanner.PathStyleRepoRoot:
+	default:
+		return c.loc.Errorf("invalid path style '%s': must be one of: %s, %s, %s", options.PathStyle, scanner.PathStyleRelative, scanner.PathStyleAbsolute, scanner.PathStyleRepoRoot)
+	}
+	if options.Concurrency < 1 {
+		return c.loc.Errorf("invalid concurrency %d: must be 1 or more", options.Concurrency)
+	}
+	if options.MaxDepth < 0 {
+		return c.loc.Errorf("invalid max-depth %d: must be 0 (no limit) or more", options.MaxDepth)
+	}
+	if options.DirectoryDepth < 0 {
+		return c.loc.Errorf("invalid directory-depth %d: must be 0 (full directory) or more", options.DirectoryDepth)
+	}
+	if options.Top < 0 {
+		return c.loc.Errorf("invalid top %d: must be 0 (no ranking) or more", options.Top)
+	}
+	if options.MinCount < 0 {
+		return c.loc.Errorf("invalid min-count %d: must be 0 or more", options.MinCount)
+	}
+	if options.MaxCount >= 0 && options.MinCount > options.MaxCount {
+		return c.loc.Errorf("min-count %d is greater than max-count %d", options.MinCount, options.MaxCount)
+	}
+	if options.OutputFile != "" && (options.OutputFormat == "terminal" || options.OutputFormat == "stdout") {
+		return c.loc.Errorf("--output-file requires --output json, both, xml or xlsx")
+	}
+	if options.Compress && (!slices.Contains([]string{"json", "both", "xml"}, options.OutputFormat) || options.OutputFile == output.StdoutPath) {
+		return c.loc.Errorf("--compress requires --output json, both or xml written to a file")
 	}
 
-	// Validate directory exists
-	if _, err := os.Stat(options.Directory); os.IsNotExist(err) {
-		return fmt.Errorf("directory not found: %s", options.Directory)
+	// Validate profile
+	if options.Profile != "web" && options.Profile != "react-native" {
+		return c.loc.Errorf("invalid profile '%s': must be one of: web, react-native", options.Profile)
+	}
+
+	// Validate context lines
+	if options.ContextLines < 0 {
+		return c.loc.Errorf("invalid context '%d': must not be negative", options.ContextLines)
+	}
+
+	// Validate parser engine; ast is the former name of the tokenizer engine
+	if options.ParserEngine == scanner.EngineLegacyAST {
+		options.ParserEngine = scanner.EngineTokenizer
+	}
+	if options.ParserEngine != scanner.EngineTokenizer && options.ParserEngine != scanner.EngineRegex {
+		return c.loc.Errorf("invalid parser engine '%s': must be one of: tokenizer, regex", options.ParserEngine)
+	}
+
+	// Validate the directories exist
+	for _, directory := range options.Directories {
+		if _, err := os.Stat(directory); os.IsNotExist(err) {
+			return c.loc.Errorf("directory not found: %s", directory)
+		}
+	}
+
+	// Validate config file exists
+	if options.ConfigFile != "" {
+		if _, err := os.Stat(options.ConfigFile); os.IsNotExist(err) {
+			return c.loc.Errorf("config file not found: %s", options.ConfigFile)
+		}
 	}
 
 	return nil
@@ -156,68 +882,351 @@ func (c *Controller) Execute() error {
 	return c.rootCmd.Execute()
 }
 
+// FormatError formats an error returned by Execute for display, in the language of the command
+func (c *Controller) FormatError(err error) string {
+	return c.loc.Sprintf("Error: %v", err)
+}
+
+// setLanguage selects the language of --lang, or else of the locale of the environment
+func (c *Controller) setLanguage(cmd *cobra.Command, args []string) error {
+	language, err := cmd.Flags().GetString("lang")
+	if err != nil {
+		return fmt.Errorf("failed to parse lang flag: %w", err)
+	}
+	if language == "" {
+		language = i18n.Detect(os.Getenv)
+	}
+	c.loc, err = i18n.New(language)
+	return err
+}
+
 // executeScan performs the component scanning process
-func (c *Controller) executeScan(options *types.CLIOptions) (*types.ScanResult, error) {
-	// Import required packages at the top of the file
+func (c *Controller) executeScan(options *types.CLIOptions, componentRegistry *registry.ComponentMappingRegistry, cfg *config.Config) (*types.ScanResult, error) {
 	// Create file discovery service
 	discoveryService := discovery.NewFileDiscoveryService()
+	discoveryService.SetLogger(c.logger)
 
-	// Build file filter
-	filter := types.FileFilter{
-		ExcludePatterns:    []string{"node_modules", "test", "tests", "__tests__", ".test.", ".spec."},
-		IncludeDirectories: options.Filter,
-		FileExtensions:     []string{".vue", ".jsx", ".tsx"},
+	filter, extensionAliases, err := buildFileFilter(options)
+	if err != nil {
+		return nil, err
 	}
-
-	// Discover files
-	files, err := discoveryService.DiscoverFiles(options.Directory, filter)
+	files, fileRoots, err := c.discoverFiles(discoveryService, options, filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to discover files: %w", err)
+		return nil, err
 	}
 
 	// Check if any files were found
 	if len(files) == 0 {
 		return &types.ScanResult{
+			SchemaVersion: types.SchemaVersion,
 			Matches:       []types.ComponentMatch{},
 			TotalCount:    0,
 			ScanTimeMs:    0,
 			ComponentType: options.ComponentType,
 			ScannedFiles:  0,
+			MatchMode:     options.MatchMode,
 		}, nil
 	}
 
-	// Create component registry
-	registry := registry.NewComponentMappingRegistry()
-
 	// Create parsers
+	reactParser := scanner.NewReactParser()
+	reactParser.SetEngine(options.ParserEngine)
+	if options.Profile == "react-native" {
+		reactParser.IgnoreComponents(scanner.ReactNativePrimitives...)
+	}
+
+	vueParser := scanner.NewVueParser()
+	vueParser.SetEngine(options.ParserEngine)
+
 	parsers := []scanner.ComponentParser{
-		scanner.NewVueParser(),
-		scanner.NewReactParser(),
+		vueParser,
+		reactParser,
+		scanner.NewLitParser(),
+		scanner.NewInlineTemplateParser(),
+		scanner.NewCustomElementParser(),
+		scanner.NewLazyComponentParser(),
+		scanner.NewWrapperParser(),
+		scanner.NewHbsParser(),
+		scanner.NewBladeParser(),
+		scanner.NewErbParser(),
+		scanner.NewTwigParser(),
+		scanner.NewServerTemplateParser(),
+		scanner.NewRazorParser(),
+	}
+	if options.IncludeMarkdown {
+		parsers = append(parsers, scanner.NewMarkdownParser())
+	}
+	if options.IncludeAlpine {
+		parsers = append(parsers, scanner.NewAlpineParser())
 	}
 
+	// Match the patterns of the installed library versions, and only the libraries selected
+	// with --library, or else those installed in the project
+	manifest, err := project.LoadManifest(options.Directory)
+	if err != nil {
+		return nil, err
+	}
+	if manifest != nil {
+		componentRegistry.SetLibraryVersions(manifest.LibraryVersions())
+	}
+	if len(options.Libraries) > 0 {
+		componentRegistry.RestrictLibraries(options.Libraries...)
+	} else if manifest != nil && !options.AllLibraries {
+		componentRegistry.DisableLibraries(manifest.MissingLibraries()...)
+	}
+	if err := componentRegistry.SetMatchMode(options.MatchMode); err != nil {
+		return nil, err
+	}
+
+	// Report paths relative to the repository of the scanned directory, or to the directory itself outside a repository
+	repoRoot := findRepoRoot(options)
+
 	// Create scanner
-	componentScanner := scanner.NewComponentScanner(parsers, registry)
+	componentScanner := scanner.NewComponentScanner(parsers, componentRegistry)
+	componentScanner.SetLogger(c.logger)
+	componentScanner.SetOptions(types.ScanOptions{
+		WithProps:        options.WithProps,
+		Snippet:          options.Snippet,
+		ContextLines:     options.ContextLines,
+		IncludeGenerated: options.IncludeGenerated,
+		CountDuplicates:  options.CountDuplicates,
+		Explain:          options.Explain,
+		TagPolicy:        cfg.TagPolicy(),
+		ExtensionAliases: extensionAliases,
+		Concurrency:      options.Concurrency,
+		PathStyle:        options.PathStyle,
+		RepoRoot:         repoRoot,
+		ComponentNames:   options.ComponentNames,
+	})
 
 	// Execute scan
 	result, err := componentScanner.Scan(files, options.ComponentType)
 	if err != nil {
-		return nil, fmt.Errorf("scan execution failed: %w", err)
+		return nil, c.loc.Errorf("scan execution failed: %w", err)
+	}
+	if manifest != nil {
+		result.Warnings = append(result.Warnings, manifest.MissingDependencyWarnings(result.Matches)...)
+	}
+	if len(options.Directories) > 1 && fileRoots != nil {
+		// Matches carry the paths in the configured style
+		matchRoots := make(map[string]string, len(fileRoots))
+		for path, root := range fileRoots {
+			matchRoots[scanner.FormatPath(path, options.PathStyle, repoRoot)] = root
+		}
+		result.RootCounts = scanner.CountByRoot(result.Matches, matchRoots, options.Directories)
+	}
+	output.SortMatches(result.Matches, options.Sort, options.Desc)
+	if options.GroupBy != "" {
+		result.GroupBy = options.GroupBy
+		result.Groups = output.GroupMatches(result.Matches, options.GroupBy)
+	}
+	if options.ByDirectory {
+		result.DirectoryCounts = output.CountByDirectory(result.Matches, options.DirectoryDepth)
+	}
+	if options.Summary {
+		result.Summary = output.Summarize(result.Matches)
+	}
+	if options.Top > 0 {
+		result.Top = output.Rank(result.Matches, options.Top)
 	}
 
 	return result, nil
 }
 
+// buildFileFilter builds the filter of the discovered files from the options
+// Returns the filter and the extensions parsed like another one
+func buildFileFilter(options *types.CLIOptions) (types.FileFilter, map[string]string, error) {
+	extensions, extensionAliases, err := discovery.ParseExtensions(options.Extensions)
+	if err != nil {
+		return types.FileFilter{}, nil, fmt.Errorf("failed to parse ext flag: %w", err)
+	}
+	filter := types.FileFilter{
+		ExcludePatterns:    excludePatterns(options.Exclude, options.IncludeTests),
+		IncludeDirectories: options.Filter,
+		FileExtensions:     extensions,
+		MaxDepth:           options.MaxDepth,
+	}
+	if !options.NoIgnore {
+		filter.IgnoreFiles = discovery.DefaultIgnoreFiles
+	}
+	if options.ExcludeStories {
+		filter.ExcludePatterns = append(filter.ExcludePatterns, ".stories.")
+	}
+	if !options.IncludeGenerated {
+		filter.ExcludePatterns = append(filter.ExcludePatterns, scanner.GeneratedFilePatterns...)
+	}
+	if options.IncludeMarkdown {
+		filter.FileExtensions = append(filter.FileExtensions, ".md")
+	}
+	if options.IncludeAlpine {
+		filter.FileExtensions = append(filter.FileExtensions, ".html", ".htm")
+	}
+	return filter, extensionAliases, nil
+}
+
+// discoverFiles discovers the files of every root, or takes the files piped in as they are
+// Returns the files and, for discovered files, the root each was found in
+func (c *Controller) discoverFiles(discoveryService *discovery.FileDiscoveryService, options *types.CLIOptions, filter types.FileFilter) ([]string, map[string]string, error) {
+	var files []string
+	var fileRoots map[string]string
+	var err error
+	switch {
+	case options.Stdin:
+		files, err = discovery.ReadFileList(c.rootCmd.InOrStdin(), '\n')
+	case options.Stdin0:
+		files, err = discovery.ReadFileList(c.rootCmd.InOrStdin(), 0)
+	default:
+		files, fileRoots, err = discoveryService.DiscoverRoots(options.Directories, filter)
+	}
+	if err != nil {
+		return nil, nil, c.loc.Errorf("failed to discover files: %w", err)
+	}
+	if options.Stdin || options.Stdin0 {
+		files = discoveryService.ExistingFiles(files)
+	}
+	return files, fileRoots, nil
+}
+
+// listFiles prints the files a scan would read and the paths skipped with the reason, without scanning
+func (c *Controller) listFiles(w io.Writer, options *types.CLIOptions) error {
+	discoveryService := discovery.NewFileDiscoveryService()
+	discoveryService.SetLogger(c.logger)
+	var skipped []string
+	discoveryService.SetSkipHandler(func(path string, reason string) {
+		skipped = append(skipped, c.loc.Sprintf("skip %s (%s)", path, reason))
+	})
+
+	filter, _, err := buildFileFilter(options)
+	if err != nil {
+		return err
+	}
+	files, _, err := c.discoverFiles(discoveryService, options, filter)
+	if err != nil {
+		return err
+	}
+	for _, path := range files {
+		fmt.Fprintln(w, c.loc.Sprintf("scan %s", path))
+	}
+	for _, line := range skipped {
+		fmt.Fprintln(w, line)
+	}
+	return nil
+}
+
 // displayOutput formats and displays the scan results
 func (c *Controller) displayOutput(result *types.ScanResult, options *types.CLIOptions) error {
 	formatter := output.NewOutputFormatter()
+	formatter.SetLogger(c.logger)
+	formatter.SetQuiet(options.Quiet)
+	formatter.SetLocalizer(c.loc)
+	formatter.SetLayout(options.Layout)
+	formatter.SetLinkTemplate(linkTemplate(options))
+	formatter.SetCompress(options.Compress)
 
-	// Determine output path for JSON (empty string will use default)
-	outputPath := ""
-
-	// Write output according to format
-	if err := formatter.Write(result, options.OutputFormat, outputPath); err != nil {
+	// Write output according to format; an empty output file uses the default JSON path
+	if err := formatter.Write(result, options.OutputFormat, options.OutputFile); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// findRepoRoot returns the root of the git repository containing the scanned directory, or the
+// absolute scanned directory outside a repository
+func findRepoRoot(options *types.CLIOptions) string {
+	repoRoot := project.FindRepoRoot(options.Directory)
+	if repoRoot == "" {
+		repoRoot, _ = filepath.Abs(options.Directory)
+	}
+	return repoRoot
+}
+
+// linkTemplate returns the link template of the terminal output, or nil if --hyperlinks leaves
+// the matches unlinked: never, or auto without --link-template or when stdout is not a terminal
+func linkTemplate(options *types.CLIOptions) *output.LinkTemplate {
+	switch options.Hyperlinks {
+	case output.HyperlinksNever:
+		return nil
+	case output.HyperlinksAuto:
+		if options.LinkTemplate == "" || !isTerminal(os.Stdout) {
+			return nil
+		}
+	}
+
+	links := &output.LinkTemplate{Template: options.LinkTemplate, Root: findRepoRoot(options)}
+	if links.Template == "" {
+		links.Template = output.DefaultLinkTemplate
+	}
+	// Relative paths of matches are relative to the repository root or the working directory
+	if options.PathStyle == scanner.PathStyleRepoRoot {
+		links.Base = links.Root
+	} else {
+		links.Base, _ = os.Getwd()
+	}
+	return links
+}
+
+// writeBadge writes the badge of the result to the --badge file, green or red if the count is
+// gated depending on whether it passed, else blue
+func (c *Controller) writeBadge(result *types.ScanResult, options *types.CLIOptions, passed bool) error {
+	color := output.BadgeColorInfo
+	if gated(options) {
+		color = output.BadgeColorFail
+		if passed {
+			color = output.BadgeColorPass
+		}
+	}
+	formatter := output.NewOutputFormatter()
+	formatter.SetLogger(c.logger)
+	return formatter.WriteBadge(output.NewBadge(result, options.BadgeLabel, color), options.Badge)
+}
+
+// loadConfig merges the project configuration file, given with --config or found in the
+// scanned directory, over the global configuration file
+// Returns an empty configuration if there is no configuration file
+func loadConfig(options *types.CLIOptions) (*config.Config, error) {
+	project := options.ConfigFile
+	if project == "" {
+		project = config.Find(options.Directory)
+	}
+	return config.LoadLayers(config.FindGlobal(), project)
+}
+
+// loadRegistry creates the component registry from the built-in mappings, merging the
+// registry sources over them, then the names given with --map and the type groups of the configuration
+func loadRegistry(options *types.CLIOptions, cfg *config.Config, loc *i18n.Localizer) (*registry.ComponentMappingRegistry, error) {
+	componentRegistry := registry.NewComponentMappingRegistry()
+
+	for _, source := range registrySources(options) {
+		if err := componentRegistry.Load(source); err != nil {
+			return nil, err
+		}
+	}
+
+	for componentType, names := range options.Mappings {
+		if err := componentRegistry.AddPatterns(componentType, names...); err != nil {
+			return nil, loc.Errorf("invalid --map value: %w", err)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.Groups)) {
+		if err := componentRegistry.DefineGroup(name, cfg.Groups[name]...); err != nil {
+			return nil, loc.Errorf("invalid configuration: %w", err)
+		}
+	}
+	return componentRegistry, nil
+}
+
+// registrySources returns the registry sources to merge, lowest precedence first: the global
+// registry file, the registry file found in the scanned directory and the --registry sources
+// in the order given (files, URLs, commands)
+func registrySources(options *types.CLIOptions) []string {
+	var sources []string
+	if path := registry.FindGlobalFile(config.GlobalDir()); path != "" {
+		sources = append(sources, path)
+	}
+	if path := registry.FindFile(options.Directory); path != "" {
+		sources = append(sources, path)
+	}
+	return append(sources, options.Registries...)
+}
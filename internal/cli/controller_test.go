@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestParseMappings(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected map[string][]string
+		wantErr  bool
+	}{
+		{
+			name:     "no values",
+			values:   nil,
+			expected: map[string][]string{},
+		},
+		{
+			name:     "single name",
+			values:   []string{"form=AppForm"},
+			expected: map[string][]string{"form": {"AppForm"}},
+		},
+		{
+			name:     "names, spaces and type case",
+			values:   []string{" Form = AppForm, XForm ,", "dialog=AppDialog"},
+			expected: map[string][]string{"form": {"AppForm", "XForm"}, "dialog": {"AppDialog"}},
+		},
+		{
+			name:     "repeated type",
+			values:   []string{"form=AppForm", "form=XForm"},
+			expected: map[string][]string{"form": {"AppForm", "XForm"}},
+		},
+		{name: "empty value", values: []string{""}, wantErr: true},
+		{name: "missing separator", values: []string{"form"}, wantErr: true},
+		{name: "missing type", values: []string{"=AppForm"}, wantErr: true},
+		{name: "blank type", values: []string{"  =AppForm"}, wantErr: true},
+		{name: "missing names", values: []string{"form="}, wantErr: true},
+		{name: "only separators", values: []string{"form= , ,"}, wantErr: true},
+		{name: "malformed after valid value", values: []string{"form=AppForm", "dialog"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mappings, err := parseMappings(tt.values, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseMappings(%q) = %v, want an error", tt.values, mappings)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMappings(%q) error = %v", tt.values, err)
+			}
+			if !reflect.DeepEqual(mappings, tt.expected) {
+				t.Errorf("parseMappings(%q) = %v, want %v", tt.values, mappings, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExcludePatterns(t *testing.T) {
+	defaults := []string{"node_modules", "test", "tests", "__tests__", ".test.", ".spec."}
+
+	tests := []struct {
+		name         string
+		patterns     []string
+		includeTests bool
+		expected     []string
+	}{
+		{name: "defaults", expected: defaults},
+		{name: "added patterns", patterns: []string{"**/legacy/**", "*.gen.ts"}, expected: append(slices.Clone(defaults), "**/legacy/**", "*.gen.ts")},
+		{name: "include tests", includeTests: true, expected: []string{"node_modules"}},
+		{name: "removed default", patterns: []string{"!test"}, expected: []string{"node_modules", "tests", "__tests__", ".test.", ".spec."}},
+		{name: "removed added pattern", patterns: []string{"legacy", "!legacy"}, expected: defaults},
+		{name: "removal before addition", patterns: []string{"!legacy", "legacy"}, expected: append(slices.Clone(defaults), "legacy")},
+		{name: "removal of unknown pattern", patterns: []string{"!vendor"}, expected: defaults},
+		{name: "empty pattern", patterns: []string{""}, expected: defaults},
+		{name: "blank pattern", patterns: []string{"  "}, expected: defaults},
+		{name: "bare negation", patterns: []string{"!"}, expected: defaults},
+		{name: "spaces around pattern", patterns: []string{" legacy "}, expected: append(slices.Clone(defaults), "legacy")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := excludePatterns(tt.patterns, tt.includeTests); !slices.Equal(result, tt.expected) {
+				t.Errorf("excludePatterns(%q, %v) = %q, want %q", tt.patterns, tt.includeTests, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+
+	"ui-elf/internal/diff"
+	"ui-elf/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// setupDiffCommand adds the diff subcommand, which compares two saved JSON result files without scanning
+func (c *Controller) setupDiffCommand() {
+	diffCmd := &cobra.Command{
+		Use:   "diff <before.json> <after.json> [flags]",
+		Short: "Compare two saved JSON result files: added and removed matches, count changes per component",
+		Long: `Compare two scan results written with --output json, e.g. of the previous
+and the current release, to track the progress of a component migration.
+
+The matches of the second file missing from the first are reported as added,
+those of the first missing from the second as removed, followed by the change of
+the usages of each component. Matches are identified by their fingerprint, so
+code moving within a file is not reported as a change. Grouped results are
+compared by the matches of their groups.
+
+The diff command does not scan: it only compares results saved beforehand.
+To compare two revisions, scan each of them with the same flags and
+--output json first, as in the example.`,
+		Example: `  # Compare the deprecated components of two releases
+  ui-elf -t deprecated -d ./src -o json --output-file before.json
+  git checkout v2.0.0
+  ui-elf -t deprecated -d ./src -o json --output-file after.json
+  ui-elf diff before.json after.json
+
+  # Print the differences as JSON
+  ui-elf diff before.json after.json -o json | jq '.components'`,
+		Args: cobra.ExactArgs(2),
+		RunE: c.diffResults,
+	}
+
+	diffCmd.Flags().StringP("output", "o", "terminal", "Output format: terminal or json")
+
+	c.rootCmd.AddCommand(diffCmd)
+}
+
+// diffResults prints the differences between the result files given as arguments
+func (c *Controller) diffResults(cmd *cobra.Command, args []string) error {
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return fmt.Errorf("failed to parse output flag: %w", err)
+	}
+	if format != "terminal" && format != "json" {
+		return c.loc.Errorf("invalid output format '%s': must be one of: terminal, json", format)
+	}
+	cmd.SilenceUsage = true
+
+	before, err := diff.LoadResult(args[0])
+	if err != nil {
+		return err
+	}
+	after, err := diff.LoadResult(args[1])
+	if err != nil {
+		return err
+	}
+	resultDiff := diff.Compare(before, after)
+	resultDiff.Before, resultDiff.After = args[0], args[1]
+
+	formatter := output.NewOutputFormatter()
+	formatter.SetLocalizer(c.loc)
+	if format == "json" {
+		jsonStr, err := formatter.FormatDiffJSON(resultDiff)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), jsonStr)
+		return nil
+	}
+	fmt.Fprint(cmd.OutOrStdout(), formatter.FormatDiffTerminal(resultDiff))
+	return nil
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"errors"
+
+	"ui-elf/internal/i18n"
+	"ui-elf/internal/types"
+)
+
+// Exit codes of ui-elf
+const (
+	ExitError     = 1 // The scan failed or the command line is invalid
+	ExitThreshold = 2 // The scan succeeded, but its count failed --fail-if-found, --max-count or --min-count
+)
+
+// ThresholdError reports a scan whose component count fails a CI gating flag
+type ThresholdError struct {
+	Count  int    // Components found
+	Reason string // Expectation failed, e.g. "more than --max-count 10"
+	loc    *i18n.Localizer
+}
+
+// Error returns the count and the expectation it failed
+func (e *ThresholdError) Error() string {
+	return e.loc.Sprintf("found %d component(s), %s", e.Count, e.Reason)
+}
+
+// ExitCode returns the exit code of ui-elf for an error returned by Execute
+func ExitCode(err error) int {
+	var thresholdErr *ThresholdError
+	if errors.As(err, &thresholdErr) {
+		return ExitThreshold
+	}
+	return ExitError
+}
+
+// gated reports whether any of --fail-if-found, --max-count or --min-count is set
+func gated(options *types.CLIOptions) bool {
+	return options.FailIfFound || options.MaxCount >= 0 || options.MinCount > 0
+}
+
+// checkThresholds returns a ThresholdError if the component count of the result fails
+// --fail-if-found, --max-count or --min-count, in the language of loc
+func checkThresholds(result *types.ScanResult, options *types.CLIOptions, loc *i18n.Localizer) error {
+	count := result.TotalCount
+	switch {
+	case options.FailIfFound && count > 0:
+		return &ThresholdError{Count: count, Reason: loc.Sprintf("expected none (--fail-if-found)"), loc: loc}
+	case options.MaxCount >= 0 && count > options.MaxCount:
+		return &ThresholdError{Count: count, Reason: loc.Sprintf("more than --max-count %d", options.MaxCount), loc: loc}
+	case count < options.MinCount:
+		return &ThresholdError{Count: count, Reason: loc.Sprintf("fewer than --min-count %d", options.MinCount), loc: loc}
+	}
+	return nil
+}
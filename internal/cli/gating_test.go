@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"ui-elf/internal/i18n"
+	"ui-elf/internal/types"
+)
+
+func TestCheckThresholds(t *testing.T) {
+	loc, err := i18n.New("en")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		count       int
+		failIfFound bool
+		maxCount    int
+		minCount    int
+		wantErr     string
+	}{
+		{name: "no threshold", count: 5, maxCount: -1},
+		{name: "no threshold and no components", count: 0, maxCount: -1},
+		{name: "fail-if-found without components", count: 0, failIfFound: true, maxCount: -1},
+		{name: "fail-if-found with components", count: 1, failIfFound: true, maxCount: -1, wantErr: "found 1 component(s), expected none (--fail-if-found)"},
+		{name: "max-count below the limit", count: 9, maxCount: 10},
+		{name: "max-count at the limit", count: 10, maxCount: 10},
+		{name: "max-count over the limit", count: 11, maxCount: 10, wantErr: "found 11 component(s), more than --max-count 10"},
+		{name: "max-count zero", count: 1, maxCount: 0, wantErr: "found 1 component(s), more than --max-count 0"},
+		{name: "min-count over the limit", count: 4, maxCount: -1, minCount: 3},
+		{name: "min-count at the limit", count: 3, maxCount: -1, minCount: 3},
+		{name: "min-count under the limit", count: 2, maxCount: -1, minCount: 3, wantErr: "found 2 component(s), fewer than --min-count 3"},
+		{name: "count within max-count and min-count", count: 5, maxCount: 10, minCount: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &types.ScanResult{TotalCount: tt.count}
+			options := &types.CLIOptions{FailIfFound: tt.failIfFound, MaxCount: tt.maxCount, MinCount: tt.minCount}
+
+			err := checkThresholds(result, options, loc)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkThresholds() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("checkThresholds() error = nil, want %q", tt.wantErr)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("checkThresholds() error = %q, want %q", err.Error(), tt.wantErr)
+			}
+			if code := ExitCode(err); code != ExitThreshold {
+				t.Errorf("ExitCode() = %d, want %d", code, ExitThreshold)
+			}
+		})
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	threshold := &ThresholdError{Count: 3, Reason: "expected none (--fail-if-found)"}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"threshold error", threshold, ExitThreshold},
+		{"wrapped threshold error", fmt.Errorf("scan of ./src: %w", threshold), ExitThreshold},
+		{"scan error", errors.New("failed to discover files: permission denied"), ExitError},
+		{"flag error", errors.New("unknown flag: --fail-if-fond"), ExitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := ExitCode(tt.err); code != tt.expected {
+				t.Errorf("ExitCode() = %d, want %d", code, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGated(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  types.CLIOptions
+		expected bool
+	}{
+		{"no threshold", types.CLIOptions{MaxCount: -1}, false},
+		{"fail-if-found", types.CLIOptions{FailIfFound: true, MaxCount: -1}, true},
+		{"max-count zero", types.CLIOptions{MaxCount: 0}, true},
+		{"min-count", types.CLIOptions{MaxCount: -1, MinCount: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := gated(&tt.options); result != tt.expected {
+				t.Errorf("gated() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"ui-elf/internal/config"
+	"ui-elf/internal/i18n"
+	"ui-elf/internal/project"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// setupInitCommand adds the init subcommand, which writes a starter configuration file
+// for the frameworks and libraries detected in the project's package.json
+func (c *Controller) setupInitCommand() {
+	initCmd := &cobra.Command{
+		Use:   "init [flags]",
+		Short: "Write a starter .ui-elf.yaml for the detected framework and libraries",
+		Long: `Detect the UI frameworks and component libraries of a project from its
+package.json and write a starter .ui-elf.yaml to the directory.
+
+The starter configuration inventories all components, excludes build outputs
+(dist, build, coverage and the output directories of the detected frameworks)
+and scans the file extensions of the detected frameworks. An existing
+configuration file is only replaced with --force.`,
+		Example: `  # Write .ui-elf.yaml for the project in the current directory
+  ui-elf init
+
+  # Preview the starter configuration of ./app without writing it
+  ui-elf init --directory ./app --stdout`,
+		Args: cobra.NoArgs,
+		RunE: c.initConfig,
+	}
+
+	initCmd.Flags().StringP("directory", "d", ".", "Directory of the project (default: current directory)")
+	initCmd.Flags().Bool("force", false, "Replace an existing configuration file")
+	initCmd.Flags().Bool("stdout", false, "Print the starter configuration instead of writing it")
+
+	c.rootCmd.AddCommand(initCmd)
+}
+
+// initConfig writes the starter configuration of the project
+func (c *Controller) initConfig(cmd *cobra.Command, args []string) error {
+	directory, err := cmd.Flags().GetString("directory")
+	if err != nil {
+		return fmt.Errorf("failed to parse directory flag: %w", err)
+	}
+
+	force, err := cmd.Flags().GetBool("force")
+	if err != nil {
+		return fmt.Errorf("failed to parse force flag: %w", err)
+	}
+
+	toStdout, err := cmd.Flags().GetBool("stdout")
+	if err != nil {
+		return fmt.Errorf("failed to parse stdout flag: %w", err)
+	}
+
+	manifest, err := project.LoadManifest(directory)
+	if err != nil {
+		return err
+	}
+	// Without a package.json, the configuration is framework-agnostic
+	var frameworks, libraries []string
+	if manifest != nil {
+		frameworks = manifest.Frameworks()
+		libraries = manifest.Libraries()
+	}
+
+	if toStdout {
+		return writeStarter(cmd.OutOrStdout(), frameworks, libraries, c.loc)
+	}
+
+	if existing := config.Find(directory); existing != "" && !force {
+		cmd.SilenceUsage = true
+		return c.loc.Errorf("configuration file %s already exists; use --force to replace it", existing)
+	}
+
+	path := filepath.Join(directory, config.FileNames[0])
+	file, err := os.Create(path)
+	if err != nil {
+		return c.loc.Errorf("failed to create config file: %w", err)
+	}
+	if err := writeStarter(file, frameworks, libraries, c.loc); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return c.loc.Errorf("failed to write config file: %w", err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), c.loc.Sprintf("Wrote %s (frameworks: %s; libraries: %s)", path, orNone(frameworks), orNone(libraries)))
+	return nil
+}
+
+// writeStarter writes the starter configuration as YAML, headed by comments on what was detected
+func writeStarter(w io.Writer, frameworks []string, libraries []string, loc *i18n.Localizer) error {
+	fmt.Fprintln(w, "# ui-elf configuration, generated by ui-elf init")
+	fmt.Fprintf(w, "# Detected frameworks: %s\n", orNone(frameworks))
+	fmt.Fprintf(w, "# Detected libraries: %s (only installed libraries are matched; pin them with scan.libraries)\n", orNone(libraries))
+	fmt.Fprintln(w, "# Map the wrapper components of the project to types with scan.map, e.g. map: {button: [AppButton]}")
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(config.Starter(frameworks)); err != nil {
+		return loc.Errorf("failed to encode configuration: %w", err)
+	}
+	return encoder.Close()
+}
+
+// orNone joins values, or returns "none" if there are none
+func orNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"ui-elf/internal/registry"
+	"ui-elf/internal/types"
+
+	"github.com/spf13/cobra"
+)
+
+// setupListTypesCommand adds the list-types subcommand, which prints the component types
+// of the registry with the component names behind them
+func (c *Controller) setupListTypesCommand() {
+	listTypesCmd := &cobra.Command{
+		Use:   "list-types",
+		Short: "List the component types, their libraries and component names",
+		Long: `List every value accepted by --component-type, with the libraries covered
+and the component names matched for each type.
+
+The global and project registry files, --registry sources, --map values and the
+type groups of the configuration files are applied, so the list shows the
+mappings a scan with the same flags would use.`,
+		Example: `  # List the built-in component types
+  ui-elf list-types
+
+  # Include the registry file of a project and an ad-hoc mapping
+  ui-elf list-types --directory ./app --map form=AppForm`,
+		Args: cobra.NoArgs,
+		RunE: c.listTypes,
+	}
+
+	listTypesCmd.Flags().StringP("directory", "d", ".", "Directory whose registry file is loaded (default: current directory)")
+	listTypesCmd.Flags().StringArray("registry", nil, "Registry with additional component mappings: file path, http(s) URL or cmd:<command> (repeatable)")
+	listTypesCmd.Flags().StringArray("map", nil, "Additional component names of a type, as type=Name[,Name] (repeatable)")
+
+	c.rootCmd.AddCommand(listTypesCmd)
+}
+
+// listTypes prints the component types of the registry loaded with the command's flags
+func (c *Controller) listTypes(cmd *cobra.Command, args []string) error {
+	directory, err := cmd.Flags().GetString("directory")
+	if err != nil {
+		return fmt.Errorf("failed to parse directory flag: %w", err)
+	}
+
+	registries, err := cmd.Flags().GetStringArray("registry")
+	if err != nil {
+		return fmt.Errorf("failed to parse registry flag: %w", err)
+	}
+
+	mapValues, err := cmd.Flags().GetStringArray("map")
+	if err != nil {
+		return fmt.Errorf("failed to parse map flag: %w", err)
+	}
+	mappings, err := parseMappings(mapValues, c.loc)
+	if err != nil {
+		return err
+	}
+
+	options := &types.CLIOptions{Directory: directory, Registries: registries, Mappings: mappings}
+	cfg, err := loadConfig(options)
+	if err != nil {
+		return err
+	}
+	applyExtensionConfig(options, cfg.Scan)
+	componentRegistry, err := loadRegistry(options, cfg, c.loc)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), formatTypes(componentRegistry))
+	return nil
+}
+
+// formatTypes lists each component type with its libraries and component names, sorted
+func formatTypes(componentRegistry *registry.ComponentMappingRegistry) string {
+	var sb strings.Builder
+
+	for _, componentType := range componentRegistry.Types() {
+		mapping, _ := componentRegistry.GetMapping(componentType)
+		libraries := "libraries"
+		if len(mapping.Patterns) == 1 {
+			libraries = "library"
+		}
+		fmt.Fprintf(&sb, "%s (%d %s)\n", componentType, len(mapping.Patterns), libraries)
+		for _, library := range sortedLibraries(mapping.Patterns) {
+			fmt.Fprintf(&sb, "  %s: %s\n", library, strings.Join(mapping.Patterns[library], ", "))
+		}
+		if len(mapping.Exclude) > 0 {
+			fmt.Fprintf(&sb, "  excluded: %s\n", strings.Join(mapping.Exclude, ", "))
+		}
+		if len(mapping.Imperative) > 0 {
+			sb.WriteString("  API calls:\n")
+			for _, library := range sortedLibraries(mapping.Imperative) {
+				fmt.Fprintf(&sb, "    %s: %s\n", library, strings.Join(mapping.Imperative[library], ", "))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "%s\n  Every component imported or registered in the scanned files\n", types.ComponentTypeCustom)
+	fmt.Fprintf(&sb, "\n%s\n  Every component found, except HTML elements (inventory)\n", types.ComponentTypeAll)
+
+	for _, group := range componentRegistry.Groups() {
+		members, _ := componentRegistry.GroupMembers(group)
+		fmt.Fprintf(&sb, "\n%s (group)\n  %s\n", group, strings.Join(members, " + "))
+	}
+
+	deprecations := componentRegistry.Deprecations()
+	if len(deprecations) > 0 {
+		fmt.Fprintf(&sb, "\n%s\n", types.ComponentTypeDeprecated)
+		for _, name := range slices.Sorted(maps.Keys(deprecations)) {
+			fmt.Fprintf(&sb, "  %s -> %s\n", name, deprecations[name])
+		}
+	}
+	return sb.String()
+}
+
+// sortedLibraries returns the library names of a mapping, sorted
+func sortedLibraries(libraries map[string][]string) []string {
+	names := make([]string, 0, len(libraries))
+	for library := range libraries {
+		names = append(names, library)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"io"
+	"log/slog"
+
+	"ui-elf/internal/i18n"
+	"ui-elf/internal/types"
+)
+
+// newLogger creates the logger of a scan, writing to w in the format of --log-format
+// Debug records are logged with --verbose, none with --quiet, and warnings otherwise; errors are in the language of loc
+func newLogger(w io.Writer, options *types.CLIOptions, loc *i18n.Localizer) (*slog.Logger, error) {
+	if options.Quiet {
+		return slog.New(slog.DiscardHandler), nil
+	}
+
+	level := slog.LevelWarn
+	if options.Verbose {
+		level = slog.LevelDebug
+	}
+	handlerOptions := &slog.HandlerOptions{Level: level}
+
+	switch options.LogFormat {
+	case "text":
+		return slog.New(slog.NewTextHandler(w, handlerOptions)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, handlerOptions)), nil
+	default:
+		return nil, loc.Errorf("invalid log format '%s': must be one of: text, json", options.LogFormat)
+	}
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"ui-elf/internal/i18n"
+)
+
+// isTerminal checks if a reader or writer is an interactive terminal rather than a pipe or file
+func isTerminal(stream any) bool {
+	file, ok := stream.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// promptChoice lists the choices on out, numbered, and reads the choice from in, by number or name
+// Invalid answers are asked again; the end of the input is an error
+func promptChoice(in io.Reader, out io.Writer, loc *i18n.Localizer, question string, choices []string) (string, error) {
+	fmt.Fprintln(out, question)
+	for i, choice := range choices {
+		fmt.Fprintf(out, "  %2d) %s\n", i+1, choice)
+	}
+
+	answers := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, loc.Sprintf("Choose 1-%d or a name: ", len(choices)))
+		if !answers.Scan() {
+			fmt.Fprintln(out)
+			return "", loc.Errorf("no choice made")
+		}
+		answer := strings.TrimSpace(answers.Text())
+		if number, err := strconv.Atoi(answer); err == nil && number >= 1 && number <= len(choices) {
+			return choices[number-1], nil
+		}
+		if index := slices.Index(choices, strings.ToLower(answer)); index >= 0 {
+			return choices[index], nil
+		}
+		if answer != "" {
+			fmt.Fprintln(out, loc.Sprintf("invalid choice '%s'", answer))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/i18n"
+)
+
+func TestPromptChoice(t *testing.T) {
+	loc, err := i18n.New("en")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	choices := []string{"vue", "react", "angular"}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		invalid  []string // Answers reported as invalid before the choice
+		wantErr  bool
+	}{
+		{name: "number", input: "2\n", expected: "react"},
+		{name: "name", input: "angular\n", expected: "angular"},
+		{name: "name in another case", input: " Vue \n", expected: "vue"},
+		{name: "empty answer asked again", input: "\n\n3\n", expected: "angular"},
+		{name: "zero", input: "0\n1\n", expected: "vue", invalid: []string{"0"}},
+		{name: "number out of range", input: "4\n2\n", expected: "react", invalid: []string{"4"}},
+		{name: "negative number", input: "-1\n2\n", expected: "react", invalid: []string{"-1"}},
+		{name: "unknown name", input: "svelte\nvue\n", expected: "vue", invalid: []string{"svelte"}},
+		{name: "partial name", input: "re\nreact\n", expected: "react", invalid: []string{"re"}},
+		{name: "no input", input: "", wantErr: true},
+		{name: "end of input after invalid answers", input: "4\nsvelte\n", invalid: []string{"4", "svelte"}, wantErr: true},
+		{name: "answer without newline", input: "1", expected: "vue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			choice, err := promptChoice(strings.NewReader(tt.input), &out, loc, "Framework?", choices)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("promptChoice() = %q, want an error", choice)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("promptChoice() error = %v", err)
+				}
+				if choice != tt.expected {
+					t.Errorf("promptChoice() = %q, want %q", choice, tt.expected)
+				}
+			}
+
+			if count := strings.Count(out.String(), "invalid choice"); count != len(tt.invalid) {
+				t.Errorf("Reported %d invalid choices, want %d: %q", count, len(tt.invalid), out.String())
+			}
+			for _, answer := range tt.invalid {
+				if !strings.Contains(out.String(), "invalid choice '"+answer+"'") {
+					t.Errorf("Output does not report the invalid choice %q: %q", answer, out.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"ui-elf/internal/config"
+	"ui-elf/internal/i18n"
+	"ui-elf/internal/registry"
+	"ui-elf/internal/types"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// setupRegistryCommand adds the registry subcommand, whose export subcommand prints the
+// effective component registry in the registry file format
+func (c *Controller) setupRegistryCommand() {
+	registryCmd := &cobra.Command{
+		Use:   "registry",
+		Short: "Work with the component registry",
+		Args:  cobra.NoArgs,
+	}
+
+	exportCmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: "Print the effective component registry as YAML or JSON",
+		Long: `Print the component registry a scan would use, merged from the built-in
+mappings, the global and project registry files, --registry sources and --map
+values, in the registry file format.
+
+The output can be committed as a snapshot, reviewed in pull requests, fed to
+other tooling, or loaded again with --registry.`,
+		Example: `  # Snapshot the registry of a project
+  ui-elf registry export --directory ./app > ui-elf.registry.snapshot.yaml
+
+  # Export the org registry merged over the built-in mappings as JSON
+  ui-elf registry export --registry https://design.example.com/ui-elf.registry.yaml --format json`,
+		Args: cobra.NoArgs,
+		RunE: c.exportRegistry,
+	}
+
+	exportCmd.Flags().StringP("directory", "d", ".", "Directory whose registry file is loaded (default: current directory)")
+	exportCmd.Flags().StringArray("registry", nil, "Registry with additional component mappings: file path, http(s) URL or cmd:<command> (repeatable)")
+	exportCmd.Flags().StringArray("map", nil, "Additional component names of a type, as type=Name[,Name] (repeatable)")
+	exportCmd.Flags().String("format", "yaml", "Output format: yaml or json")
+
+	registryCmd.AddCommand(exportCmd)
+	c.rootCmd.AddCommand(registryCmd)
+}
+
+// exportRegistry prints the registry loaded with the command's flags in the requested format
+func (c *Controller) exportRegistry(cmd *cobra.Command, args []string) error {
+	directory, err := cmd.Flags().GetString("directory")
+	if err != nil {
+		return fmt.Errorf("failed to parse directory flag: %w", err)
+	}
+
+	registries, err := cmd.Flags().GetStringArray("registry")
+	if err != nil {
+		return fmt.Errorf("failed to parse registry flag: %w", err)
+	}
+
+	mapValues, err := cmd.Flags().GetStringArray("map")
+	if err != nil {
+		return fmt.Errorf("failed to parse map flag: %w", err)
+	}
+	mappings, err := parseMappings(mapValues, c.loc)
+	if err != nil {
+		return err
+	}
+
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return fmt.Errorf("failed to parse format flag: %w", err)
+	}
+	if format != "yaml" && format != "json" {
+		return c.loc.Errorf("invalid format '%s': must be one of: yaml, json", format)
+	}
+
+	options := &types.CLIOptions{Directory: directory, Registries: registries, Mappings: mappings}
+	cfg, err := loadConfig(options)
+	if err != nil {
+		return err
+	}
+	applyExtensionConfig(options, cfg.Scan)
+
+	// Type groups are part of the configuration, not of the registry file format
+	componentRegistry, err := loadRegistry(options, &config.Config{}, c.loc)
+	if err != nil {
+		return err
+	}
+
+	return writeRegistry(cmd.OutOrStdout(), componentRegistry, format, c.loc)
+}
+
+// writeRegistry encodes the registry as YAML or JSON
+func writeRegistry(w io.Writer, componentRegistry *registry.ComponentMappingRegistry, format string, loc *i18n.Localizer) error {
+	if format == "json" {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(componentRegistry); err != nil {
+			return loc.Errorf("failed to encode registry: %w", err)
+		}
+		return nil
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(componentRegistry); err != nil {
+		return loc.Errorf("failed to encode registry: %w", err)
+	}
+	return encoder.Close()
+}
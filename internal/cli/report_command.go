@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"ui-elf/internal/history"
+	"ui-elf/internal/output"
+	"ui-elf/internal/project"
+
+	"github.com/spf13/cobra"
+)
+
+// setupReportCommand adds the report subcommand, which records the summary of a scan in a
+// history file and prints the trend of the component counts
+func (c *Controller) setupReportCommand() {
+	reportCmd := &cobra.Command{
+		Use:   "report [flags] [directory...]",
+		Short: "Record the scan summary in a history file and show the component count trend",
+		Long: `Scan like ui-elf does, then append the summary of the scan (time, git
+commit, component type, total and counts per component) as a JSON line to the
+--history file, and print the trend of the totals recorded so far.
+
+Run it in CI on the main branch, with the history file committed or kept as an
+artifact, to follow a migration over time. --no-scan prints the trend of the
+history file without scanning. The change column compares each total with the
+previous entry of the same component type.`,
+		Example: `  # Record the deprecated components of this commit and show the trend
+  ui-elf report -t deprecated -d ./src --history .ui-elf/history.jsonl
+
+  # Show the last 10 entries without scanning
+  ui-elf report --no-scan --last 10`,
+		Args: cobra.ArbitraryArgs,
+		RunE: c.report,
+	}
+
+	reportCmd.Flags().String("history", history.DefaultPath, "History file the scan summaries are appended to, as JSON lines")
+	reportCmd.Flags().Bool("no-scan", false, "Only print the trend of the history file, without scanning")
+	reportCmd.Flags().Int("last", 20, "Entries shown in the trend, most recent last (0: all)")
+	addComponentFlags(reportCmd)
+	addScanFlags(reportCmd)
+
+	c.rootCmd.AddCommand(reportCmd)
+}
+
+// report scans and appends the summary to the history file, unless --no-scan is given, and
+// prints the trend of the history file
+func (c *Controller) report(cmd *cobra.Command, args []string) error {
+	historyPath, err := cmd.Flags().GetString("history")
+	if err != nil {
+		return fmt.Errorf("failed to parse history flag: %w", err)
+	}
+
+	noScan, err := cmd.Flags().GetBool("no-scan")
+	if err != nil {
+		return fmt.Errorf("failed to parse no-scan flag: %w", err)
+	}
+
+	last, err := cmd.Flags().GetInt("last")
+	if err != nil {
+		return fmt.Errorf("failed to parse last flag: %w", err)
+	}
+	if last < 0 {
+		return c.loc.Errorf("invalid last %d: must be 0 (all) or more", last)
+	}
+
+	if !noScan {
+		options, cfg, err := c.loadOptions(cmd, args)
+		if err != nil {
+			return err
+		}
+		result, err := c.scan(cmd, options, cfg)
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+		entry := history.NewEntry(result, project.HeadCommit(options.Directory), time.Now())
+		if err := history.Append(historyPath, entry); err != nil {
+			return err
+		}
+	}
+
+	entries, err := history.Load(historyPath)
+	if err != nil {
+		return err
+	}
+	formatter := output.NewOutputFormatter()
+	formatter.SetLocalizer(c.loc)
+	fmt.Fprint(cmd.OutOrStdout(), formatter.FormatTrend(entries, historyPath, last))
+	return nil
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"fmt"
+
+	"ui-elf/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// setupSchemaCommand adds the schema subcommand, which prints the JSON Schema of the results
+func (c *Controller) setupSchemaCommand() {
+	schemaCmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the JSON results",
+		Long: `Print the JSON Schema (draft 2020-12) of the results written with --output
+json, to validate them or generate client types in other languages.
+
+Every result carries the schemaVersion of its format. Fields are only added
+within a major version, so readers should ignore unknown fields; a new major
+version marks changes that break existing readers.`,
+		Example: `  # Save the schema next to the results
+  ui-elf schema > ui-elf-results.schema.json
+
+  # Generate TypeScript types of the results
+  ui-elf schema | npx json-schema-to-typescript > ui-elf-results.d.ts`,
+		Args: cobra.NoArgs,
+		RunE: c.printSchema,
+	}
+
+	c.rootCmd.AddCommand(schemaCmd)
+}
+
+// printSchema prints the JSON Schema of the results
+func (c *Controller) printSchema(cmd *cobra.Command, args []string) error {
+	schema, err := output.JSONSchema()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), schema)
+	return nil
+}
@@ -0,0 +1,305 @@
+// Package config loads the optional global and project configuration files.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"ui-elf/internal/project"
+	"ui-elf/internal/registry"
+	"ui-elf/internal/scanner"
+	"ui-elf/internal/types"
+)
+
+// FileNames lists the configuration file names looked up in the scanned directory, in order
+// JSON files are parsed with the YAML parser, as JSON is a subset of YAML
+var FileNames = []string{".ui-elf.yaml", ".ui-elf.yml", ".ui-elf.json", "ui-elf.yaml", "ui-elf.yml", "ui-elf.json"}
+
+// GlobalFileNames lists the configuration file names looked up in the global directory, in order
+var GlobalFileNames = []string{"config.yaml", "config.yml", "config.json"}
+
+// Config holds the configuration of one file, or the merged configuration of several
+type Config struct {
+	HTML           HTMLConfig          `yaml:"html,omitempty"`
+	CustomElements CustomElementConfig `yaml:"customElements,omitempty"`
+	SVG            SVGConfig           `yaml:"svg,omitempty"`
+	Scan           ScanConfig          `yaml:"scan,omitempty"`
+	Groups         map[string][]string `yaml:"groups,omitempty"` // Composite type name -> member types (e.g. interactive: [button, menu, tabs])
+	Sources        []string            `yaml:"-"`                // Paths of the merged files, lowest precedence first
+}
+
+// HTMLConfig configures which tags are plain HTML
+type HTMLConfig struct {
+	Tags    []string `yaml:"tags,omitempty"`    // Tag names treated as HTML in addition to the built-in list (e.g. font, center); "!tag" removes one (e.g. !button)
+	Replace bool     `yaml:"replace,omitempty"` // Tags replace the built-in list instead of extending it
+}
+
+// CustomElementConfig configures which hyphenated custom element tags are reported
+// Entries are glob patterns (e.g. "font-awesome-*")
+type CustomElementConfig struct {
+	Allow []string `yaml:"allow,omitempty"` // When set, only matching custom elements are reported
+	Deny  []string `yaml:"deny,omitempty"`  // Custom elements never reported
+}
+
+// SVGConfig configures the handling of SVG primitives
+// (camelCase SVG tags such as <linearGradient> and react-native-svg components)
+type SVGConfig struct {
+	Mode string `yaml:"mode,omitempty"` // "exclude" (default) or "classify" to report them with usage kind "svg"
+}
+
+// ScanConfig holds defaults for the scan flags; flags given on the command line take precedence
+// Unset fields (nil) keep the flag defaults. Exclude patterns, registries and mappings extend
+// those given on the command line instead
+type ScanConfig struct {
+	ComponentType    *string             `yaml:"componentType,omitempty"`
+	ComponentName    []string            `yaml:"componentName,omitempty"` // Components searched with the custom type, like --component-name
+	Filter           []string            `yaml:"filter,omitempty"`
+	Exclude          []string            `yaml:"exclude,omitempty"`    // Additional patterns of paths not scanned
+	Registries       []string            `yaml:"registries,omitempty"` // Registry sources; relative file paths are resolved against the configuration file
+	Map              map[string][]string `yaml:"map,omitempty"`        // Additional component names per type, like --map
+	MaxDepth         *int                `yaml:"maxDepth,omitempty"`
+	Concurrency      *int                `yaml:"concurrency,omitempty"`
+	PathStyle        *string             `yaml:"pathStyle,omitempty"`
+	NoIgnore         *bool               `yaml:"noIgnore,omitempty"`
+	Output           *string             `yaml:"output,omitempty"`
+	OutputFile       *string             `yaml:"outputFile,omitempty"`
+	Compress         *bool               `yaml:"compress,omitempty"`
+	Sort             *string             `yaml:"sort,omitempty"`
+	Desc             *bool               `yaml:"desc,omitempty"`
+	GroupBy          *string             `yaml:"groupBy,omitempty"`
+	Layout           *string             `yaml:"layout,omitempty"`
+	Hyperlinks       *string             `yaml:"hyperlinks,omitempty"`
+	LinkTemplate     *string             `yaml:"linkTemplate,omitempty"`
+	Summary          *bool               `yaml:"summary,omitempty"`
+	ByDirectory      *bool               `yaml:"byDirectory,omitempty"`
+	DirectoryDepth   *int                `yaml:"directoryDepth,omitempty"`
+	Top              *int                `yaml:"top,omitempty"`
+	Profile          *string             `yaml:"profile,omitempty"`
+	ParserEngine     *string             `yaml:"parserEngine,omitempty"`
+	ExcludeStories   *bool               `yaml:"excludeStories,omitempty"`
+	IncludeTests     *bool               `yaml:"includeTests,omitempty"`
+	IncludeMarkdown  *bool               `yaml:"includeMarkdown,omitempty"`
+	IncludeAlpine    *bool               `yaml:"includeAlpine,omitempty"`
+	IncludeGenerated *bool               `yaml:"includeGenerated,omitempty"`
+	WithProps        *bool               `yaml:"withProps,omitempty"`
+	Snippet          *bool               `yaml:"snippet,omitempty"`
+	Context          *int                `yaml:"context,omitempty"`
+	CountDuplicates  *bool               `yaml:"countDuplicates,omitempty"`
+	AllLibraries     *bool               `yaml:"allLibraries,omitempty"`
+	Libraries        []string            `yaml:"libraries,omitempty"`
+	Match            *string             `yaml:"match,omitempty"`
+	Ext              []string            `yaml:"ext,omitempty"` // Scanned file extensions, like --ext
+	FailIfFound      *bool               `yaml:"failIfFound,omitempty"`
+	MaxCount         *int                `yaml:"maxCount,omitempty"`
+	MinCount         *int                `yaml:"minCount,omitempty"`
+	Badge            *string             `yaml:"badge,omitempty"`
+	BadgeLabel       *string             `yaml:"badgeLabel,omitempty"`
+}
+
+// Find returns the path of the configuration file in dir, or "" if there is none
+func Find(dir string) string {
+	return project.FindFile(dir, FileNames)
+}
+
+// FindGlobal returns the path of the global configuration file, or "" if there is none
+func FindGlobal() string {
+	dir := GlobalDir()
+	if dir == "" {
+		return ""
+	}
+	return project.FindFile(dir, GlobalFileNames)
+}
+
+// GlobalDir returns the directory of the user-wide configuration and registry files:
+// $XDG_CONFIG_HOME/ui-elf, or ~/.config/ui-elf. Returns "" if the home directory is unknown
+func GlobalDir() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "ui-elf")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "ui-elf")
+}
+
+// LoadLayers loads the global and the project configuration file and merges the project
+// configuration over the global one. Empty paths are skipped
+func LoadLayers(global string, project string) (*Config, error) {
+	merged := &Config{}
+	for _, layer := range []struct {
+		path string
+		load func(string) (*Config, error)
+	}{{global, LoadGlobal}, {project, Load}} {
+		if layer.path == "" {
+			continue
+		}
+		cfg, err := layer.load(layer.path)
+		if err != nil {
+			return nil, err
+		}
+		merged.Merge(cfg)
+		merged.Sources = append(merged.Sources, layer.path)
+	}
+	return merged, nil
+}
+
+// Merge merges other over the configuration
+// Tag, custom element, exclude, registry and mapping lists are combined; HTML tags replacing the
+// built-in list drop the tags set here. Other settings of other, including the groups of the
+// same name, replace those set here
+func (c *Config) Merge(other *Config) {
+	if other.HTML.Replace {
+		c.HTML = HTMLConfig{Replace: true}
+	}
+	c.HTML.Tags = append(c.HTML.Tags, other.HTML.Tags...)
+	c.CustomElements.Allow = append(c.CustomElements.Allow, other.CustomElements.Allow...)
+	c.CustomElements.Deny = append(c.CustomElements.Deny, other.CustomElements.Deny...)
+	if other.SVG.Mode != "" {
+		c.SVG.Mode = other.SVG.Mode
+	}
+	for name, members := range other.Groups {
+		if c.Groups == nil {
+			c.Groups = make(map[string][]string)
+		}
+		c.Groups[name] = members
+	}
+
+	scan := other.Scan
+	c.Scan.Exclude = append(c.Scan.Exclude, scan.Exclude...)
+	c.Scan.Registries = append(c.Scan.Registries, scan.Registries...)
+	for componentType, names := range scan.Map {
+		if c.Scan.Map == nil {
+			c.Scan.Map = make(map[string][]string)
+		}
+		c.Scan.Map[componentType] = append(c.Scan.Map[componentType], names...)
+	}
+	mergeValue(&c.Scan.ComponentType, scan.ComponentType)
+	if scan.ComponentName != nil {
+		c.Scan.ComponentName = scan.ComponentName
+	}
+	if scan.Filter != nil {
+		c.Scan.Filter = scan.Filter
+	}
+	if scan.Libraries != nil {
+		c.Scan.Libraries = scan.Libraries
+	}
+	if scan.Ext != nil {
+		c.Scan.Ext = scan.Ext
+	}
+	mergeValue(&c.Scan.MaxDepth, scan.MaxDepth)
+	mergeValue(&c.Scan.Concurrency, scan.Concurrency)
+	mergeValue(&c.Scan.PathStyle, scan.PathStyle)
+	mergeValue(&c.Scan.NoIgnore, scan.NoIgnore)
+	mergeValue(&c.Scan.Output, scan.Output)
+	mergeValue(&c.Scan.OutputFile, scan.OutputFile)
+	mergeValue(&c.Scan.Compress, scan.Compress)
+	mergeValue(&c.Scan.Sort, scan.Sort)
+	mergeValue(&c.Scan.Desc, scan.Desc)
+	mergeValue(&c.Scan.GroupBy, scan.GroupBy)
+	mergeValue(&c.Scan.Layout, scan.Layout)
+	mergeValue(&c.Scan.Hyperlinks, scan.Hyperlinks)
+	mergeValue(&c.Scan.LinkTemplate, scan.LinkTemplate)
+	mergeValue(&c.Scan.Summary, scan.Summary)
+	mergeValue(&c.Scan.ByDirectory, scan.ByDirectory)
+	mergeValue(&c.Scan.DirectoryDepth, scan.DirectoryDepth)
+	mergeValue(&c.Scan.Top, scan.Top)
+	mergeValue(&c.Scan.Profile, scan.Profile)
+	mergeValue(&c.Scan.ParserEngine, scan.ParserEngine)
+	mergeValue(&c.Scan.ExcludeStories, scan.ExcludeStories)
+	mergeValue(&c.Scan.IncludeTests, scan.IncludeTests)
+	mergeValue(&c.Scan.IncludeMarkdown, scan.IncludeMarkdown)
+	mergeValue(&c.Scan.IncludeAlpine, scan.IncludeAlpine)
+	mergeValue(&c.Scan.IncludeGenerated, scan.IncludeGenerated)
+	mergeValue(&c.Scan.WithProps, scan.WithProps)
+	mergeValue(&c.Scan.Snippet, scan.Snippet)
+	mergeValue(&c.Scan.Context, scan.Context)
+	mergeValue(&c.Scan.CountDuplicates, scan.CountDuplicates)
+	mergeValue(&c.Scan.AllLibraries, scan.AllLibraries)
+	mergeValue(&c.Scan.Match, scan.Match)
+	mergeValue(&c.Scan.FailIfFound, scan.FailIfFound)
+	mergeValue(&c.Scan.MaxCount, scan.MaxCount)
+	mergeValue(&c.Scan.MinCount, scan.MinCount)
+	mergeValue(&c.Scan.Badge, scan.Badge)
+	mergeValue(&c.Scan.BadgeLabel, scan.BadgeLabel)
+}
+
+// mergeValue replaces *dst with src if src is set
+func mergeValue[T any](dst **T, src *T) {
+	if src != nil {
+		*dst = src
+	}
+}
+
+// Load reads and validates the project configuration file at path
+// Project files come with the scanned code, so their registries must be files: URL and command
+// sources, which would run or fetch code on a plain scan, are only accepted from the global
+// configuration or --registry
+func Load(path string) (*Config, error) {
+	cfg, err := LoadGlobal(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, source := range cfg.Scan.Registries {
+		if isRemoteSource(source) {
+			return nil, fmt.Errorf("registry source '%s' in %s is not allowed in a project configuration file: give URL and cmd: sources with --registry or in the global configuration", source, path)
+		}
+	}
+	return cfg, nil
+}
+
+// LoadGlobal reads and validates the global configuration file at path, which may list registry
+// sources of any kind
+func LoadGlobal(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	for _, pattern := range append(cfg.CustomElements.Allow, cfg.CustomElements.Deny...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid custom element pattern '%s' in %s: %w", pattern, path, err)
+		}
+	}
+
+	// Registry files are relative to the configuration file, not the working directory
+	for i, source := range cfg.Scan.Registries {
+		if !isRemoteSource(source) && !filepath.IsAbs(source) {
+			cfg.Scan.Registries[i] = filepath.Join(filepath.Dir(path), source)
+		}
+	}
+
+	switch cfg.SVG.Mode {
+	case "", scanner.SVGModeExclude, scanner.SVGModeClassify:
+	default:
+		return nil, fmt.Errorf("invalid svg mode '%s' in %s: must be one of: %s, %s", cfg.SVG.Mode, path, scanner.SVGModeExclude, scanner.SVGModeClassify)
+	}
+
+	return &cfg, nil
+}
+
+// isRemoteSource checks if a registry source is a URL or a command rather than a file path
+func isRemoteSource(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") ||
+		strings.HasPrefix(source, registry.CommandPrefix)
+}
+
+// TagPolicy returns the tag policy configured for the scanner
+func (c *Config) TagPolicy() types.TagPolicy {
+	return types.TagPolicy{
+		HTMLTags:            c.HTML.Tags,
+		ReplaceHTMLTags:     c.HTML.Replace,
+		AllowCustomElements: c.CustomElements.Allow,
+		DenyCustomElements:  c.CustomElements.Deny,
+		SVG:                 c.SVG.Mode,
+	}
+}
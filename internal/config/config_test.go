@@ -0,0 +1,253 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestFind(t *testing.T) {
+	tempDir := t.TempDir()
+
+	if path := Find(tempDir); path != "" {
+		t.Errorf("Find() = %q, want \"\" for a directory without config file", path)
+	}
+
+	jsonPath := filepath.Join(tempDir, ".ui-elf.json")
+	if err := os.WriteFile(jsonPath, []byte("{}"), 0644); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	if path := Find(tempDir); path != jsonPath {
+		t.Errorf("Find() = %q, want %q", path, jsonPath)
+	}
+
+	yamlPath := filepath.Join(tempDir, ".ui-elf.yaml")
+	if err := os.WriteFile(yamlPath, []byte(""), 0644); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	if path := Find(tempDir); path != yamlPath {
+		t.Errorf("Find() = %q, want %q (YAML takes precedence)", path, yamlPath)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	expected := types.TagPolicy{
+		HTMLTags:            []string{"font", "center"},
+		AllowCustomElements: []string{"q-*", "my-*"},
+		DenyCustomElements:  []string{"font-awesome-*"},
+		SVG:                 "classify",
+	}
+
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+	}{
+		{
+			name:     "yaml",
+			fileName: ".ui-elf.yaml",
+			content: `html:
+  tags: [font, center]
+customElements:
+  allow:
+    - "q-*"
+    - "my-*"
+  deny:
+    - "font-awesome-*"
+svg:
+  mode: classify
+`,
+		},
+		{
+			name:     "json",
+			fileName: ".ui-elf.json",
+			content: `{
+  "html": { "tags": ["font", "center"] },
+  "customElements": { "allow": ["q-*", "my-*"], "deny": ["font-awesome-*"] },
+  "svg": { "mode": "classify" }
+}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.fileName)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to create config file: %v", err)
+			}
+
+			cfg, err := Load(path)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if policy := cfg.TagPolicy(); !reflect.DeepEqual(policy, expected) {
+				t.Errorf("TagPolicy() = %+v, want %+v", policy, expected)
+			}
+		})
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"invalid yaml", "html: [", "failed to parse config file"},
+		{"invalid pattern", "customElements:\n  deny: [\"font-[\"]\n", "invalid custom element pattern 'font-['"},
+		{"invalid svg mode", "svg:\n  mode: hide\n", "invalid svg mode 'hide'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".ui-elf.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to create config file: %v", err)
+			}
+
+			_, err := Load(path)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Load() error = %v, want error containing %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFindGlobal(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+
+	if dir := GlobalDir(); dir != filepath.Join(configHome, "ui-elf") {
+		t.Errorf("GlobalDir() = %q, want %q", dir, filepath.Join(configHome, "ui-elf"))
+	}
+	if path := FindGlobal(); path != "" {
+		t.Errorf("FindGlobal() = %q, want \"\" without global config file", path)
+	}
+
+	path := filepath.Join(configHome, "ui-elf", "config.yml")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create config directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(""), 0644); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	if found := FindGlobal(); found != path {
+		t.Errorf("FindGlobal() = %q, want %q", found, path)
+	}
+}
+
+func TestLoadLayers(t *testing.T) {
+	dir := t.TempDir()
+	global := filepath.Join(dir, "config.yaml")
+	project := filepath.Join(dir, ".ui-elf.yaml")
+	if err := os.WriteFile(global, []byte("html:\n  tags: [font]\nsvg:\n  mode: classify\nscan:\n  output: json\n  snippet: true\n  filter: [src]\n  exclude: [legacy]\n  map:\n    form: [AppForm]\ngroups:\n  interactive: [button, menu]\n  overlays: [dialog, modal]\n"), 0644); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	if err := os.WriteFile(project, []byte("html:\n  tags: [center]\nscan:\n  output: both\n  filter: [app]\n  exclude: [generated]\n  map:\n    form: [XForm]\ngroups:\n  interactive: [button, menu, tabs]\n"), 0644); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	cfg, err := LoadLayers(global, project)
+	if err != nil {
+		t.Fatalf("LoadLayers() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(cfg.Sources, []string{global, project}) {
+		t.Errorf("Sources = %v, want [%s %s]", cfg.Sources, global, project)
+	}
+	if !reflect.DeepEqual(cfg.HTML.Tags, []string{"font", "center"}) {
+		t.Errorf("HTML.Tags = %v, want the tags of both layers", cfg.HTML.Tags)
+	}
+	if cfg.SVG.Mode != "classify" {
+		t.Errorf("SVG.Mode = %q, want the global classify kept", cfg.SVG.Mode)
+	}
+	if cfg.Scan.Output == nil || *cfg.Scan.Output != "both" {
+		t.Errorf("Scan.Output = %v, want the project value both", cfg.Scan.Output)
+	}
+	if cfg.Scan.Snippet == nil || !*cfg.Scan.Snippet {
+		t.Errorf("Scan.Snippet = %v, want the global value true", cfg.Scan.Snippet)
+	}
+	if !reflect.DeepEqual(cfg.Scan.Filter, []string{"app"}) {
+		t.Errorf("Scan.Filter = %v, want the project filter to replace the global one", cfg.Scan.Filter)
+	}
+	expectedGroups := map[string][]string{"interactive": {"button", "menu", "tabs"}, "overlays": {"dialog", "modal"}}
+	if !reflect.DeepEqual(cfg.Groups, expectedGroups) {
+		t.Errorf("Groups = %v, want %v", cfg.Groups, expectedGroups)
+	}
+	if !reflect.DeepEqual(cfg.Scan.Exclude, []string{"legacy", "generated"}) {
+		t.Errorf("Scan.Exclude = %v, want the patterns of both layers", cfg.Scan.Exclude)
+	}
+	if !reflect.DeepEqual(cfg.Scan.Map, map[string][]string{"form": {"AppForm", "XForm"}}) {
+		t.Errorf("Scan.Map = %v, want the names of both layers", cfg.Scan.Map)
+	}
+	if cfg.Scan.WithProps != nil {
+		t.Errorf("Scan.WithProps = %v, want nil when no layer sets it", *cfg.Scan.WithProps)
+	}
+
+	if _, err := LoadLayers(global, filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Errorf("LoadLayers() with a missing file: expected an error")
+	}
+}
+
+func TestMerge_ReplaceHTMLTags(t *testing.T) {
+	cfg := &Config{HTML: HTMLConfig{Tags: []string{"font"}}}
+	cfg.Merge(&Config{HTML: HTMLConfig{Tags: []string{"div", "span"}, Replace: true}})
+	cfg.Merge(&Config{HTML: HTMLConfig{Tags: []string{"!span"}}})
+
+	expected := types.TagPolicy{HTMLTags: []string{"div", "span", "!span"}, ReplaceHTMLTags: true}
+	if policy := cfg.TagPolicy(); !reflect.DeepEqual(policy, expected) {
+		t.Errorf("TagPolicy() = %+v, want %+v", policy, expected)
+	}
+}
+
+func TestLoad_Registries(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ui-elf.json")
+	content := `{"scan": {"componentType": "widget", "registries": ["regs/acme.yaml", "/etc/ui-elf/org.yaml", "https://example.com/r.yaml", "cmd:./registry.sh"]}}`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	if found := Find(dir); found != path {
+		t.Errorf("Find() = %q, want %q", found, path)
+	}
+
+	cfg, err := LoadGlobal(path)
+	if err != nil {
+		t.Fatalf("LoadGlobal() error = %v", err)
+	}
+
+	if cfg.Scan.ComponentType == nil || *cfg.Scan.ComponentType != "widget" {
+		t.Errorf("Scan.ComponentType = %v, want widget", cfg.Scan.ComponentType)
+	}
+	expected := []string{filepath.Join(dir, "regs", "acme.yaml"), "/etc/ui-elf/org.yaml", "https://example.com/r.yaml", "cmd:./registry.sh"}
+	if !reflect.DeepEqual(cfg.Scan.Registries, expected) {
+		t.Errorf("Scan.Registries = %v, want %v", cfg.Scan.Registries, expected)
+	}
+}
+
+func TestLoad_RemoteRegistries(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, source := range []string{"cmd:touch pwned", "https://example.com/r.yaml", "http://example.com/r.yaml"} {
+		t.Run(source, func(t *testing.T) {
+			path := filepath.Join(dir, ".ui-elf.yaml")
+			if err := os.WriteFile(path, []byte("scan:\n  registries: [\""+source+"\"]\n"), 0644); err != nil {
+				t.Fatalf("Failed to create config file: %v", err)
+			}
+
+			if _, err := Load(path); err == nil || !strings.Contains(err.Error(), "not allowed in a project configuration file") {
+				t.Errorf("Load() error = %v, want the %s source rejected", err, source)
+			}
+			if _, err := LoadLayers("", path); err == nil {
+				t.Errorf("LoadLayers() error = nil, want the %s source of the project layer rejected", source)
+			}
+			if _, err := LoadLayers(path, ""); err != nil {
+				t.Errorf("LoadLayers() error = %v, want the %s source of the global layer accepted", err, source)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"slices"
+)
+
+// starterExcludes lists the build outputs excluded by every starter configuration
+var starterExcludes = []string{"**/dist/**", "**/build/**", "**/coverage/**", "**/storybook-static/**"}
+
+// frameworkExcludes lists the build outputs and caches of each framework
+var frameworkExcludes = map[string][]string{
+	"nuxt":      {"**/.nuxt/**", "**/.output/**"},
+	"next":      {"**/.next/**", "**/out/**"},
+	"sveltekit": {"**/.svelte-kit/**"},
+	"angular":   {"**/.angular/**"},
+}
+
+// frameworkExtensions lists the scanned file extensions of each framework, as --ext values
+var frameworkExtensions = map[string][]string{
+	"vue":       {".vue", ".js", ".ts"},
+	"nuxt":      {".vue", ".js", ".ts"},
+	"react":     {".jsx", ".tsx", ".js", ".ts"},
+	"next":      {".jsx", ".tsx", ".js", ".ts"},
+	"preact":    {".jsx", ".tsx", ".js", ".ts"},
+	"solid":     {".jsx", ".tsx", ".js", ".ts"},
+	"qwik":      {".jsx", ".tsx", ".js", ".ts"},
+	"svelte":    {".svelte=.vue", ".js", ".ts"},
+	"sveltekit": {".svelte=.vue", ".js", ".ts"},
+	"angular":   {".ts"},
+	"lit":       {".js", ".ts"},
+	"ember":     {".hbs", ".js", ".ts"},
+}
+
+// Starter returns the starter configuration of a project using the given frameworks
+// It inventories all components, skips build outputs and scans the extensions of the
+// frameworks; without a known framework the default extensions are kept
+func Starter(frameworks []string) *Config {
+	componentType := "all"
+	cfg := &Config{Scan: ScanConfig{
+		ComponentType: &componentType,
+		Exclude:       slices.Clone(starterExcludes),
+	}}
+
+	for _, framework := range frameworks {
+		for _, pattern := range frameworkExcludes[framework] {
+			if !slices.Contains(cfg.Scan.Exclude, pattern) {
+				cfg.Scan.Exclude = append(cfg.Scan.Exclude, pattern)
+			}
+		}
+		for _, ext := range frameworkExtensions[framework] {
+			if !slices.Contains(cfg.Scan.Ext, ext) {
+				cfg.Scan.Ext = append(cfg.Scan.Ext, ext)
+			}
+		}
+	}
+	return cfg
+}
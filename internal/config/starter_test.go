@@ -0,0 +1,49 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStarter(t *testing.T) {
+	tests := []struct {
+		name       string
+		frameworks []string
+		exclude    []string
+		ext        []string
+	}{
+		{
+			name:       "no framework",
+			frameworks: nil,
+			exclude:    []string{"**/dist/**", "**/build/**", "**/coverage/**", "**/storybook-static/**"},
+			ext:        nil,
+		},
+		{
+			name:       "nuxt",
+			frameworks: []string{"nuxt", "vue"},
+			exclude:    []string{"**/dist/**", "**/build/**", "**/coverage/**", "**/storybook-static/**", "**/.nuxt/**", "**/.output/**"},
+			ext:        []string{".vue", ".js", ".ts"},
+		},
+		{
+			name:       "svelte and react",
+			frameworks: []string{"react", "svelte"},
+			exclude:    []string{"**/dist/**", "**/build/**", "**/coverage/**", "**/storybook-static/**"},
+			ext:        []string{".jsx", ".tsx", ".js", ".ts", ".svelte=.vue"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Starter(tt.frameworks)
+			if cfg.Scan.ComponentType == nil || *cfg.Scan.ComponentType != "all" {
+				t.Errorf("Starter() componentType = %v, want all", cfg.Scan.ComponentType)
+			}
+			if !reflect.DeepEqual(cfg.Scan.Exclude, tt.exclude) {
+				t.Errorf("Starter() exclude = %v, want %v", cfg.Scan.Exclude, tt.exclude)
+			}
+			if !reflect.DeepEqual(cfg.Scan.Ext, tt.ext) {
+				t.Errorf("Starter() ext = %v, want %v", cfg.Scan.Ext, tt.ext)
+			}
+		})
+	}
+}
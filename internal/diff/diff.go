@@ -0,0 +1,152 @@
+// Package diff compares scan results, e.g. of two releases, to track component migrations.
+package diff
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// LoadResult reads a JSON scan result written with --output json, gzip-compressed or not
+// Results of another major schema version are rejected; results written before versioning are
+// read as the current version. The matches of grouped results are taken from their groups
+func LoadResult(path string) (*types.ScanResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read result file: %w", err)
+	}
+	if bytes.HasPrefix(data, gzipMagic) {
+		if data, err = decompress(data); err != nil {
+			return nil, fmt.Errorf("failed to decompress result file %s: %w", path, err)
+		}
+	}
+
+	var result types.ScanResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse result file %s: %w", path, err)
+	}
+	if result.SchemaVersion != "" && majorVersion(result.SchemaVersion) != majorVersion(types.SchemaVersion) {
+		return nil, fmt.Errorf("unsupported schema version %s of result file %s: expected %s.x", result.SchemaVersion, path, majorVersion(types.SchemaVersion))
+	}
+	if len(result.Matches) == 0 {
+		for _, group := range result.Groups {
+			result.Matches = append(result.Matches, group.Matches...)
+		}
+	}
+	return &result, nil
+}
+
+// gzipMagic starts gzip-compressed files
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompress returns the content of gzip-compressed data
+func decompress(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// majorVersion returns the major version of a "major.minor" schema version
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
+
+// Compare returns the matches added and removed from before to after, and the change of the
+// usages per component
+// Matches are identified by their fingerprint, which survives line shifts, or else by path,
+// line and component; repeated matches are compared by their number
+func Compare(before *types.ScanResult, after *types.ScanResult) *types.ResultDiff {
+	return &types.ResultDiff{
+		TotalCount: types.ComponentDelta{Before: before.TotalCount, After: after.TotalCount, Delta: after.TotalCount - before.TotalCount},
+		Added:      subtract(after.Matches, before.Matches),
+		Removed:    subtract(before.Matches, after.Matches),
+		Components: compareCounts(componentCounts(before), componentCounts(after)),
+	}
+}
+
+// subtract returns the matches missing from others, in their order
+func subtract(matches []types.ComponentMatch, others []types.ComponentMatch) []types.ComponentMatch {
+	remaining := make(map[string]int)
+	for _, match := range others {
+		remaining[matchKey(match)]++
+	}
+
+	missing := []types.ComponentMatch{}
+	for _, match := range matches {
+		key := matchKey(match)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		missing = append(missing, match)
+	}
+	return missing
+}
+
+// matchKey identifies a match across scan results
+func matchKey(match types.ComponentMatch) string {
+	if match.Fingerprint != "" {
+		return match.Fingerprint
+	}
+	return match.FilePath + ":" + strconv.Itoa(match.Line) + ":" + match.ComponentName
+}
+
+// componentCounts returns the usages per canonical component name of a result, counted from its
+// matches if the result has no counts
+func componentCounts(result *types.ScanResult) map[string]int {
+	if result.ComponentCounts != nil {
+		return result.ComponentCounts
+	}
+	counts := make(map[string]int)
+	for _, match := range result.Matches {
+		name := match.CanonicalName
+		if name == "" {
+			name = match.ComponentName
+		}
+		counts[name] += max(match.Occurrences, 1)
+	}
+	return counts
+}
+
+// compareCounts returns the components whose usages changed, largest change first, then by name
+func compareCounts(before map[string]int, after map[string]int) []types.ComponentDelta {
+	deltas := []types.ComponentDelta{}
+	for component, count := range before {
+		if after[component] != count {
+			deltas = append(deltas, types.ComponentDelta{Component: component, Before: count, After: after[component], Delta: after[component] - count})
+		}
+	}
+	for component, count := range after {
+		if _, ok := before[component]; !ok {
+			deltas = append(deltas, types.ComponentDelta{Component: component, After: count, Delta: count})
+		}
+	}
+
+	sort.Slice(deltas, func(i, j int) bool {
+		if abs(deltas[i].Delta) != abs(deltas[j].Delta) {
+			return abs(deltas[i].Delta) > abs(deltas[j].Delta)
+		}
+		return deltas[i].Component < deltas[j].Component
+	})
+	return deltas
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,145 @@
+package diff
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestCompare(t *testing.T) {
+	before := &types.ScanResult{
+		Matches: []types.ComponentMatch{
+			{FilePath: "src/A.vue", Line: 3, ComponentName: "q-dialog", CanonicalName: "QDialog", Fingerprint: "a1"},
+			{FilePath: "src/A.vue", Line: 9, ComponentName: "q-dialog", CanonicalName: "QDialog", Fingerprint: "a2"},
+			{FilePath: "src/B.vue", Line: 4, ComponentName: "q-btn", CanonicalName: "QBtn", Fingerprint: "b1"},
+		},
+		TotalCount:      3,
+		ComponentCounts: map[string]int{"QDialog": 2, "QBtn": 1},
+	}
+	after := &types.ScanResult{
+		Matches: []types.ComponentMatch{
+			// Moved down by an edit above it: same fingerprint, not a change
+			{FilePath: "src/A.vue", Line: 5, ComponentName: "q-dialog", CanonicalName: "QDialog", Fingerprint: "a1"},
+			{FilePath: "src/B.vue", Line: 4, ComponentName: "q-btn", CanonicalName: "QBtn", Fingerprint: "b1"},
+			{FilePath: "src/C.vue", Line: 2, ComponentName: "AppModal", CanonicalName: "AppModal", Fingerprint: "c1"},
+		},
+		TotalCount:      3,
+		ComponentCounts: map[string]int{"QDialog": 1, "QBtn": 1, "AppModal": 1},
+	}
+
+	diff := Compare(before, after)
+
+	if diff.TotalCount != (types.ComponentDelta{Before: 3, After: 3, Delta: 0}) {
+		t.Errorf("TotalCount = %+v, want 3 -> 3", diff.TotalCount)
+	}
+	if len(diff.Added) != 1 || diff.Added[0].Fingerprint != "c1" {
+		t.Errorf("Added = %+v, want the AppModal match", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Fingerprint != "a2" {
+		t.Errorf("Removed = %+v, want the second QDialog match", diff.Removed)
+	}
+	expected := []types.ComponentDelta{
+		{Component: "AppModal", Before: 0, After: 1, Delta: 1},
+		{Component: "QDialog", Before: 2, After: 1, Delta: -1},
+	}
+	if !reflect.DeepEqual(diff.Components, expected) {
+		t.Errorf("Components = %+v, want %+v", diff.Components, expected)
+	}
+}
+
+func TestCompare_WithoutFingerprints(t *testing.T) {
+	match := types.ComponentMatch{FilePath: "src/A.vue", Line: 3, ComponentName: "q-btn"}
+	before := &types.ScanResult{Matches: []types.ComponentMatch{match}, TotalCount: 1}
+	after := &types.ScanResult{Matches: []types.ComponentMatch{match, match}, TotalCount: 2}
+
+	diff := Compare(before, after)
+
+	if len(diff.Added) != 1 || len(diff.Removed) != 0 {
+		t.Errorf("Expected the repeated match to be added once, got added %d, removed %d", len(diff.Added), len(diff.Removed))
+	}
+	expected := []types.ComponentDelta{{Component: "q-btn", Before: 1, After: 2, Delta: 1}}
+	if !reflect.DeepEqual(diff.Components, expected) {
+		t.Errorf("Components = %+v, want %+v", diff.Components, expected)
+	}
+}
+
+func TestLoadResult(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("reads the matches of grouped results", func(t *testing.T) {
+		path := filepath.Join(dir, "grouped.json")
+		content := `{"totalCount": 2, "groupBy": "file", "groups": [
+			{"key": "src/A.vue", "count": 1, "matches": [{"filePath": "src/A.vue", "line": 1, "componentName": "q-btn", "componentType": "button"}]},
+			{"key": "src/B.vue", "count": 1, "matches": [{"filePath": "src/B.vue", "line": 2, "componentName": "q-btn", "componentType": "button"}]}
+		]}`
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write result file: %v", err)
+		}
+
+		result, err := LoadResult(path)
+		if err != nil {
+			t.Fatalf("LoadResult failed: %v", err)
+		}
+		if len(result.Matches) != 2 {
+			t.Errorf("Expected the 2 matches of the groups, got %d", len(result.Matches))
+		}
+	})
+
+	t.Run("reads compressed results", func(t *testing.T) {
+		path := filepath.Join(dir, "results.json.gz")
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		writer.Write([]byte(`{"totalCount": 1, "matches": [{"filePath": "src/A.vue", "line": 1, "componentName": "q-btn", "componentType": "button"}]}`))
+		writer.Close()
+		if err := os.WriteFile(path, compressed.Bytes(), 0644); err != nil {
+			t.Fatalf("Failed to write result file: %v", err)
+		}
+
+		result, err := LoadResult(path)
+		if err != nil {
+			t.Fatalf("LoadResult failed: %v", err)
+		}
+		if len(result.Matches) != 1 {
+			t.Errorf("Expected the match of the compressed file, got %d", len(result.Matches))
+		}
+	})
+
+	t.Run("reads results of the same major schema version", func(t *testing.T) {
+		path := filepath.Join(dir, "newer.json")
+		content := `{"schemaVersion": "1.99", "totalCount": 0, "matches": [], "addedLater": true}`
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write result file: %v", err)
+		}
+
+		if _, err := LoadResult(path); err != nil {
+			t.Errorf("LoadResult failed: %v", err)
+		}
+	})
+
+	t.Run("rejects another major schema version", func(t *testing.T) {
+		path := filepath.Join(dir, "v2.json")
+		if err := os.WriteFile(path, []byte(`{"schemaVersion": "2.0", "totalCount": 0}`), 0644); err != nil {
+			t.Fatalf("Failed to write result file: %v", err)
+		}
+
+		if _, err := LoadResult(path); err == nil {
+			t.Error("Expected an error for a result of schema version 2.0")
+		}
+	})
+
+	t.Run("rejects invalid JSON", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		if err := os.WriteFile(path, []byte("<scanResult/>"), 0644); err != nil {
+			t.Fatalf("Failed to write result file: %v", err)
+		}
+
+		if _, err := LoadResult(path); err == nil {
+			t.Error("Expected an error for a file that is not JSON")
+		}
+	})
+}
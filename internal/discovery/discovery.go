@@ -2,48 +2,226 @@
 package discovery
 
 import (
+	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
+	"sync"
 
 	"ui-elf/internal/types"
 )
 
+// TestExcludePatterns lists the test directories and files not scanned by default
+var TestExcludePatterns = []string{"test", "tests", "__tests__", ".test.", ".spec."}
+
+// DefaultExcludePatterns lists the paths not scanned by default: dependencies and tests
+var DefaultExcludePatterns = append([]string{"node_modules"}, TestExcludePatterns...)
+
+// DefaultFileExtensions lists the extensions of the files scanned by default
+// Compound extensions (.blade.php) match only the files ending with all of their parts
+var DefaultFileExtensions = []string{".vue", ".jsx", ".tsx", ".js", ".ts", ".hbs", ".blade.php", ".erb", ".twig", ".ejs", ".njk", ".razor", ".cshtml"}
+
+// ParseExtensions resolves --ext values into the scanned file extensions and the extensions
+// parsed like another one
+// The values replace the default extensions, unless one of them is prefixed with "+", which
+// extends the defaults instead (e.g. +.mdx). A value of the form .svelte=.vue scans .svelte
+// files with the parsers of .vue files. Values without a leading dot are given one.
+func ParseExtensions(values []string) ([]string, map[string]string, error) {
+	if len(values) == 0 {
+		return slices.Clone(DefaultFileExtensions), nil, nil
+	}
+
+	var extensions []string
+	var aliases map[string]string
+	if slices.ContainsFunc(values, func(value string) bool { return strings.HasPrefix(strings.TrimSpace(value), "+") }) {
+		extensions = slices.Clone(DefaultFileExtensions)
+	}
+	for _, value := range values {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "+")
+
+		ext, parsedAs, isAlias := strings.Cut(value, "=")
+		ext = normalizeExtension(ext)
+		if ext == "" {
+			return nil, nil, fmt.Errorf("invalid extension '%s': expected an extension such as .vue or .svelte=.vue", value)
+		}
+		if isAlias {
+			parsedAs = normalizeExtension(parsedAs)
+			if parsedAs == "" || parsedAs == ext {
+				return nil, nil, fmt.Errorf("invalid extension '%s': expected the extension whose parsers handle %s files, as %s=.vue", value, ext, ext)
+			}
+			if aliases == nil {
+				aliases = make(map[string]string)
+			}
+			aliases[ext] = parsedAs
+		}
+
+		if !slices.Contains(extensions, ext) {
+			extensions = append(extensions, ext)
+		}
+	}
+	return extensions, aliases, nil
+}
+
+// ReadFileList reads the paths of the files to scan, separated by sep ('\n' or 0 for NUL-delimited
+// input), in order and without duplicates
+// Blank lines are skipped, and newline-separated paths are trimmed (CRLF input, trailing spaces)
+func ReadFileList(r io.Reader, sep byte) ([]string, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file list: %w", err)
+	}
+
+	var files []string
+	seen := make(map[string]bool)
+	for _, path := range strings.Split(string(content), string(sep)) {
+		if sep == '\n' {
+			path = strings.TrimSpace(path)
+		}
+		if path == "" || seen[path] {
+			continue
+		}
+		seen[path] = true
+		files = append(files, path)
+	}
+	return files, nil
+}
+
+// ExistingFiles returns the files that exist, logging the others, such as files deleted in a
+// git diff, at debug level
+func (s *FileDiscoveryService) ExistingFiles(files []string) []string {
+	var existing []string
+	for _, path := range files {
+		if info, err := os.Stat(path); err != nil || info.IsDir() {
+			s.logger.Debug("skipped missing file", "path", path)
+			s.skip(path, "not found")
+			continue
+		}
+		existing = append(existing, path)
+	}
+	return existing
+}
+
+// normalizeExtension trims ext and gives it a leading dot, returning "" for an empty extension
+func normalizeExtension(ext string) string {
+	ext = strings.TrimSpace(ext)
+	if ext == "" || ext == "." {
+		return ""
+	}
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	return ext
+}
+
+// SkipHandler receives the paths skipped during discovery with the reason they were skipped
+// (e.g. "excluded by pattern 'node_modules'"); skipped directories are reported, not their files
+type SkipHandler func(path string, reason string)
+
 // FileDiscoveryService handles file discovery with filtering
-type FileDiscoveryService struct{}
+type FileDiscoveryService struct {
+	logger *slog.Logger
+	onSkip SkipHandler
+}
 
-// NewFileDiscoveryService creates a new FileDiscoveryService
+// NewFileDiscoveryService creates a new FileDiscoveryService logging to the default logger
 func NewFileDiscoveryService() *FileDiscoveryService {
-	return &FileDiscoveryService{}
+	return &FileDiscoveryService{logger: slog.Default()}
+}
+
+// SetLogger sets the logger receiving the paths skipped during discovery
+func (s *FileDiscoveryService) SetLogger(logger *slog.Logger) {
+	s.logger = logger
+}
+
+// SetSkipHandler sets the handler receiving the skipped paths, e.g. to list them with --list-files
+func (s *FileDiscoveryService) SetSkipHandler(handler SkipHandler) {
+	s.onSkip = handler
+}
+
+// skip reports a skipped path to the skip handler, if any
+func (s *FileDiscoveryService) skip(path string, reason string) {
+	if s.onSkip != nil {
+		s.onSkip(path, reason)
+	}
 }
 
 // DiscoverFiles traverses the directory tree and returns files matching the filter criteria
+// Unreadable paths below rootDir are logged and skipped, and so are the paths ignored by the
+// ignore files of the filter found in rootDir, its subdirectories and, up to the root of its git
+// repository, its parent directories
 func (s *FileDiscoveryService) DiscoverFiles(rootDir string, filter types.FileFilter) ([]string, error) {
 	var files []string
+	excluded := 0
+	ignore := newIgnoreMatcher(filter.IgnoreFiles)
+	if ignore != nil {
+		ignore.loadAncestors(rootDir)
+	}
 
 	err := filepath.Walk(rootDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
-			return err
+			if path == rootDir {
+				return err
+			}
+			s.logger.Warn("skipped unreadable path", "path", path, "error", err)
+			s.skip(path, fmt.Sprintf("unreadable: %v", err))
+			if info != nil && info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
 		}
 
-		// Skip directories
+		// Skip ignored directories and those below the depth limit, and read the ignore files of the others
+		relPath, _ := filepath.Rel(rootDir, path)
+		relPath = filepath.ToSlash(relPath)
 		if info.IsDir() {
+			if path != rootDir && filter.MaxDepth > 0 && pathDepth(relPath) >= filter.MaxDepth {
+				s.skip(path, fmt.Sprintf("below --max-depth %d", filter.MaxDepth))
+				return filepath.SkipDir
+			}
+			if ignore == nil {
+				return nil
+			}
+			if source, ignored := ignore.ignoredBy(relPath, true); path != rootDir && ignored {
+				s.logger.Debug("skipped ignored directory", "path", path)
+				s.skip(path, "ignored by "+source)
+				return filepath.SkipDir
+			}
+			if path == rootDir {
+				relPath = ""
+			}
+			ignore.load(path, relPath)
 			return nil
 		}
 
 		// Check if file should be excluded
-		if s.ShouldExcludeFile(path, filter) {
+		if ignore != nil {
+			if source, ignored := ignore.ignoredBy(relPath, false); ignored {
+				excluded++
+				s.skip(path, "ignored by "+source)
+				return nil
+			}
+		}
+		if pattern, excludedBy := s.excludingPattern(path, filter); excludedBy {
+			excluded++
+			s.skip(path, fmt.Sprintf("excluded by pattern '%s'", pattern))
 			return nil
 		}
 
 		// Check if file has a valid extension
 		if !s.hasValidExtension(path, filter.FileExtensions) {
+			s.skip(path, "extension not scanned")
 			return nil
 		}
 
 		// If include directories are specified, check if file is in one of them
 		if len(filter.IncludeDirectories) > 0 {
 			if !s.isInIncludedDirectory(path, rootDir, filter.IncludeDirectories) {
+				s.skip(path, "outside the --filter directories")
 				return nil
 			}
 		}
@@ -51,25 +229,74 @@ func (s *FileDiscoveryService) DiscoverFiles(rootDir string, filter types.FileFi
 		files = append(files, path)
 		return nil
 	})
+	if err == nil {
+		s.logger.Debug("discovered files", "root", rootDir, "files", len(files), "excluded", excluded)
+	}
 
 	return files, err
 }
 
+// DiscoverRoots discovers the files of several scan roots, merging them in the order of the roots
+// Returns the files and the root each was discovered in; files under several roots belong to the first
+func (s *FileDiscoveryService) DiscoverRoots(roots []string, filter types.FileFilter) ([]string, map[string]string, error) {
+	var files []string
+	fileRoots := make(map[string]string)
+	seen := make(map[string]bool)
+	for _, root := range roots {
+		rootFiles, err := s.DiscoverFiles(root, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, path := range rootFiles {
+			// Overlapping roots (src and src/components) find the same files under different paths
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				absPath = path
+			}
+			if seen[absPath] {
+				s.skip(path, "already discovered under another root")
+				continue
+			}
+			seen[absPath] = true
+			fileRoots[path] = root
+			files = append(files, path)
+		}
+	}
+	return files, fileRoots, nil
+}
+
+// pathDepth returns the number of segments of a slash-separated relative path
+func pathDepth(relPath string) int {
+	return strings.Count(relPath, "/") + 1
+}
+
 // ShouldExcludeFile checks if a file should be excluded based on filter patterns
 func (s *FileDiscoveryService) ShouldExcludeFile(filePath string, filter types.FileFilter) bool {
+	_, excluded := s.excludingPattern(filePath, filter)
+	return excluded
+}
+
+// excludingPattern returns the first exclusion pattern of the filter matching a file
+func (s *FileDiscoveryService) excludingPattern(filePath string, filter types.FileFilter) (string, bool) {
 	for _, pattern := range filter.ExcludePatterns {
 		if s.matchesPattern(filePath, pattern) {
-			return true
+			return pattern, true
 		}
 	}
-	return false
+	return "", false
 }
 
 // matchesPattern checks if a file path matches an exclusion pattern
+// Patterns with glob characters (*, ?, [) are matched against the trailing path segments,
+// "**" spanning directories (e.g. "**/legacy/**", "*.gen.ts"); others are substrings of the path
 func (s *FileDiscoveryService) matchesPattern(filePath string, pattern string) bool {
 	// Normalize path separators
 	normalizedPath := filepath.ToSlash(filePath)
 
+	if strings.ContainsAny(pattern, "*?[") {
+		return globRegex(pattern).MatchString(normalizedPath)
+	}
+
 	// Check if path contains the pattern
 	if strings.Contains(normalizedPath, pattern) {
 		return true
@@ -86,15 +313,72 @@ func (s *FileDiscoveryService) matchesPattern(filePath string, pattern string) b
 	return false
 }
 
+// globRegexes caches the compiled glob patterns, as every file is matched against them
+var globRegexes sync.Map
+
+// globRegex compiles a glob pattern into a regex matching paths ending with it
+// "**" matches any number of directories, "*" and "?" stay within a path segment
+// and bracket expressions ([abc], [!abc]) match one character of a segment
+func globRegex(pattern string) *regexp.Regexp {
+	if cached, ok := globRegexes.Load(pattern); ok {
+		return cached.(*regexp.Regexp)
+	}
+
+	compiled, err := regexp.Compile("(?:^|/)" + globBody(pattern) + "$")
+	if err != nil {
+		// Malformed bracket expressions match literally
+		compiled = regexp.MustCompile("(?:^|/)" + regexp.QuoteMeta(pattern) + "$")
+	}
+	globRegexes.Store(pattern, compiled)
+	return compiled
+}
+
+// globBody translates a glob pattern into the body of a regex, without anchors
+func globBody(pattern string) string {
+	var sb strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**/") {
+				sb.WriteString("(?:.*/)?")
+				i += 2
+			} else if strings.HasPrefix(pattern[i:], "**") {
+				sb.WriteString(".*")
+				i++
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return sb.String()
+}
+
 // hasValidExtension checks if a file has one of the valid extensions
+// The file name must end with the extension, so compound extensions (.blade.php) can be matched
 func (s *FileDiscoveryService) hasValidExtension(filePath string, extensions []string) bool {
 	if len(extensions) == 0 {
 		return true
 	}
 
-	ext := filepath.Ext(filePath)
+	name := filepath.Base(filePath)
 	for _, validExt := range extensions {
-		if ext == validExt {
+		if strings.HasSuffix(name, validExt) {
 			return true
 		}
 	}
@@ -102,7 +386,37 @@ func (s *FileDiscoveryService) hasValidExtension(filePath string, extensions []s
 	return false
 }
 
+// ExpandRoots expands the glob patterns among the scan roots (e.g. packages/*/src) into the
+// directories they match, in order
+// Roots without glob characters are kept as they are; a pattern matching no directory is an error
+func ExpandRoots(roots []string) ([]string, error) {
+	var expanded []string
+	for _, root := range roots {
+		if !strings.ContainsAny(root, "*?[") {
+			expanded = append(expanded, root)
+			continue
+		}
+
+		matches, err := filepath.Glob(root)
+		if err != nil {
+			return nil, fmt.Errorf("invalid directory pattern '%s': %w", root, err)
+		}
+		found := false
+		for _, match := range matches {
+			if info, err := os.Stat(match); err == nil && info.IsDir() {
+				expanded = append(expanded, match)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no directory matches '%s'", root)
+		}
+	}
+	return expanded, nil
+}
+
 // isInIncludedDirectory checks if a file is within one of the included directories
+// Included directories may be glob patterns matching directories (e.g. packages/*/src/components)
 func (s *FileDiscoveryService) isInIncludedDirectory(filePath string, rootDir string, includeDirectories []string) bool {
 	// Get relative path from root
 	relPath, err := filepath.Rel(rootDir, filePath)
@@ -115,6 +429,13 @@ func (s *FileDiscoveryService) isInIncludedDirectory(filePath string, rootDir st
 	for _, includeDir := range includeDirectories {
 		normalizedIncludeDir := filepath.ToSlash(includeDir)
 
+		if strings.ContainsAny(normalizedIncludeDir, "*?[") {
+			if matchesDirectoryGlob(normalizedRelPath, normalizedIncludeDir) {
+				return true
+			}
+			continue
+		}
+
 		// Check if file is in the included directory or its subdirectories
 		if strings.HasPrefix(normalizedRelPath, normalizedIncludeDir+"/") ||
 			normalizedRelPath == normalizedIncludeDir {
@@ -124,3 +445,17 @@ func (s *FileDiscoveryService) isInIncludedDirectory(filePath string, rootDir st
 
 	return false
 }
+
+// matchesDirectoryGlob checks if relPath is within a directory matching pattern, whose "*"
+// and "?" do not span directories
+func matchesDirectoryGlob(relPath string, pattern string) bool {
+	pattern = strings.TrimSuffix(pattern, "/")
+	segments := strings.Count(pattern, "/") + 1
+
+	relSegments := strings.Split(relPath, "/")
+	if len(relSegments) < segments {
+		return false
+	}
+	matched, err := path.Match(pattern, strings.Join(relSegments[:segments], "/"))
+	return err == nil && matched
+}
@@ -3,6 +3,8 @@ package discovery
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -42,6 +44,46 @@ func TestShouldExcludeFile(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name:     "excludes directories matching a globstar pattern",
+			filePath: "/home/dev/app/src/legacy/forms/Old.vue",
+			filter: types.FileFilter{
+				ExcludePatterns: []string{"**/legacy/**"},
+			},
+			expected: true,
+		},
+		{
+			name:     "excludes file names matching a glob",
+			filePath: "src/api/client.gen.ts",
+			filter: types.FileFilter{
+				ExcludePatterns: []string{"*.gen.ts"},
+			},
+			expected: true,
+		},
+		{
+			name:     "glob stars stay within a path segment",
+			filePath: "src/legacy/forms/Old.vue",
+			filter: types.FileFilter{
+				ExcludePatterns: []string{"src/*/Old.vue"},
+			},
+			expected: false,
+		},
+		{
+			name:     "glob with character class",
+			filePath: "src/v2/Button.vue",
+			filter: types.FileFilter{
+				ExcludePatterns: []string{"src/v[0-9]/**"},
+			},
+			expected: true,
+		},
+		{
+			name:     "glob does not match part of a segment",
+			filePath: "src/mylegacy/Button.vue",
+			filter: types.FileFilter{
+				ExcludePatterns: []string{"**/legacy/**"},
+			},
+			expected: false,
+		},
 		{
 			name:     "does not exclude regular files",
 			filePath: "src/components/Button.tsx",
@@ -95,6 +137,24 @@ func TestHasValidExtension(t *testing.T) {
 			extensions: []string{".vue", ".jsx", ".tsx"},
 			expected:   false,
 		},
+		{
+			name:       "matches compound extension",
+			filePath:   "resources/views/welcome.blade.php",
+			extensions: []string{".vue", ".blade.php"},
+			expected:   true,
+		},
+		{
+			name:       "does not match last part of compound extension",
+			filePath:   "app/Http/Controllers/HomeController.php",
+			extensions: []string{".vue", ".blade.php"},
+			expected:   false,
+		},
+		{
+			name:       "does not match file named like compound extension",
+			filePath:   "resources/views/blade.php",
+			extensions: []string{".blade.php"},
+			expected:   false,
+		},
 		{
 			name:       "empty extensions list matches all",
 			filePath:   "src/components/Button.js",
@@ -113,6 +173,120 @@ func TestHasValidExtension(t *testing.T) {
 	}
 }
 
+func TestParseExtensions(t *testing.T) {
+	tests := []struct {
+		name               string
+		values             []string
+		expectedExtensions []string
+		expectedAliases    map[string]string
+		expectError        bool
+	}{
+		{
+			name:               "no values keep the defaults",
+			expectedExtensions: DefaultFileExtensions,
+		},
+		{
+			name:               "values replace the defaults",
+			values:             []string{".vue", "jsx", " .tsx "},
+			expectedExtensions: []string{".vue", ".jsx", ".tsx"},
+		},
+		{
+			name:               "plus prefixed values extend the defaults",
+			values:             []string{"+.mdx"},
+			expectedExtensions: append(slices.Clone(DefaultFileExtensions), ".mdx"),
+		},
+		{
+			name:               "aliases parse an extension like another one",
+			values:             []string{".vue", ".svelte=.vue"},
+			expectedExtensions: []string{".vue", ".svelte"},
+			expectedAliases:    map[string]string{".svelte": ".vue"},
+		},
+		{
+			name:        "rejects empty extensions",
+			values:      []string{"."},
+			expectError: true,
+		},
+		{
+			name:        "rejects aliases of the extension itself",
+			values:      []string{".vue=vue"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extensions, aliases, err := ParseExtensions(tt.values)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("ParseExtensions(%v) succeeded, want an error", tt.values)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseExtensions(%v) failed: %v", tt.values, err)
+			}
+			if !reflect.DeepEqual(extensions, tt.expectedExtensions) {
+				t.Errorf("extensions = %v, want %v", extensions, tt.expectedExtensions)
+			}
+			if !reflect.DeepEqual(aliases, tt.expectedAliases) {
+				t.Errorf("aliases = %v, want %v", aliases, tt.expectedAliases)
+			}
+		})
+	}
+}
+
+func TestReadFileList(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		sep      byte
+		expected []string
+	}{
+		{
+			name:     "newline separated paths",
+			input:    "src/App.vue\r\n\nsrc/Form.tsx \nsrc/App.vue\n",
+			sep:      '\n',
+			expected: []string{"src/App.vue", "src/Form.tsx"},
+		},
+		{
+			name:     "NUL separated paths keep spaces and newlines",
+			input:    "src/My Form.vue\x00src/odd\nname.tsx\x00",
+			sep:      0,
+			expected: []string{"src/My Form.vue", "src/odd\nname.tsx"},
+		},
+		{
+			name:  "empty input",
+			input: "",
+			sep:   '\n',
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := ReadFileList(strings.NewReader(tt.input), tt.sep)
+			if err != nil {
+				t.Fatalf("ReadFileList failed: %v", err)
+			}
+			if !reflect.DeepEqual(files, tt.expected) {
+				t.Errorf("ReadFileList() = %q, want %q", files, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExistingFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	appFile := filepath.Join(tempDir, "App.vue")
+	if err := os.WriteFile(appFile, []byte("<template></template>"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+
+	files := NewFileDiscoveryService().ExistingFiles([]string{appFile, filepath.Join(tempDir, "Deleted.vue"), tempDir})
+	if !reflect.DeepEqual(files, []string{appFile}) {
+		t.Errorf("ExistingFiles() = %v, want [%s]", files, appFile)
+	}
+}
+
 func TestDiscoverFiles(t *testing.T) {
 	// Create a temporary directory structure for testing
 	tmpDir := t.TempDir()
@@ -187,3 +361,180 @@ func TestDiscoverFiles(t *testing.T) {
 		}
 	})
 }
+
+func TestDiscoverRoots(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, file := range []string{"src/App.vue", "src/components/Button.vue", "packages/ui/Dialog.tsx"} {
+		fullPath := filepath.Join(tmpDir, file)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte("test content"), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	src := filepath.Join(tmpDir, "src")
+	components := filepath.Join(tmpDir, "src", "components")
+	packages := filepath.Join(tmpDir, "packages")
+	files, fileRoots, err := NewFileDiscoveryService().DiscoverRoots([]string{src, packages, components}, types.FileFilter{})
+	if err != nil {
+		t.Fatalf("DiscoverRoots() error = %v", err)
+	}
+
+	expected := map[string]string{
+		filepath.Join(src, "App.vue"):               src,
+		filepath.Join(components, "Button.vue"):     src,
+		filepath.Join(packages, "ui", "Dialog.tsx"): packages,
+	}
+	if len(files) != len(expected) {
+		t.Errorf("DiscoverRoots() found %d files, want %d: %v", len(files), len(expected), files)
+	}
+	if !reflect.DeepEqual(fileRoots, expected) {
+		t.Errorf("DiscoverRoots() roots = %v, want %v", fileRoots, expected)
+	}
+}
+
+func TestIsInIncludedDirectory(t *testing.T) {
+	service := NewFileDiscoveryService()
+
+	tests := []struct {
+		name     string
+		filePath string
+		include  []string
+		expected bool
+	}{
+		{
+			name:     "file in an included directory",
+			filePath: "/repo/src/components/Button.vue",
+			include:  []string{"src/components"},
+			expected: true,
+		},
+		{
+			name:     "file in a directory matching a glob",
+			filePath: "/repo/packages/forms/src/components/Form.vue",
+			include:  []string{"packages/*/src/components"},
+			expected: true,
+		},
+		{
+			name:     "glob stars do not span directories",
+			filePath: "/repo/packages/forms/lib/src/components/Form.vue",
+			include:  []string{"packages/*/src/components"},
+			expected: false,
+		},
+		{
+			name:     "file outside the directories matching a glob",
+			filePath: "/repo/packages/forms/src/utils/format.ts",
+			include:  []string{"packages/*/src/components"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := service.isInIncludedDirectory(tt.filePath, "/repo", tt.include); result != tt.expected {
+				t.Errorf("isInIncludedDirectory() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExpandRoots(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, dir := range []string{"packages/forms/src", "packages/ui/src", "packages/docs"} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+	}
+
+	roots, err := ExpandRoots([]string{filepath.Join(tmpDir, "packages", "*", "src"), "apps/admin"})
+	if err != nil {
+		t.Fatalf("ExpandRoots() error = %v", err)
+	}
+	expected := []string{
+		filepath.Join(tmpDir, "packages", "forms", "src"),
+		filepath.Join(tmpDir, "packages", "ui", "src"),
+		"apps/admin",
+	}
+	if !reflect.DeepEqual(roots, expected) {
+		t.Errorf("ExpandRoots() = %v, want %v", roots, expected)
+	}
+
+	if _, err := ExpandRoots([]string{filepath.Join(tmpDir, "apps", "*")}); err == nil {
+		t.Error("ExpandRoots() succeeded for a pattern matching no directory, want an error")
+	}
+}
+
+func TestDiscoverFiles_MaxDepth(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, file := range []string{"App.vue", "src/Form.vue", "src/components/Button.vue", "src/components/forms/Input.vue"} {
+		fullPath := filepath.Join(tmpDir, file)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte("test content"), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	service := NewFileDiscoveryService()
+	for maxDepth, expected := range map[int]int{0: 4, 1: 1, 2: 2, 3: 3, 4: 4} {
+		files, err := service.DiscoverFiles(tmpDir, types.FileFilter{MaxDepth: maxDepth})
+		if err != nil {
+			t.Fatalf("DiscoverFiles() error = %v", err)
+		}
+		if len(files) != expected {
+			t.Errorf("DiscoverFiles() with MaxDepth %d found %d files, want %d: %v", maxDepth, len(files), expected, files)
+		}
+	}
+}
+
+func TestDiscoverFiles_SkipHandler(t *testing.T) {
+	tmpDir := t.TempDir()
+	for file, content := range map[string]string{
+		".gitignore":            "dist/\n",
+		"README.md":             "",
+		"dist/App.vue":          "",
+		"src/App.vue":           "",
+		"src/App.test.vue":      "",
+		"legacy/Old.vue":        "",
+		"src/deep/nested/A.vue": "",
+	} {
+		fullPath := filepath.Join(tmpDir, file)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	service := NewFileDiscoveryService()
+	skipped := make(map[string]string)
+	service.SetSkipHandler(func(path string, reason string) {
+		relPath, _ := filepath.Rel(tmpDir, path)
+		skipped[filepath.ToSlash(relPath)] = reason
+	})
+	_, err := service.DiscoverFiles(tmpDir, types.FileFilter{
+		ExcludePatterns:    []string{".test."},
+		IncludeDirectories: []string{"src"},
+		FileExtensions:     []string{".vue"},
+		IgnoreFiles:        DefaultIgnoreFiles,
+		MaxDepth:           2,
+	})
+	if err != nil {
+		t.Fatalf("DiscoverFiles() error = %v", err)
+	}
+
+	expected := map[string]string{
+		".gitignore":       "extension not scanned",
+		"README.md":        "extension not scanned",
+		"dist":             "ignored by .gitignore",
+		"src/App.test.vue": "excluded by pattern '.test.'",
+		"legacy/Old.vue":   "outside the --filter directories",
+		"src/deep":         "below --max-depth 2",
+	}
+	if !reflect.DeepEqual(skipped, expected) {
+		t.Errorf("skipped = %v, want %v", skipped, expected)
+	}
+}
@@ -0,0 +1,165 @@
+package discovery
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/project"
+)
+
+// DefaultIgnoreFiles lists the gitignore-style files honored during discovery, unless disabled
+var DefaultIgnoreFiles = []string{".gitignore", ".ui-elfignore"}
+
+// ignoreRule is a pattern of an ignore file
+type ignoreRule struct {
+	base    string // Directory of the ignore file, relative to the scanned directory ("" for the directory itself)
+	within  string // Scanned directory relative to the directory of an ignore file above it ("" for the others)
+	source  string // Path of the ignore file, relative to the scanned directory
+	regex   *regexp.Regexp
+	negate  bool // "!pattern" re-includes paths ignored by an earlier rule
+	dirOnly bool // "pattern/" only matches directories
+}
+
+// ignoreMatcher holds the rules of the ignore files read during a walk, in the order read
+type ignoreMatcher struct {
+	fileNames []string
+	rules     []ignoreRule
+}
+
+// newIgnoreMatcher creates a matcher reading the ignore files with the given names, or nil if there are none
+func newIgnoreMatcher(fileNames []string) *ignoreMatcher {
+	if len(fileNames) == 0 {
+		return nil
+	}
+	return &ignoreMatcher{fileNames: fileNames}
+}
+
+// load reads the ignore files of dir, whose path relative to the scanned directory is relDir
+// Unreadable or missing ignore files are skipped
+func (m *ignoreMatcher) load(dir string, relDir string) {
+	for _, name := range m.fileNames {
+		file, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		lines := bufio.NewScanner(file)
+		for lines.Scan() {
+			if rule, ok := parseIgnoreRule(lines.Text(), relDir); ok {
+				rule.source = path.Join(relDir, name)
+				m.rules = append(m.rules, rule)
+			}
+		}
+		_ = file.Close()
+	}
+}
+
+// loadAncestors reads the ignore files of the directories from the root of the git repository
+// containing rootDir down to the parent of rootDir, as git does when a subdirectory is scanned
+// Their rules are anchored to their own directories. Nothing is read outside a repository.
+func (m *ignoreMatcher) loadAncestors(rootDir string) {
+	absRoot, err := filepath.Abs(rootDir)
+	if err != nil {
+		return
+	}
+	repoRoot := project.FindRepoRoot(absRoot)
+	if repoRoot == "" || repoRoot == absRoot {
+		return
+	}
+
+	// Ancestors from the repository root down, so that deeper ignore files take precedence
+	var ancestors []string
+	for dir := filepath.Dir(absRoot); ; dir = filepath.Dir(dir) {
+		ancestors = append([]string{dir}, ancestors...)
+		if dir == repoRoot || dir == filepath.Dir(dir) {
+			break
+		}
+	}
+
+	for _, dir := range ancestors {
+		within, err := filepath.Rel(dir, absRoot)
+		if err != nil {
+			continue
+		}
+		first := len(m.rules)
+		m.load(dir, "")
+		for i := first; i < len(m.rules); i++ {
+			m.rules[i].within = filepath.ToSlash(within)
+			if source, err := filepath.Rel(absRoot, filepath.Join(dir, path.Base(m.rules[i].source))); err == nil {
+				m.rules[i].source = filepath.ToSlash(source)
+			}
+		}
+	}
+}
+
+// ignored checks if relPath, relative to the scanned directory, is ignored
+// As in git, the last rule matching the path decides, and rules only apply below their ignore file
+func (m *ignoreMatcher) ignored(relPath string, isDir bool) bool {
+	_, ignored := m.ignoredBy(relPath, isDir)
+	return ignored
+}
+
+// ignoredBy checks if relPath is ignored, returning the ignore file whose rule decided
+func (m *ignoreMatcher) ignoredBy(relPath string, isDir bool) (string, bool) {
+	source, ignored := "", false
+	for _, rule := range m.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		path := relPath
+		if rule.base != "" {
+			var found bool
+			if path, found = strings.CutPrefix(relPath, rule.base+"/"); !found {
+				continue
+			}
+		}
+		if rule.within != "" {
+			path = rule.within + "/" + path
+		}
+		if rule.regex.MatchString(path) {
+			source, ignored = rule.source, !rule.negate
+		}
+	}
+	return source, ignored
+}
+
+// parseIgnoreRule parses a line of an ignore file in directory base, following the gitignore syntax:
+// comments (#), negation (!), directory patterns (trailing /), patterns anchored to the ignore
+// file's directory when they contain a slash, and the *, ?, [...] and ** wildcards
+func parseIgnoreRule(line string, base string) (ignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+
+	rule := ignoreRule{base: base}
+	if pattern, found := strings.CutPrefix(line, "!"); found {
+		rule.negate = true
+		line = pattern
+	}
+	// A leading backslash escapes a literal # or !
+	line = strings.TrimPrefix(line, `\`)
+	if pattern, found := strings.CutSuffix(line, "/"); found {
+		rule.dirOnly = true
+		line = pattern
+	}
+	if line == "" {
+		return ignoreRule{}, false
+	}
+
+	// Patterns with a slash are relative to the ignore file; others match a name at any depth
+	prefix := "(?:^|/)"
+	if strings.Contains(line, "/") {
+		prefix = "^"
+		line = strings.TrimPrefix(line, "/")
+	}
+	regex, err := regexp.Compile(prefix + globBody(line) + "$")
+	if err != nil {
+		regex = regexp.MustCompile(prefix + regexp.QuoteMeta(line) + "$")
+	}
+	rule.regex = regex
+	return rule, true
+}
@@ -0,0 +1,161 @@
+package discovery
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestIgnoreMatcher_ignored(t *testing.T) {
+	matcher := &ignoreMatcher{}
+	for _, line := range []string{
+		"# build output",
+		"dist/",
+		"*.gen.vue",
+		"!keep.gen.vue",
+		"/legacy",
+		"docs/**/examples",
+		`\#notes.vue`,
+	} {
+		if rule, ok := parseIgnoreRule(line, ""); ok {
+			matcher.rules = append(matcher.rules, rule)
+		}
+	}
+	if rule, ok := parseIgnoreRule("drafts", "packages/ui"); ok {
+		matcher.rules = append(matcher.rules, rule)
+	}
+
+	tests := []struct {
+		relPath  string
+		isDir    bool
+		expected bool
+	}{
+		{relPath: "dist", isDir: true, expected: true},
+		{relPath: "packages/ui/dist", isDir: true, expected: true},
+		{relPath: "dist", isDir: false, expected: false},
+		{relPath: "src/Form.gen.vue", expected: true},
+		{relPath: "src/keep.gen.vue", expected: false},
+		{relPath: "legacy", isDir: true, expected: true},
+		{relPath: "src/legacy", isDir: true, expected: false},
+		{relPath: "docs/examples", isDir: true, expected: true},
+		{relPath: "docs/forms/basic/examples", isDir: true, expected: true},
+		{relPath: "#notes.vue", expected: true},
+		{relPath: "packages/ui/drafts", isDir: true, expected: true},
+		{relPath: "packages/forms/drafts", isDir: true, expected: false},
+		{relPath: "src/App.vue", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.relPath, func(t *testing.T) {
+			if result := matcher.ignored(tt.relPath, tt.isDir); result != tt.expected {
+				t.Errorf("ignored(%q, %v) = %v, want %v", tt.relPath, tt.isDir, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiscoverFiles_IgnoreFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		".gitignore":                  "dist/\n*.gen.vue\n",
+		"src/App.vue":                 "",
+		"src/Form.gen.vue":            "",
+		"dist/App.vue":                "",
+		"packages/ui/.ui-elfignore":   "drafts/\n",
+		"packages/ui/Button.vue":      "",
+		"packages/ui/drafts/Card.vue": "",
+	}
+	for file, content := range files {
+		fullPath := filepath.Join(tmpDir, file)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	service := NewFileDiscoveryService()
+	discover := func(filter types.FileFilter) []string {
+		found, err := service.DiscoverFiles(tmpDir, filter)
+		if err != nil {
+			t.Fatalf("DiscoverFiles() error = %v", err)
+		}
+		var relPaths []string
+		for _, path := range found {
+			relPath, _ := filepath.Rel(tmpDir, path)
+			relPaths = append(relPaths, filepath.ToSlash(relPath))
+		}
+		sort.Strings(relPaths)
+		return relPaths
+	}
+
+	expected := []string{"packages/ui/Button.vue", "src/App.vue"}
+	if found := discover(types.FileFilter{FileExtensions: []string{".vue"}, IgnoreFiles: DefaultIgnoreFiles}); !reflect.DeepEqual(found, expected) {
+		t.Errorf("DiscoverFiles() with ignore files = %v, want %v", found, expected)
+	}
+	if found := discover(types.FileFilter{FileExtensions: []string{".vue"}}); len(found) != 5 {
+		t.Errorf("DiscoverFiles() without ignore files = %v, want all 5 .vue files", found)
+	}
+}
+
+func TestDiscoverFiles_AncestorIgnoreFiles(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		".git/HEAD":                        "ref: refs/heads/main\n",
+		".gitignore":                       "dist/\n*.gen.vue\n/packages/ui/src/legacy/\n/src/\n",
+		"packages/.ui-elfignore":           "drafts/\n!Keep.gen.vue\n",
+		"packages/ui/src/App.vue":          "",
+		"packages/ui/src/Form.gen.vue":     "",
+		"packages/ui/src/Keep.gen.vue":     "",
+		"packages/ui/src/dist/App.vue":     "",
+		"packages/ui/src/drafts/Card.vue":  "",
+		"packages/ui/src/legacy/Old.vue":   "",
+		"packages/ui/src/src/Nested.vue":   "",
+		"packages/ui/src/widgets/Menu.vue": "",
+	}
+	for file, content := range files {
+		fullPath := filepath.Join(repoDir, file)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	scanDir := filepath.Join(repoDir, "packages", "ui", "src")
+	service := NewFileDiscoveryService()
+	skipped := make(map[string]string)
+	service.SetSkipHandler(func(path string, reason string) {
+		relPath, _ := filepath.Rel(scanDir, path)
+		skipped[filepath.ToSlash(relPath)] = reason
+	})
+
+	found, err := service.DiscoverFiles(scanDir, types.FileFilter{FileExtensions: []string{".vue"}, IgnoreFiles: DefaultIgnoreFiles})
+	if err != nil {
+		t.Fatalf("DiscoverFiles() error = %v", err)
+	}
+	var relPaths []string
+	for _, path := range found {
+		relPath, _ := filepath.Rel(scanDir, path)
+		relPaths = append(relPaths, filepath.ToSlash(relPath))
+	}
+	sort.Strings(relPaths)
+
+	// /src/ is anchored to the repository root, not to the scanned directory
+	expected := []string{"App.vue", "Keep.gen.vue", "src/Nested.vue", "widgets/Menu.vue"}
+	if !reflect.DeepEqual(relPaths, expected) {
+		t.Errorf("DiscoverFiles() = %v, want %v", relPaths, expected)
+	}
+	if reason := skipped["legacy"]; reason != "ignored by ../../../.gitignore" {
+		t.Errorf("Skip reason of legacy = %q, want the repository .gitignore", reason)
+	}
+	if reason := skipped["drafts"]; reason != "ignored by ../../.ui-elfignore" {
+		t.Errorf("Skip reason of drafts = %q, want the packages .ui-elfignore", reason)
+	}
+}
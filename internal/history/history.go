@@ -0,0 +1,81 @@
+// Package history records scan summaries in a JSON Lines file to report component usage over time.
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"ui-elf/internal/types"
+)
+
+// DefaultPath is the history file of the report command when none is given
+const DefaultPath = ".ui-elf/history.jsonl"
+
+// NewEntry returns the history entry of a scan result, at the given time and commit
+func NewEntry(result *types.ScanResult, commit string, at time.Time) types.HistoryEntry {
+	return types.HistoryEntry{
+		Time:            at.UTC().Truncate(time.Second),
+		Commit:          commit,
+		ComponentType:   result.ComponentType,
+		TotalCount:      result.TotalCount,
+		ScannedFiles:    result.ScannedFiles,
+		ComponentCounts: result.ComponentCounts,
+	}
+}
+
+// Append adds an entry as a JSON line to the history file, creating the file and its directory
+// if needed
+func Append(path string, entry types.HistoryEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal history entry: %w", err)
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create history directory: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open history file: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	return nil
+}
+
+// Load reads the entries of a history file in their order; blank lines are skipped
+// A missing file has no entries
+func Load(path string) ([]types.HistoryEntry, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history file: %w", err)
+	}
+
+	var entries []types.HistoryEntry
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var entry types.HistoryEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse history file %s, line %d: %w", path, i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,80 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"ui-elf/internal/types"
+)
+
+func TestAppendAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".ui-elf", "history.jsonl")
+	at := time.Date(2026, 10, 1, 12, 30, 15, 500, time.UTC)
+	entries := []types.HistoryEntry{
+		NewEntry(&types.ScanResult{ComponentType: "deprecated", TotalCount: 42, ScannedFiles: 120, ComponentCounts: map[string]int{"QDialog": 42}}, "1a2b3c4d", at),
+		NewEntry(&types.ScanResult{ComponentType: "deprecated", TotalCount: 30, ScannedFiles: 118}, "", at.Add(24*time.Hour)),
+	}
+
+	for _, entry := range entries {
+		if err := Append(path, entry); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(loaded, entries) {
+		t.Errorf("Load() = %+v, want %+v", loaded, entries)
+	}
+	if !loaded[0].Time.Equal(time.Date(2026, 10, 1, 12, 30, 15, 0, time.UTC)) {
+		t.Errorf("Expected the time in whole seconds, got %v", loaded[0].Time)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("missing file has no entries", func(t *testing.T) {
+		entries, err := Load(filepath.Join(dir, "missing.jsonl"))
+		if err != nil || len(entries) != 0 {
+			t.Errorf("Load() = %v, %v; want no entries", entries, err)
+		}
+	})
+
+	t.Run("skips blank lines", func(t *testing.T) {
+		path := filepath.Join(dir, "blank.jsonl")
+		content := "{\"componentType\":\"button\",\"totalCount\":3}\n\n{\"componentType\":\"button\",\"totalCount\":4}\n"
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write history file: %v", err)
+		}
+
+		entries, err := Load(path)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if len(entries) != 2 || entries[1].TotalCount != 4 {
+			t.Errorf("Expected 2 entries, got %+v", entries)
+		}
+	})
+
+	t.Run("reports the invalid line", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.jsonl")
+		if err := os.WriteFile(path, []byte("{\"totalCount\":3}\nnot json\n"), 0644); err != nil {
+			t.Fatalf("Failed to write history file: %v", err)
+		}
+
+		_, err := Load(path)
+		if err == nil {
+			t.Fatal("Expected an error for an invalid line")
+		}
+		if want := "line 2"; !strings.Contains(err.Error(), want) {
+			t.Errorf("Error should name %s, got: %v", want, err)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package i18n
+
+// catalogDE holds the German translations
+var catalogDE = map[string]string{
+	// Terminal output
+	"Component Finder Results - %s":    "Component Finder Ergebnisse - %s",
+	"Match mode: %s":                   "Vergleichsmodus: %s",
+	"No components found.":             "Keine Komponenten gefunden.",
+	"Found components by %s:":          "Gefundene Komponenten nach %s:",
+	"Found components in:":             "Gefundene Komponenten in:",
+	"%s (line %d): %s%s":               "%s (Zeile %d): %s%s",
+	"line %d: %s%s":                    "Zeile %d: %s%s",
+	"(deprecated, use %s)":             "(veraltet, stattdessen %s verwenden)",
+	"Total components found: %d":       "Gefundene Komponenten insgesamt: %d",
+	"in stories: %d":                   "in Stories: %d",
+	"in tests: %d":                     "in Tests: %d",
+	"in documentation: %d":             "in der Dokumentation: %d",
+	"from %s: %d":                      "aus %s: %d",
+	"Unique components: %d":            "Verschiedene Komponenten: %d",
+	"Files affected: %d":               "Betroffene Dateien: %d",
+	"By directory:":                    "Nach Ordner:",
+	"Top directories:":                 "Häufigste Verzeichnisse:",
+	"Top components:":                  "Häufigste Komponenten:",
+	"Top files:":                       "Dateien mit den meisten Verwendungen:",
+	"By type:":                         "Nach Typ:",
+	"By component:":                    "Nach Komponente:",
+	"By root:":                         "Nach Verzeichnis:",
+	"%s: %d in %d file(s)":             "%s: %d in %d Datei(en)",
+	"By rule:":                         "Nach Regel:",
+	"Files scanned: %d":                "Durchsuchte Dateien: %d",
+	"Scan time: %dms":                  "Suchdauer: %dms",
+	"Warnings:":                        "Warnungen:",
+	"Component Finder Diff - %s -> %s": "Component Finder Vergleich - %s -> %s",
+	"Total components: %s":             "Komponenten insgesamt: %s",
+	"Removed (%d):":                    "Entfernt (%d):",
+	"Added (%d):":                      "Hinzugefügt (%d):",
+	"No changes in component usage.":   "Keine Änderungen der Komponentennutzung.",
+	"Component Usage Trend - %s":       "Verlauf der Komponentennutzung - %s",
+	"No history entries.":              "Keine Einträge im Verlauf.",
+	"Date":                             "Datum",
+	"Commit":                           "Commit",
+	"Type":                             "Typ",
+	"Total":                            "Gesamt",
+	"Change":                           "Änderung",
+	"Results written to %s":            "Ergebnisse geschrieben nach %s",
+	"Results also written to %s":       "Ergebnisse auch geschrieben nach %s",
+
+	// Command messages
+	"Error: %v": "Fehler: %v",
+	"Wrote %s (frameworks: %s; libraries: %s)":                        "%s geschrieben (Frameworks: %s; Bibliotheken: %s)",
+	"Wrote %s docs to %s":                                             "%s-Dokumentation nach %s geschrieben",
+	"configuration file %s already exists; use --force to replace it": "Konfigurationsdatei %s existiert bereits; mit --force wird sie ersetzt",
+
+	// File listing
+	"scan %s":      "durchsuchen %s",
+	"skip %s (%s)": "überspringen %s (%s)",
+
+	// Prompts
+	"Component type to search for:": "Gesuchter Komponententyp:",
+	"Choose 1-%d or a name: ":       "1-%d oder einen Namen wählen: ",
+	"invalid choice '%s'":           "ungültige Auswahl '%s'",
+	"no choice made":                "keine Auswahl getroffen",
+
+	// CI gating
+	"found %d component(s), %s":       "%d Komponente(n) gefunden, %s",
+	"expected none (--fail-if-found)": "keine erwartet (--fail-if-found)",
+	"more than --max-count %d":        "mehr als --max-count %d",
+	"fewer than --min-count %d":       "weniger als --min-count %d",
+
+	// Validation
+	`required flag "component-type" not set: pass --component-type or set scan.componentType in the configuration file`: `Erforderliches Flag "component-type" fehlt: --component-type angeben oder scan.componentType in der Konfigurationsdatei setzen`,
+	"invalid component type '%s': must be one of: %s":                                                                   "ungültiger Komponententyp '%s': erlaubt sind: %s",
+	"no deprecated components: list them in the deprecated section of a registry file":                                  "keine veralteten Komponenten: im Abschnitt deprecated einer Registry-Datei auflisten",
+	"--component-name requires --component-type %s, not '%s'":                                                           "--component-name erfordert --component-type %s, nicht '%s'",
+	"invalid component name '%s': expected a component name such as MyWidget or my-widget":                              "ungültiger Komponentenname '%s': erwartet wird ein Name wie MyWidget oder my-widget",
+	"invalid library '%s': must be one of: %s":                                                                          "ungültige Bibliothek '%s': erlaubt sind: %s",
+	"invalid match mode '%s': must be one of: %s, %s, %s":                                                               "ungültiger Vergleichsmodus '%s': erlaubt sind: %s, %s, %s",
+	"invalid output format '%s': must be one of: terminal, json, both, stdout, xml, xlsx":                               "ungültiges Ausgabeformat '%s': erlaubt sind: terminal, json, both, stdout, xml, xlsx",
+	"invalid sort '%s': must be one of: %s":                                                                             "ungültige Sortierung '%s': erlaubt sind: %s",
+	"invalid hyperlinks '%s': must be one of: %s":                                                                       "ungültiger Wert für hyperlinks '%s': erlaubt sind: %s",
+	"--summary cannot be combined with --group-by":                                                                      "--summary kann nicht mit --group-by kombiniert werden",
+	"invalid layout '%s': must be one of: %s":                                                                           "ungültiges Layout '%s': erlaubt sind: %s",
+	"invalid group-by '%s': must be one of: %s":                                                                         "ungültige Gruppierung '%s': erlaubt sind: %s",
+	"invalid max-count %d: must be -1 (no limit) or more":                                                               "ungültiger max-count %d: muss -1 (keine Grenze) oder größer sein",
+	"invalid path style '%s': must be one of: %s, %s, %s":                                                               "ungültiger Pfadstil '%s': erlaubt sind: %s, %s, %s",
+	"invalid concurrency %d: must be 1 or more":                                                                         "ungültige Parallelität %d: muss 1 oder größer sein",
+	"invalid top %d: must be 0 (no ranking) or more":                                                                    "ungültiges top %d: muss 0 (keine Rangliste) oder größer sein",
+	"invalid directory-depth %d: must be 0 (full directory) or more":                                                    "ungültige directory-depth %d: muss 0 (ganzer Ordner) oder größer sein",
+	"invalid max-depth %d: must be 0 (no limit) or more":                                                                "ungültige max-depth %d: muss 0 (keine Grenze) oder größer sein",
+	"invalid min-count %d: must be 0 or more":                                                                           "ungültiger min-count %d: muss 0 oder größer sein",
+	"min-count %d is greater than max-count %d":                                                                         "min-count %d ist größer als max-count %d",
+	"invalid output format '%s': must be one of: terminal, json":                                                        "ungültiges Ausgabeformat '%s': erlaubt sind: terminal, json",
+	"invalid last %d: must be 0 (all) or more":                                                                          "ungültiger Wert für last %d: muss 0 (alle) oder größer sein",
+	"--compress requires --output json, both or xml written to a file":                                                  "--compress erfordert --output json, both oder xml mit Ausgabe in eine Datei",
+	"--output-file requires --output json, both, xml or xlsx":                                                           "--output-file erfordert --output json, both, xml oder xlsx",
+	"invalid profile '%s': must be one of: web, react-native":                                                           "ungültiges Profil '%s': erlaubt sind: web, react-native",
+	"invalid context '%d': must not be negative":                                                                        "ungültiger Kontext '%d': darf nicht negativ sein",
+	"invalid parser engine '%s': must be one of: tokenizer, regex":                                                      "ungültige Parser-Engine '%s': erlaubt sind: tokenizer, regex",
+	"directory not found: %s":                                                                                           "Verzeichnis nicht gefunden: %s",
+	"config file not found: %s":                                                                                         "Konfigurationsdatei nicht gefunden: %s",
+	"invalid log format '%s': must be one of: text, json":                                                               "ungültiges Log-Format '%s': erlaubt sind: text, json",
+	"invalid format '%s': must be one of: yaml, json":                                                                   "ungültiges Format '%s': erlaubt sind: yaml, json",
+	"directories given both as arguments and with --directory: use one of them":                                         "Verzeichnisse sowohl als Argumente als auch mit --directory angegeben: nur eines davon verwenden",
+	"invalid --map value '%s': expected type=Name[,Name]":                                                               "ungültiger --map-Wert '%s': erwartet wird typ=Name[,Name]",
+	"invalid --map value '%s': no component names given":                                                                "ungültiger --map-Wert '%s': keine Komponentennamen angegeben",
+	"failed to display output: %w":                                                                                      "Ausgabe fehlgeschlagen: %w",
+	"scan failed: %w":                                                                                                   "Scan fehlgeschlagen: %w",
+	"scan execution failed: %w":                                                                                         "Ausführung des Scans fehlgeschlagen: %w",
+	"failed to discover files: %w":                                                                                      "Dateien konnten nicht ermittelt werden: %w",
+	"invalid --map value: %w":                                                                                           "ungültiger --map-Wert: %w",
+	"invalid configuration: %w":                                                                                         "ungültige Konfiguration: %w",
+	"failed to encode configuration: %w":                                                                                "Konfiguration konnte nicht kodiert werden: %w",
+	"failed to create output directory: %w":                                                                             "Ausgabeverzeichnis konnte nicht erstellt werden: %w",
+	"invalid SOURCE_DATE_EPOCH '%s': %w":                                                                                "ungültiges SOURCE_DATE_EPOCH '%s': %w",
+	"failed to generate %s docs: %w":                                                                                    "%s-Dokumentation konnte nicht erzeugt werden: %w",
+	"failed to create config file: %w":                                                                                  "Konfigurationsdatei konnte nicht erstellt werden: %w",
+	"failed to write config file: %w":                                                                                   "Konfigurationsdatei konnte nicht geschrieben werden: %w",
+	"failed to encode registry: %w":                                                                                     "Registry konnte nicht kodiert werden: %w",
+}
@@ -0,0 +1,119 @@
+package i18n
+
+// catalogIT holds the Italian translations
+var catalogIT = map[string]string{
+	// Terminal output
+	"Component Finder Results - %s":    "Risultati di Component Finder - %s",
+	"Match mode: %s":                   "Modalità di confronto: %s",
+	"No components found.":             "Nessun componente trovato.",
+	"Found components by %s:":          "Componenti trovati per %s:",
+	"Found components in:":             "Componenti trovati in:",
+	"%s (line %d): %s%s":               "%s (riga %d): %s%s",
+	"line %d: %s%s":                    "riga %d: %s%s",
+	"(deprecated, use %s)":             "(deprecato, usare %s)",
+	"Total components found: %d":       "Totale componenti trovati: %d",
+	"in stories: %d":                   "nelle stories: %d",
+	"in tests: %d":                     "nei test: %d",
+	"in documentation: %d":             "nella documentazione: %d",
+	"from %s: %d":                      "da %s: %d",
+	"Unique components: %d":            "Componenti distinti: %d",
+	"Files affected: %d":               "File interessati: %d",
+	"By directory:":                    "Per cartella:",
+	"Top directories:":                 "Directory principali:",
+	"Top components:":                  "Componenti più usati:",
+	"Top files:":                       "File con più utilizzi:",
+	"By type:":                         "Per tipo:",
+	"By component:":                    "Per componente:",
+	"By root:":                         "Per directory:",
+	"%s: %d in %d file(s)":             "%s: %d in %d file",
+	"By rule:":                         "Per regola:",
+	"Files scanned: %d":                "File analizzati: %d",
+	"Scan time: %dms":                  "Tempo di analisi: %dms",
+	"Warnings:":                        "Avvisi:",
+	"Component Finder Diff - %s -> %s": "Component Finder Confronto - %s -> %s",
+	"Total components: %s":             "Componenti totali: %s",
+	"Removed (%d):":                    "Rimossi (%d):",
+	"Added (%d):":                      "Aggiunti (%d):",
+	"No changes in component usage.":   "Nessuna variazione nell'uso dei componenti.",
+	"Component Usage Trend - %s":       "Andamento dell'uso dei componenti - %s",
+	"No history entries.":              "Nessuna voce nella cronologia.",
+	"Date":                             "Data",
+	"Commit":                           "Commit",
+	"Type":                             "Tipo",
+	"Total":                            "Totale",
+	"Change":                           "Variazione",
+	"Results written to %s":            "Risultati scritti in %s",
+	"Results also written to %s":       "Risultati scritti anche in %s",
+
+	// Command messages
+	"Error: %v": "Errore: %v",
+	"Wrote %s (frameworks: %s; libraries: %s)":                        "Scritto %s (framework: %s; librerie: %s)",
+	"Wrote %s docs to %s":                                             "Documentazione %s scritta in %s",
+	"configuration file %s already exists; use --force to replace it": "il file di configurazione %s esiste già; usare --force per sostituirlo",
+
+	// File listing
+	"scan %s":      "analizza %s",
+	"skip %s (%s)": "salta %s (%s)",
+
+	// Prompts
+	"Component type to search for:": "Tipo di componente da cercare:",
+	"Choose 1-%d or a name: ":       "Scegliere 1-%d o un nome: ",
+	"invalid choice '%s'":           "scelta '%s' non valida",
+	"no choice made":                "nessuna scelta effettuata",
+
+	// CI gating
+	"found %d component(s), %s":       "trovati %d componenti, %s",
+	"expected none (--fail-if-found)": "nessuno atteso (--fail-if-found)",
+	"more than --max-count %d":        "più di --max-count %d",
+	"fewer than --min-count %d":       "meno di --min-count %d",
+
+	// Validation
+	`required flag "component-type" not set: pass --component-type or set scan.componentType in the configuration file`: `flag obbligatorio "component-type" mancante: passare --component-type o impostare scan.componentType nel file di configurazione`,
+	"invalid component type '%s': must be one of: %s":                                                                   "tipo di componente '%s' non valido: valori ammessi: %s",
+	"no deprecated components: list them in the deprecated section of a registry file":                                  "nessun componente deprecato: elencarli nella sezione deprecated di un file di registry",
+	"--component-name requires --component-type %s, not '%s'":                                                           "--component-name richiede --component-type %s, non '%s'",
+	"invalid component name '%s': expected a component name such as MyWidget or my-widget":                              "nome di componente '%s' non valido: atteso un nome come MyWidget o my-widget",
+	"invalid library '%s': must be one of: %s":                                                                          "libreria '%s' non valida: valori ammessi: %s",
+	"invalid match mode '%s': must be one of: %s, %s, %s":                                                               "modalità di confronto '%s' non valida: valori ammessi: %s, %s, %s",
+	"invalid output format '%s': must be one of: terminal, json, both, stdout, xml, xlsx":                               "formato di output '%s' non valido: valori ammessi: terminal, json, both, stdout, xml, xlsx",
+	"invalid sort '%s': must be one of: %s":                                                                             "ordinamento '%s' non valido: valori ammessi: %s",
+	"invalid hyperlinks '%s': must be one of: %s":                                                                       "valore di hyperlinks '%s' non valido: valori ammessi: %s",
+	"--summary cannot be combined with --group-by":                                                                      "--summary non può essere combinato con --group-by",
+	"invalid layout '%s': must be one of: %s":                                                                           "layout '%s' non valido: valori ammessi: %s",
+	"invalid group-by '%s': must be one of: %s":                                                                         "raggruppamento '%s' non valido: valori ammessi: %s",
+	"invalid max-count %d: must be -1 (no limit) or more":                                                               "max-count %d non valido: deve essere -1 (nessun limite) o maggiore",
+	"invalid path style '%s': must be one of: %s, %s, %s":                                                               "stile dei percorsi '%s' non valido: valori ammessi: %s, %s, %s",
+	"invalid concurrency %d: must be 1 or more":                                                                         "concorrenza %d non valida: deve essere 1 o maggiore",
+	"invalid top %d: must be 0 (no ranking) or more":                                                                    "top %d non valido: deve essere 0 (nessuna classifica) o maggiore",
+	"invalid directory-depth %d: must be 0 (full directory) or more":                                                    "directory-depth %d non valido: deve essere 0 (cartella intera) o maggiore",
+	"invalid max-depth %d: must be 0 (no limit) or more":                                                                "max-depth %d non valido: deve essere 0 (nessun limite) o maggiore",
+	"invalid min-count %d: must be 0 or more":                                                                           "min-count %d non valido: deve essere 0 o maggiore",
+	"min-count %d is greater than max-count %d":                                                                         "min-count %d è maggiore di max-count %d",
+	"invalid output format '%s': must be one of: terminal, json":                                                        "formato di output '%s' non valido: valori ammessi: terminal, json",
+	"invalid last %d: must be 0 (all) or more":                                                                          "valore di last %d non valido: deve essere 0 (tutte) o maggiore",
+	"--compress requires --output json, both or xml written to a file":                                                  "--compress richiede --output json, both o xml scritto su file",
+	"--output-file requires --output json, both, xml or xlsx":                                                           "--output-file richiede --output json, both, xml o xlsx",
+	"invalid profile '%s': must be one of: web, react-native":                                                           "profilo '%s' non valido: valori ammessi: web, react-native",
+	"invalid context '%d': must not be negative":                                                                        "contesto '%d' non valido: non può essere negativo",
+	"invalid parser engine '%s': must be one of: tokenizer, regex":                                                      "motore di parsing '%s' non valido: valori ammessi: tokenizer, regex",
+	"directory not found: %s":                                                                                           "directory non trovata: %s",
+	"config file not found: %s":                                                                                         "file di configurazione non trovato: %s",
+	"invalid log format '%s': must be one of: text, json":                                                               "formato di log '%s' non valido: valori ammessi: text, json",
+	"invalid format '%s': must be one of: yaml, json":                                                                   "formato '%s' non valido: valori ammessi: yaml, json",
+	"directories given both as arguments and with --directory: use one of them":                                         "directory indicate sia come argomenti sia con --directory: usarne solo uno",
+	"invalid --map value '%s': expected type=Name[,Name]":                                                               "valore di --map '%s' non valido: atteso tipo=Nome[,Nome]",
+	"invalid --map value '%s': no component names given":                                                                "valore di --map '%s' non valido: nessun nome di componente indicato",
+	"failed to display output: %w":                                                                                      "impossibile mostrare l'output: %w",
+	"scan failed: %w":                                                                                                   "scansione non riuscita: %w",
+	"scan execution failed: %w":                                                                                         "esecuzione della scansione non riuscita: %w",
+	"failed to discover files: %w":                                                                                      "impossibile individuare i file: %w",
+	"invalid --map value: %w":                                                                                           "valore di --map non valido: %w",
+	"invalid configuration: %w":                                                                                         "configurazione non valida: %w",
+	"failed to encode configuration: %w":                                                                                "impossibile codificare la configurazione: %w",
+	"failed to create output directory: %w":                                                                             "impossibile creare la directory di output: %w",
+	"invalid SOURCE_DATE_EPOCH '%s': %w":                                                                                "SOURCE_DATE_EPOCH '%s' non valido: %w",
+	"failed to generate %s docs: %w":                                                                                    "impossibile generare la documentazione %s: %w",
+	"failed to create config file: %w":                                                                                  "impossibile creare il file di configurazione: %w",
+	"failed to write config file: %w":                                                                                   "impossibile scrivere il file di configurazione: %w",
+	"failed to encode registry: %w":                                                                                     "impossibile codificare il registro: %w",
+}
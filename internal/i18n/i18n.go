@@ -0,0 +1,90 @@
+// Package i18n translates the user-facing messages of the CLI and its output.
+package i18n
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DefaultLanguage is the language of the messages in the source code, used when no catalog applies
+const DefaultLanguage = "en"
+
+// Languages lists the supported languages, as ISO 639-1 codes
+var Languages = []string{"en", "de", "it"}
+
+// catalogs maps each language to the translations of its messages
+// Messages are keyed by their English fmt format; a missing translation falls back to English
+var catalogs = map[string]map[string]string{
+	"de": catalogDE,
+	"it": catalogIT,
+}
+
+// localeVariables lists the environment variables selecting the language, by precedence
+var localeVariables = []string{"LC_ALL", "LC_MESSAGES", "LANG"}
+
+// Localizer formats messages in one language
+// A nil Localizer formats messages in English
+type Localizer struct {
+	language string
+	catalog  map[string]string
+}
+
+// New creates a localizer for a supported language
+func New(language string) (*Localizer, error) {
+	language = strings.ToLower(language)
+	if !slices.Contains(Languages, language) {
+		return nil, fmt.Errorf("unsupported language '%s': must be one of: %s", language, strings.Join(Languages, ", "))
+	}
+	return &Localizer{language: language, catalog: catalogs[language]}, nil
+}
+
+// Detect returns the language of the locale set in the environment (e.g. de for de_DE.UTF-8),
+// read with getenv, or DefaultLanguage if it is unset or unsupported
+func Detect(getenv func(string) string) string {
+	for _, variable := range localeVariables {
+		locale := getenv(variable)
+		if locale == "" {
+			continue
+		}
+		// language[_territory][.codeset][@modifier]; C and POSIX are English
+		language := strings.ToLower(locale)
+		if end := strings.IndexAny(language, "_-.@"); end >= 0 {
+			language = language[:end]
+		}
+		if slices.Contains(Languages, language) {
+			return language
+		}
+		return DefaultLanguage
+	}
+	return DefaultLanguage
+}
+
+// Language returns the language of the localizer
+func (l *Localizer) Language() string {
+	if l == nil {
+		return DefaultLanguage
+	}
+	return l.language
+}
+
+// Sprintf formats the translation of an English format
+func (l *Localizer) Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(l.translate(format), args...)
+}
+
+// Errorf creates an error from the translation of an English format, wrapping %w arguments
+func (l *Localizer) Errorf(format string, args ...any) error {
+	return fmt.Errorf(l.translate(format), args...)
+}
+
+// translate returns the translation of a message, or the message itself if there is none
+func (l *Localizer) translate(message string) string {
+	if l == nil {
+		return message
+	}
+	if translation, ok := l.catalog[message]; ok {
+		return translation
+	}
+	return message
+}
@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected string
+	}{
+		{name: "unset", env: nil, expected: "en"},
+		{name: "LANG with territory and codeset", env: map[string]string{"LANG": "de_DE.UTF-8"}, expected: "de"},
+		{name: "LANG with modifier", env: map[string]string{"LANG": "it_IT@euro"}, expected: "it"},
+		{name: "LC_ALL takes precedence", env: map[string]string{"LC_ALL": "it_IT.UTF-8", "LANG": "de_DE.UTF-8"}, expected: "it"},
+		{name: "LC_MESSAGES over LANG", env: map[string]string{"LC_MESSAGES": "de", "LANG": "it_IT.UTF-8"}, expected: "de"},
+		{name: "POSIX locale", env: map[string]string{"LANG": "C.UTF-8"}, expected: "en"},
+		{name: "unsupported language", env: map[string]string{"LANG": "fr_FR.UTF-8"}, expected: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if language := Detect(func(key string) string { return tt.env[key] }); language != tt.expected {
+				t.Errorf("Detect() = %q, want %q", language, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New("fr"); err == nil {
+		t.Error("New(fr) error = nil, want an unsupported language error")
+	}
+	loc, err := New("DE")
+	if err != nil {
+		t.Fatalf("New(DE) error = %v", err)
+	}
+	if loc.Language() != "de" {
+		t.Errorf("Language() = %q, want de", loc.Language())
+	}
+}
+
+func TestLocalizer_Sprintf(t *testing.T) {
+	var english *Localizer
+	if message := english.Sprintf("Files scanned: %d", 3); message != "Files scanned: 3" {
+		t.Errorf("nil Localizer Sprintf() = %q, want the English message", message)
+	}
+
+	german, _ := New("de")
+	if message := german.Sprintf("Files scanned: %d", 3); message != "Durchsuchte Dateien: 3" {
+		t.Errorf("German Sprintf() = %q, want %q", message, "Durchsuchte Dateien: 3")
+	}
+	if message := german.Sprintf("untranslated %s", "message"); message != "untranslated message" {
+		t.Errorf("German Sprintf() = %q, want the English fallback", message)
+	}
+}
+
+// verbRegex matches the fmt verbs of a format
+var verbRegex = regexp.MustCompile(`%[-+# 0]*[0-9]*(?:\.[0-9]+)?[a-zA-Z%]`)
+
+func TestCatalogs(t *testing.T) {
+	keys := slices.Sorted(maps.Keys(catalogDE))
+	for language, catalog := range catalogs {
+		if !reflect.DeepEqual(slices.Sorted(maps.Keys(catalog)), keys) {
+			t.Errorf("catalog %s translates other messages than the de catalog", language)
+		}
+		for message, translation := range catalog {
+			// Translations take the arguments of the message, in order
+			if verbs, translated := verbRegex.FindAllString(message, -1), verbRegex.FindAllString(translation, -1); !reflect.DeepEqual(verbs, translated) {
+				t.Errorf("catalog %s: %q has verbs %v, want %v of %q", language, translation, translated, verbs, message)
+			}
+		}
+	}
+}
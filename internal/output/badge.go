@@ -0,0 +1,47 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"ui-elf/internal/types"
+)
+
+// Colors of a badge
+const (
+	BadgeColorPass = "brightgreen" // The count passes the CI gating flags
+	BadgeColorFail = "red"         // The count fails the CI gating flags
+	BadgeColorInfo = "blue"        // The count is not gated
+)
+
+// Badge is a shields.io endpoint badge, rendered from https://img.shields.io/endpoint?url=<badge URL>
+type Badge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// NewBadge creates the badge of a scan result showing its component count, labeled
+// "<type> components" unless a label is given, in the given color
+func NewBadge(result *types.ScanResult, label string, color string) Badge {
+	if label == "" {
+		label = result.ComponentType + " components"
+	}
+	return Badge{SchemaVersion: 1, Label: label, Message: strconv.Itoa(result.TotalCount), Color: color}
+}
+
+// WriteBadge writes a badge as shields.io endpoint JSON to path
+func (f *OutputFormatter) WriteBadge(badge Badge, path string) error {
+	jsonBytes, err := json.MarshalIndent(badge, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal badge: %w", err)
+	}
+	if err := os.WriteFile(path, append(jsonBytes, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write badge file: %w", err)
+	}
+	f.logger.Debug("wrote badge", "path", path, "message", badge.Message)
+	return nil
+}
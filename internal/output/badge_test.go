@@ -0,0 +1,62 @@
+package output
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestNewBadge(t *testing.T) {
+	result := &types.ScanResult{TotalCount: 12, ComponentType: "deprecated"}
+
+	tests := []struct {
+		name     string
+		label    string
+		expected Badge
+	}{
+		{
+			name:     "labels the badge with the component type",
+			expected: Badge{SchemaVersion: 1, Label: "deprecated components", Message: "12", Color: BadgeColorFail},
+		},
+		{
+			name:     "uses the given label",
+			label:    "deprecated dialogs",
+			expected: Badge{SchemaVersion: 1, Label: "deprecated dialogs", Message: "12", Color: BadgeColorFail},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if badge := NewBadge(result, tt.label, BadgeColorFail); badge != tt.expected {
+				t.Errorf("NewBadge() = %+v, want %+v", badge, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWriteBadge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "badge.json")
+	badge := Badge{SchemaVersion: 1, Label: "buttons", Message: "3", Color: BadgeColorInfo}
+
+	if err := NewOutputFormatter().WriteBadge(badge, path); err != nil {
+		t.Fatalf("WriteBadge failed: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read badge file: %v", err)
+	}
+	var parsed map[string]any
+	if err := json.Unmarshal(content, &parsed); err != nil {
+		t.Fatalf("Badge file contains invalid JSON: %v", err)
+	}
+	expected := map[string]any{"schemaVersion": float64(1), "label": "buttons", "message": "3", "color": "blue"}
+	for key, value := range expected {
+		if parsed[key] != value {
+			t.Errorf("Badge %s = %v, want %v", key, parsed[key], value)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package output
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// CompressedExtension is appended to the paths of the result files written compressed
+const CompressedExtension = ".gz"
+
+// writeFile writes data to path, gzip-compressed to path with CompressedExtension if the
+// formatter compresses its files
+// Returns the path of the file written
+func (f *OutputFormatter) writeFile(path string, data []byte) (string, error) {
+	if !f.compress {
+		return path, os.WriteFile(path, data, 0644)
+	}
+
+	if !strings.HasSuffix(path, CompressedExtension) {
+		path += CompressedExtension
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	writer := gzip.NewWriter(file)
+	if _, err := writer.Write(data); err != nil {
+		return "", fmt.Errorf("failed to compress %s: %w", path, err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("failed to compress %s: %w", path, err)
+	}
+	return path, file.Close()
+}
@@ -0,0 +1,73 @@
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestWrite_Compressed(t *testing.T) {
+	result := &types.ScanResult{
+		Matches:       []types.ComponentMatch{{FilePath: "src/App.vue", Line: 3, ComponentName: "q-btn", ComponentType: "button"}},
+		TotalCount:    1,
+		ComponentType: "button",
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		path     string
+		expected string
+	}{
+		{name: "appends the extension", format: "json", path: "results.json", expected: "results.json.gz"},
+		{name: "keeps the extension", format: "json", path: "results.json.gz", expected: "results.json.gz"},
+		{name: "compresses XML", format: "xml", path: "results.xml", expected: "results.xml.gz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			var out bytes.Buffer
+			formatter := NewOutputFormatter()
+			formatter.SetOutput(&out)
+			formatter.SetCompress(true)
+
+			if err := formatter.Write(result, tt.format, filepath.Join(dir, tt.path)); err != nil {
+				t.Fatalf("Write failed: %v", err)
+			}
+			expectedPath := filepath.Join(dir, tt.expected)
+			if !strings.Contains(out.String(), expectedPath) {
+				t.Errorf("Output should name the file written %s, got:\n%s", expectedPath, out.String())
+			}
+
+			file, err := os.Open(expectedPath)
+			if err != nil {
+				t.Fatalf("Failed to open output file: %v", err)
+			}
+			defer file.Close()
+			reader, err := gzip.NewReader(file)
+			if err != nil {
+				t.Fatalf("Output file is not gzip-compressed: %v", err)
+			}
+			content, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("Failed to decompress output file: %v", err)
+			}
+			if tt.format == "json" {
+				var parsed types.ScanResult
+				if err := json.Unmarshal(content, &parsed); err != nil || parsed.TotalCount != 1 {
+					t.Errorf("Output file should contain the JSON result, got %s (%v)", content, err)
+				}
+			} else if !strings.Contains(string(content), `<scanResult componentType="button"`) {
+				t.Errorf("Output file should contain the XML result, got:\n%s", content)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// FormatDiffTerminal formats the differences between two scan results for terminal display
+// Shows the change of the total, the removed and added matches and the change per component
+func (f *OutputFormatter) FormatDiffTerminal(diff *types.ResultDiff) string {
+	var sb strings.Builder
+
+	// Header
+	fmt.Fprintf(&sb, "\n%s\n", f.loc.Sprintf("Component Finder Diff - %s -> %s", diff.Before, diff.After))
+	sb.WriteString(strings.Repeat("=", 50))
+	sb.WriteString("\n\n")
+	fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Total components: %s", formatDelta(diff.TotalCount)))
+
+	// Matches
+	if len(diff.Removed) > 0 {
+		fmt.Fprintf(&sb, "\n%s\n", f.loc.Sprintf("Removed (%d):", len(diff.Removed)))
+		for _, match := range diff.Removed {
+			fmt.Fprintf(&sb, "  - %s\n", f.loc.Sprintf("%s (line %d): %s%s", match.FilePath, match.Line, match.ComponentName, f.matchMarkers(match)))
+		}
+	}
+	if len(diff.Added) > 0 {
+		fmt.Fprintf(&sb, "\n%s\n", f.loc.Sprintf("Added (%d):", len(diff.Added)))
+		for _, match := range diff.Added {
+			fmt.Fprintf(&sb, "  + %s\n", f.loc.Sprintf("%s (line %d): %s%s", match.FilePath, match.Line, match.ComponentName, f.matchMarkers(match)))
+		}
+	}
+
+	// Counts
+	sb.WriteString("\n")
+	sb.WriteString(strings.Repeat("-", 50))
+	sb.WriteString("\n")
+	if len(diff.Components) == 0 {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("No changes in component usage."))
+		return sb.String()
+	}
+	fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("By component:"))
+	for _, component := range diff.Components {
+		fmt.Fprintf(&sb, "  %s: %s\n", component.Component, formatDelta(component))
+	}
+	return sb.String()
+}
+
+// formatDelta formats a change of usages as before -> after (+delta)
+func formatDelta(delta types.ComponentDelta) string {
+	return fmt.Sprintf("%d -> %d (%+d)", delta.Before, delta.After, delta.Delta)
+}
+
+// FormatDiffJSON formats the differences between two scan results as JSON
+func (f *OutputFormatter) FormatDiffJSON(diff *types.ResultDiff) (string, error) {
+	jsonBytes, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	return string(jsonBytes), nil
+}
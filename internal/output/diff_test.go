@@ -0,0 +1,50 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestFormatDiffTerminal(t *testing.T) {
+	diff := &types.ResultDiff{
+		Before:     "before.json",
+		After:      "after.json",
+		TotalCount: types.ComponentDelta{Before: 3, After: 2, Delta: -1},
+		Added:      []types.ComponentMatch{{FilePath: "src/C.vue", Line: 2, ComponentName: "AppModal"}},
+		Removed: []types.ComponentMatch{
+			{FilePath: "src/A.vue", Line: 3, ComponentName: "q-dialog", Replacement: "AppModal"},
+			{FilePath: "src/A.vue", Line: 9, ComponentName: "q-dialog", Replacement: "AppModal"},
+		},
+		Components: []types.ComponentDelta{
+			{Component: "QDialog", Before: 2, After: 0, Delta: -2},
+			{Component: "AppModal", Before: 0, After: 1, Delta: 1},
+		},
+	}
+
+	output := NewOutputFormatter().FormatDiffTerminal(diff)
+	for _, expected := range []string{
+		"Component Finder Diff - before.json -> after.json",
+		"Total components: 3 -> 2 (-1)",
+		"Removed (2):\n  - src/A.vue (line 3): q-dialog (deprecated, use AppModal)\n",
+		"Added (1):\n  + src/C.vue (line 2): AppModal\n",
+		"By component:\n  QDialog: 2 -> 0 (-2)\n  AppModal: 0 -> 1 (+1)\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Output should contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+func TestFormatDiffTerminal_NoChanges(t *testing.T) {
+	diff := &types.ResultDiff{Before: "a.json", After: "b.json", TotalCount: types.ComponentDelta{Before: 1, After: 1}}
+
+	output := NewOutputFormatter().FormatDiffTerminal(diff)
+	if !strings.Contains(output, "No changes in component usage.") {
+		t.Errorf("Output should report no changes, got:\n%s", output)
+	}
+	if strings.Contains(output, "Added") || strings.Contains(output, "Removed") {
+		t.Errorf("Output should not list empty match lists, got:\n%s", output)
+	}
+}
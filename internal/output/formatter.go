@@ -4,18 +4,87 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"sort"
 	"strings"
 
+	"ui-elf/internal/i18n"
 	"ui-elf/internal/types"
 )
 
+// DefaultJSONPath is the file JSON results are written to when no output file is given
+const DefaultJSONPath = "ui-elf-results.json"
+
+// StdoutPath is the output path writing JSON results to standard output instead of a file
+const StdoutPath = "-"
+
+// Layouts of the matches in terminal output
+const (
+	LayoutFlat = "flat" // One line per match, with its file path
+	LayoutFile = "file" // Matches listed under their file, with the count per file
+)
+
+// Layouts lists the valid --layout values
+var Layouts = []string{LayoutFlat, LayoutFile}
+
 // OutputFormatter handles formatting and displaying scan results
-type OutputFormatter struct{}
+type OutputFormatter struct {
+	out      io.Writer
+	errOut   io.Writer
+	logger   *slog.Logger
+	loc      *i18n.Localizer
+	layout   string
+	links    *LinkTemplate
+	quiet    bool
+	compress bool
+}
 
-// NewOutputFormatter creates a new output formatter
+// NewOutputFormatter creates a new output formatter writing to standard output and standard error
 func NewOutputFormatter() *OutputFormatter {
-	return &OutputFormatter{}
+	return &OutputFormatter{out: os.Stdout, errOut: os.Stderr, logger: slog.Default()}
+}
+
+// SetLogger sets the logger receiving the files written
+func (f *OutputFormatter) SetLogger(logger *slog.Logger) {
+	f.logger = logger
+}
+
+// SetLocalizer sets the language of the terminal output and notes; JSON output is not translated
+func (f *OutputFormatter) SetLocalizer(loc *i18n.Localizer) {
+	f.loc = loc
+}
+
+// SetLayout sets the layout of the matches in terminal output, LayoutFlat by default
+func (f *OutputFormatter) SetLayout(layout string) {
+	f.layout = layout
+}
+
+// SetLinkTemplate links the matches of the terminal output to the URLs of the template with OSC 8
+// hyperlinks; nil, the default, prints no links
+func (f *OutputFormatter) SetLinkTemplate(links *LinkTemplate) {
+	f.links = links
+}
+
+// SetCompress gzip-compresses the JSON and XML files written, to paths with CompressedExtension
+func (f *OutputFormatter) SetCompress(compress bool) {
+	f.compress = compress
+}
+
+// SetQuiet suppresses the notes on the files written, leaving only the results
+func (f *OutputFormatter) SetQuiet(quiet bool) {
+	f.quiet = quiet
+}
+
+// SetOutput sets the writer receiving the terminal output and JSON written to StdoutPath
+func (f *OutputFormatter) SetOutput(w io.Writer) {
+	f.out = w
+}
+
+// SetErrOutput sets the writer receiving the terminal output while JSON is written to the output
+func (f *OutputFormatter) SetErrOutput(w io.Writer) {
+	f.errOut = w
 }
 
 // FormatTerminal formats the scan result for terminal display
@@ -24,36 +93,258 @@ func (f *OutputFormatter) FormatTerminal(result *types.ScanResult) string {
 	var sb strings.Builder
 
 	// Header
-	fmt.Fprintf(&sb, "\nComponent Finder Results - %s\n", result.ComponentType)
+	fmt.Fprintf(&sb, "\n%s\n", f.loc.Sprintf("Component Finder Results - %s", result.ComponentType))
 	sb.WriteString(strings.Repeat("=", 50))
 	sb.WriteString("\n\n")
+	if result.MatchMode != "" && result.MatchMode != "exact" {
+		fmt.Fprintf(&sb, "%s\n\n", f.loc.Sprintf("Match mode: %s", result.MatchMode))
+	}
 
 	// File paths
-	if len(result.Matches) == 0 {
-		sb.WriteString("No components found.\n")
-	} else {
-		sb.WriteString("Found components in:\n\n")
+	switch {
+	case result.Summary != nil:
+		// A summary reports the statistics below only
+	case len(result.Matches) == 0:
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("No components found."))
+	case result.GroupBy != "":
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Found components by %s:", result.GroupBy))
+		for _, group := range result.Groups {
+			fmt.Fprintf(&sb, "\n  %s: %d\n", group.Key, group.Count)
+			for _, match := range group.Matches {
+				// Groups of a file need not repeat its path
+				f.writeMatch(&sb, match, "    ", result.GroupBy != GroupByFile)
+			}
+		}
+	case f.layout == LayoutFile:
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Found components in:"))
+		for _, file := range matchesByFile(result.Matches) {
+			fmt.Fprintf(&sb, "\n  %s: %d\n", file.Key, file.Count)
+			for _, match := range file.Matches {
+				f.writeMatch(&sb, match, "    ", false)
+			}
+		}
+	default:
+		fmt.Fprintf(&sb, "%s\n\n", f.loc.Sprintf("Found components in:"))
 		for _, match := range result.Matches {
-			fmt.Fprintf(&sb, "  %s (line %d): %s\n",
-				match.FilePath, match.Line, match.ComponentName)
+			f.writeMatch(&sb, match, "  ", true)
 		}
 	}
 
 	// Summary
-	sb.WriteString("\n")
+	if result.Summary == nil {
+		sb.WriteString("\n")
+	}
 	sb.WriteString(strings.Repeat("-", 50))
 	sb.WriteString("\n")
-	fmt.Fprintf(&sb, "Total components found: %d\n", result.TotalCount)
-	fmt.Fprintf(&sb, "Files scanned: %d\n", result.ScannedFiles)
-	fmt.Fprintf(&sb, "Scan time: %dms\n", result.ScanTimeMs)
+	fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Total components found: %d", result.TotalCount))
+	if storyCount := countMatches(result.Matches, func(m types.ComponentMatch) bool { return m.Story }); storyCount > 0 {
+		fmt.Fprintf(&sb, "  %s\n", f.loc.Sprintf("in stories: %d", storyCount))
+	}
+	if testCount := countMatches(result.Matches, func(m types.ComponentMatch) bool { return m.Test }); testCount > 0 {
+		fmt.Fprintf(&sb, "  %s\n", f.loc.Sprintf("in tests: %d", testCount))
+	}
+	if docsCount := countMatches(result.Matches, func(m types.ComponentMatch) bool { return m.Docs }); docsCount > 0 {
+		fmt.Fprintf(&sb, "  %s\n", f.loc.Sprintf("in documentation: %d", docsCount))
+	}
+	for _, library := range countByLibrary(result.Matches) {
+		fmt.Fprintf(&sb, "  %s\n", f.loc.Sprintf("from %s: %d", library.name, library.count))
+	}
+	if result.Summary != nil {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Unique components: %d", result.Summary.UniqueComponents))
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Files affected: %d", result.Summary.FilesAffected))
+	}
+	if len(result.TypeCounts) > 0 {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("By type:"))
+		for _, componentType := range sortCounts(result.TypeCounts) {
+			fmt.Fprintf(&sb, "  %s: %d\n", componentType.name, componentType.count)
+		}
+	}
+	if len(result.ComponentCounts) > 0 {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("By component:"))
+		for _, component := range sortCounts(result.ComponentCounts) {
+			fmt.Fprintf(&sb, "  %s: %d\n", component.name, component.count)
+		}
+	}
+	if len(result.DirectoryCounts) > 0 {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("By directory:"))
+		for _, directory := range result.DirectoryCounts {
+			fmt.Fprintf(&sb, "  %s\n", f.loc.Sprintf("%s: %d in %d file(s)", directory.Directory, directory.Count, directory.Files))
+		}
+	}
+	if result.Summary != nil && len(result.Summary.TopDirectories) > 0 {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Top directories:"))
+		for _, directory := range result.Summary.TopDirectories {
+			fmt.Fprintf(&sb, "  %s\n", f.loc.Sprintf("%s: %d in %d file(s)", directory.Directory, directory.Count, directory.Files))
+		}
+	}
+	if result.Top != nil {
+		writeRanking(&sb, f.loc.Sprintf("Top components:"), result.Top.Components)
+		writeRanking(&sb, f.loc.Sprintf("Top files:"), result.Top.Files)
+	}
+	if len(result.RootCounts) > 0 {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("By root:"))
+		for _, root := range result.RootCounts {
+			fmt.Fprintf(&sb, "  %s\n", f.loc.Sprintf("%s: %d in %d file(s)", root.Root, root.Count, root.ScannedFiles))
+		}
+	}
+	if len(result.RuleCounts) > 0 {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("By rule:"))
+		for _, rule := range result.RuleCounts {
+			fmt.Fprintf(&sb, "  %s: %d\n", formatRule(rule.MatchRule), rule.Count)
+		}
+	}
+	fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Files scanned: %d", result.ScannedFiles))
+	fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("Scan time: %dms", result.ScanTimeMs))
+
+	// Warnings
+	if len(result.Warnings) > 0 {
+		fmt.Fprintf(&sb, "\n%s\n", f.loc.Sprintf("Warnings:"))
+		for _, warning := range result.Warnings {
+			fmt.Fprintf(&sb, "  ! %s\n", warning)
+		}
+	}
 
 	return sb.String()
 }
 
+// writeMatch writes the location, component and markers of a match, followed by its snippet
+// The location is the line alone unless withPath is set; with a link template, the match links to its URL
+func (f *OutputFormatter) writeMatch(sb *strings.Builder, match types.ComponentMatch, indent string, withPath bool) {
+	var text string
+	if withPath {
+		text = f.loc.Sprintf("%s (line %d): %s%s", match.FilePath, match.Line, match.ComponentName, f.matchMarkers(match))
+	} else {
+		text = f.loc.Sprintf("line %d: %s%s", match.Line, match.ComponentName, f.matchMarkers(match))
+	}
+	if f.links != nil {
+		text = hyperlink(f.links.URL(match), text)
+	}
+	fmt.Fprintf(sb, "%s%s\n", indent, text)
+	if match.Snippet != "" {
+		for _, line := range strings.Split(match.Snippet, "\n") {
+			fmt.Fprintf(sb, "%s    | %s\n", indent, line)
+		}
+	}
+}
+
+// matchMarkers returns the annotations displayed after a match in terminal output
+func (f *OutputFormatter) matchMarkers(match types.ComponentMatch) string {
+	var markers strings.Builder
+	if match.ResolvedName != "" {
+		fmt.Fprintf(&markers, " (%s)", match.ResolvedName)
+	}
+	if match.Replacement != "" {
+		fmt.Fprintf(&markers, " %s", f.loc.Sprintf("(deprecated, use %s)", match.Replacement))
+	}
+	if match.Rule != nil {
+		fmt.Fprintf(&markers, " <- %s", formatRule(*match.Rule))
+	}
+	if match.Occurrences > 1 {
+		fmt.Fprintf(&markers, " x%d", match.Occurrences)
+	}
+	if match.Story {
+		markers.WriteString(" [story]")
+	}
+	if match.Test {
+		markers.WriteString(" [test]")
+	}
+	if match.Docs {
+		markers.WriteString(" [docs]")
+	}
+	if match.UsageKind != "" {
+		fmt.Fprintf(&markers, " [%s]", match.UsageKind)
+	}
+	if len(match.Props) > 0 {
+		fmt.Fprintf(&markers, " {%s}", formatProps(match.Props))
+	}
+	return markers.String()
+}
+
+// formatRule formats a registry rule as type/library "pattern" (button/quasar "q-btn")
+func formatRule(rule types.MatchRule) string {
+	formatted := rule.Type
+	if rule.Library != "" {
+		formatted += "/" + rule.Library
+	}
+	if rule.Pattern != "" {
+		formatted += fmt.Sprintf(" %q", rule.Pattern)
+	}
+	return formatted
+}
+
+// formatProps formats component props as a sorted, comma-separated list (variant=danger, disabled)
+func formatProps(props map[string]string) string {
+	names := sortedKeys(props)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name
+		if props[name] != "" {
+			parts[i] += "=" + props[name]
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// countMatches counts the matches satisfying the given predicate
+func countMatches(matches []types.ComponentMatch, predicate func(types.ComponentMatch) bool) int {
+	count := 0
+	for _, match := range matches {
+		if predicate(match) {
+			count++
+		}
+	}
+	return count
+}
+
+// nameCount holds the number of matches for a name (library or component)
+type nameCount struct {
+	name  string
+	count int
+}
+
+// countByLibrary counts matches per source library, most used first
+// Matches without a known library are not counted
+func countByLibrary(matches []types.ComponentMatch) []nameCount {
+	counts := make(map[string]int)
+	for _, match := range matches {
+		if match.Library != "" {
+			counts[match.Library]++
+		}
+	}
+	return sortCounts(counts)
+}
+
+// sortCounts orders counts by count, most used first, then by name
+func sortCounts(counts map[string]int) []nameCount {
+	sorted := make([]nameCount, 0, len(counts))
+	for name, count := range counts {
+		sorted = append(sorted, nameCount{name: name, count: count})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].count != sorted[j].count {
+			return sorted[i].count > sorted[j].count
+		}
+		return sorted[i].name < sorted[j].name
+	})
+	return sorted
+}
+
+// compactResult is the JSON of a grouped or summarized scan result, whose matches are listed
+// in their groups only, or not at all
+type compactResult struct {
+	*types.ScanResult
+	Matches []types.ComponentMatch `json:"matches,omitempty"`
+}
+
 // FormatJSON formats the scan result as JSON
-// Returns a JSON string with all result data
+// Returns a JSON string with all result data; grouped matches are only listed in their groups,
+// and a summary replaces the matches
 func (f *OutputFormatter) FormatJSON(result *types.ScanResult) (string, error) {
-	jsonBytes, err := json.MarshalIndent(result, "", "  ")
+	var value any = result
+	if result.GroupBy != "" || result.Summary != nil {
+		value = compactResult{ScanResult: result}
+	}
+	jsonBytes, err := json.MarshalIndent(value, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal JSON: %w", err)
 	}
@@ -61,47 +352,64 @@ func (f *OutputFormatter) FormatJSON(result *types.ScanResult) (string, error) {
 }
 
 // Write outputs the scan result according to the specified options
-// Supports terminal, JSON file output, or both
+// Supports terminal, JSON file output, or both. JSON is written to outputPath, DefaultJSONPath
+// if it is empty, or to the output of the formatter if it is StdoutPath. The stdout format
+// writes only JSON to the output, for piping into other tools. The xml and xlsx formats write
+// XML or an Excel workbook to outputPath, or DefaultXMLPath or DefaultXLSXPath if it is empty
+// While JSON is written to the output, the terminal output goes to the error output
 func (f *OutputFormatter) Write(result *types.ScanResult, format string, outputPath string) error {
 	switch format {
 	case "terminal":
-		fmt.Print(f.FormatTerminal(result))
+		fmt.Fprint(f.out, f.FormatTerminal(result))
+
+	case "stdout":
+		if _, err := f.writeJSON(result, StdoutPath); err != nil {
+			return err
+		}
 
 	case "json":
-		jsonStr, err := f.FormatJSON(result)
+		written, err := f.writeJSON(result, outputPath)
 		if err != nil {
 			return err
 		}
-
-		if outputPath == "" {
-			outputPath = "ui-elf-results.json"
+		if written != "" && !f.quiet {
+			fmt.Fprintln(f.out, f.loc.Sprintf("Results written to %s", written))
 		}
 
-		if err := os.WriteFile(outputPath, []byte(jsonStr), 0644); err != nil {
-			return fmt.Errorf("failed to write JSON file: %w", err)
-		}
-
-		fmt.Printf("Results written to %s\n", outputPath)
-
 	case "both":
-		// Display terminal output
-		fmt.Print(f.FormatTerminal(result))
+		// Display terminal output, kept apart from JSON written to the output
+		terminalOut := f.out
+		if outputPath == StdoutPath {
+			terminalOut = f.errOut
+		}
+		fmt.Fprint(terminalOut, f.FormatTerminal(result))
 
 		// Write JSON file
-		jsonStr, err := f.FormatJSON(result)
+		written, err := f.writeJSON(result, outputPath)
 		if err != nil {
 			return err
 		}
-
-		if outputPath == "" {
-			outputPath = "ui-elf-results.json"
+		if written != "" && !f.quiet {
+			fmt.Fprintf(f.out, "\n%s\n", f.loc.Sprintf("Results also written to %s", written))
 		}
 
-		if err := os.WriteFile(outputPath, []byte(jsonStr), 0644); err != nil {
-			return fmt.Errorf("failed to write JSON file: %w", err)
+	case "xml":
+		written, err := f.writeXML(result, outputPath)
+		if err != nil {
+			return err
+		}
+		if written != "" && !f.quiet {
+			fmt.Fprintln(f.out, f.loc.Sprintf("Results written to %s", written))
 		}
 
-		fmt.Printf("\nResults also written to %s\n", outputPath)
+	case "xlsx":
+		written, err := f.writeXLSX(result, outputPath)
+		if err != nil {
+			return err
+		}
+		if written != "" && !f.quiet {
+			fmt.Fprintln(f.out, f.loc.Sprintf("Results written to %s", written))
+		}
 
 	default:
 		return fmt.Errorf("unsupported output format: %s", format)
@@ -109,3 +417,27 @@ func (f *OutputFormatter) Write(result *types.ScanResult, format string, outputP
 
 	return nil
 }
+
+// writeJSON writes the scan result as JSON to outputPath, or DefaultJSONPath if it is empty
+// Returns the path of the file written, or "" if the JSON was written to the output (StdoutPath)
+func (f *OutputFormatter) writeJSON(result *types.ScanResult, outputPath string) (string, error) {
+	jsonStr, err := f.FormatJSON(result)
+	if err != nil {
+		return "", err
+	}
+
+	if outputPath == StdoutPath {
+		fmt.Fprintln(f.out, jsonStr)
+		return "", nil
+	}
+	if outputPath == "" {
+		outputPath = DefaultJSONPath
+	}
+
+	outputPath, err = f.writeFile(outputPath, []byte(jsonStr))
+	if err != nil {
+		return "", fmt.Errorf("failed to write JSON file: %w", err)
+	}
+	f.logger.Debug("wrote JSON results", "path", outputPath, "bytes", len(jsonStr))
+	return outputPath, nil
+}
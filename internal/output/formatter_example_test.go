@@ -56,6 +56,7 @@ func ExampleOutputFormatter_FormatJSON() {
 	formatter := output.NewOutputFormatter()
 
 	result := &types.ScanResult{
+		SchemaVersion: types.SchemaVersion,
 		Matches: []types.ComponentMatch{
 			{
 				FilePath:      "src/App.tsx",
@@ -75,6 +76,7 @@ func ExampleOutputFormatter_FormatJSON() {
 
 	// Output:
 	// {
+	//   "schemaVersion": "1.0",
 	//   "matches": [
 	//     {
 	//       "filePath": "src/App.tsx",
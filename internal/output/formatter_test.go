@@ -1,6 +1,7 @@
 package output
 
 import (
+	"bytes"
 	"encoding/json"
 	"os"
 	"strings"
@@ -84,6 +85,320 @@ func TestFormatTerminal(t *testing.T) {
 			t.Error("Output should contain scan time")
 		}
 	})
+
+	t.Run("marks matches inside stories", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Button.vue", Line: 3, ComponentName: "q-btn", ComponentType: "button"},
+				{FilePath: "src/Button.stories.tsx", Line: 8, ComponentName: "QBtn", ComponentType: "button", Story: true},
+			},
+			TotalCount:    2,
+			ComponentType: "button",
+			ScannedFiles:  2,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/Button.stories.tsx (line 8): QBtn [story]") {
+			t.Error("Output should mark story matches")
+		}
+		if strings.Contains(output, "src/Button.vue (line 3): q-btn [story]") {
+			t.Error("Output should not mark production matches as stories")
+		}
+		if !strings.Contains(output, "in stories: 1") {
+			t.Error("Output should contain the story match count")
+		}
+	})
+
+	t.Run("marks matches inside tests", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Dialog.vue", Line: 3, ComponentName: "q-dialog", ComponentType: "dialog"},
+				{FilePath: "src/Dialog.spec.ts", Line: 12, ComponentName: "QDialog", ComponentType: "dialog", Test: true},
+			},
+			TotalCount:    2,
+			ComponentType: "dialog",
+			ScannedFiles:  2,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/Dialog.spec.ts (line 12): QDialog [test]") {
+			t.Error("Output should mark test matches")
+		}
+		if !strings.Contains(output, "in tests: 1") {
+			t.Error("Output should contain the test match count")
+		}
+	})
+
+	t.Run("marks matches inside documentation", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "docs/button.md", Line: 6, ComponentName: "Button", ComponentType: "button", Docs: true},
+			},
+			TotalCount:    1,
+			ComponentType: "button",
+			ScannedFiles:  1,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "docs/button.md (line 6): Button [docs]") {
+			t.Error("Output should mark documentation matches")
+		}
+		if !strings.Contains(output, "in documentation: 1") {
+			t.Error("Output should contain the documentation match count")
+		}
+	})
+
+	t.Run("marks component definitions", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/widget.ts", Line: 3, ComponentName: "my-widget", UsageKind: types.UsageKindDefinition},
+			},
+			TotalCount:    1,
+			ComponentType: "my-widget",
+			ScannedFiles:  1,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/widget.ts (line 3): my-widget [definition]") {
+			t.Error("Output should mark component definitions")
+		}
+	})
+
+	t.Run("breaks matches down by library", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/A.tsx", Line: 1, ComponentName: "Button", ComponentType: "button", Library: "@mui/material"},
+				{FilePath: "src/B.tsx", Line: 2, ComponentName: "Button", ComponentType: "button", Library: "antd"},
+				{FilePath: "src/C.tsx", Line: 3, ComponentName: "Button", ComponentType: "button", Library: "@mui/material"},
+				{FilePath: "src/D.vue", Line: 4, ComponentName: "q-btn", ComponentType: "button"},
+			},
+			TotalCount:    4,
+			ComponentType: "button",
+			ScannedFiles:  4,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "  from @mui/material: 2\n  from antd: 1\n") {
+			t.Errorf("Output should contain the per-library breakdown, got:\n%s", output)
+		}
+	})
+
+	t.Run("shows per-component breakdown", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Form.vue", Line: 3, ComponentName: "q-btn", CanonicalName: "QBtn", ComponentType: "button"},
+				{FilePath: "src/Menu.vue", Line: 8, ComponentName: "QBtn", CanonicalName: "QBtn", ComponentType: "button"},
+				{FilePath: "src/Menu.vue", Line: 9, ComponentName: "q-btn-dropdown", CanonicalName: "QBtnDropdown", ComponentType: "button"},
+			},
+			TotalCount:      3,
+			ComponentType:   "button",
+			ScannedFiles:    2,
+			ComponentCounts: map[string]int{"QBtn": 2, "QBtnDropdown": 1},
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "By component:\n  QBtn: 2\n  QBtnDropdown: 1\n") {
+			t.Errorf("Output should contain the per-component breakdown, got:\n%s", output)
+		}
+	})
+
+	t.Run("explains matching rules", func(t *testing.T) {
+		rule := types.MatchRule{Type: "button", Library: "quasar", Pattern: "q-btn"}
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Form.vue", Line: 3, ComponentName: "q-btn", ComponentType: "button", Rule: &rule},
+				{FilePath: "src/Menu.vue", Line: 8, ComponentName: "AppCard", ComponentType: "custom", Rule: &types.MatchRule{Type: "custom"}},
+			},
+			TotalCount:    2,
+			ComponentType: "button",
+			ScannedFiles:  2,
+			RuleCounts:    []types.RuleCount{{MatchRule: rule, Count: 1}, {MatchRule: types.MatchRule{Type: "custom"}, Count: 1}},
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/Form.vue (line 3): q-btn <- button/quasar \"q-btn\"\n") ||
+			!strings.Contains(output, "src/Menu.vue (line 8): AppCard <- custom\n") {
+			t.Errorf("Output should annotate matches with their rule, got:\n%s", output)
+		}
+		if !strings.Contains(output, "By rule:\n  button/quasar \"q-btn\": 1\n  custom: 1\n") {
+			t.Errorf("Output should contain the rule hit counts, got:\n%s", output)
+		}
+	})
+
+	t.Run("shows a non-exact match mode", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Toolbar.tsx", Line: 3, ComponentName: "ButtonGroup", ComponentType: "button"},
+			},
+			TotalCount:    1,
+			ComponentType: "button",
+			ScannedFiles:  1,
+			MatchMode:     "prefix",
+		}
+
+		if output := formatter.FormatTerminal(result); !strings.Contains(output, "Match mode: prefix\n") {
+			t.Errorf("Output should show the match mode, got:\n%s", output)
+		}
+
+		result.MatchMode = "exact"
+		if output := formatter.FormatTerminal(result); strings.Contains(output, "Match mode") {
+			t.Errorf("Output should not show the exact match mode, got:\n%s", output)
+		}
+	})
+
+	t.Run("shows per-type breakdown of a type group", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Toolbar.vue", Line: 3, ComponentName: "q-btn", ComponentType: "button"},
+				{FilePath: "src/Toolbar.vue", Line: 4, ComponentName: "q-btn", ComponentType: "button"},
+				{FilePath: "src/Toolbar.vue", Line: 5, ComponentName: "q-menu", ComponentType: "menu"},
+			},
+			TotalCount:    3,
+			ComponentType: "interactive",
+			ScannedFiles:  1,
+			TypeCounts:    map[string]int{"button": 2, "menu": 1, "tabs": 0},
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "By type:\n  button: 2\n  menu: 1\n  tabs: 0\n") {
+			t.Errorf("Output should contain the per-type breakdown, got:\n%s", output)
+		}
+	})
+
+	t.Run("shows repeated occurrences", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Toolbar.tsx", Line: 4, ComponentName: "Button", ComponentType: "button", Occurrences: 3},
+				{FilePath: "src/Toolbar.tsx", Line: 5, ComponentName: "Button", ComponentType: "button", Occurrences: 1},
+			},
+			TotalCount:    4,
+			ComponentType: "button",
+			ScannedFiles:  1,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/Toolbar.tsx (line 4): Button x3\n") || !strings.Contains(output, "src/Toolbar.tsx (line 5): Button\n") {
+			t.Errorf("Output should mark repeated occurrences, got:\n%s", output)
+		}
+	})
+
+	t.Run("shows warnings", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches:       []types.ComponentMatch{},
+			ComponentType: "button",
+			Warnings:      []string{"1 match(es) imported from 'antd', which is not listed in package.json"},
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "Warnings:\n  ! 1 match(es) imported from 'antd', which is not listed in package.json\n") {
+			t.Errorf("Output should list the warnings, got:\n%s", output)
+		}
+	})
+
+	t.Run("shows captured props", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Actions.tsx", Line: 3, ComponentName: "Button", ComponentType: "button",
+					Props: map[string]string{"variant": "danger", "disabled": ""}},
+			},
+			TotalCount:    1,
+			ComponentType: "button",
+			ScannedFiles:  1,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/Actions.tsx (line 3): Button {disabled, variant=danger}") {
+			t.Errorf("Output should list the props of the match, got:\n%s", output)
+		}
+	})
+
+	t.Run("shows snippets below matches", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Form.vue", Line: 3, ComponentName: "q-btn", ComponentType: "button",
+					Snippet: "  <q-form>\n    <q-btn />"},
+			},
+			TotalCount:    1,
+			ComponentType: "button",
+			ScannedFiles:  1,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/Form.vue (line 3): q-btn\n      |   <q-form>\n      |     <q-btn />\n") {
+			t.Errorf("Output should show the snippet below the match, got:\n%s", output)
+		}
+	})
+
+	t.Run("shows resolved names of aliased imports", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Toolbar.tsx", Line: 5, ComponentName: "PrimaryBtn", ResolvedName: "Button", ComponentType: "button"},
+			},
+			TotalCount:    1,
+			ComponentType: "button",
+			ScannedFiles:  1,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/Toolbar.tsx (line 5): PrimaryBtn (Button)") {
+			t.Error("Output should show the resolved component name")
+		}
+	})
+
+	t.Run("shows replacements of deprecated components", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Settings.vue", Line: 8, ComponentName: "OldDialog", ComponentType: "deprecated", Replacement: "AppModal"},
+			},
+			TotalCount:    1,
+			ComponentType: "deprecated",
+			ScannedFiles:  1,
+		}
+
+		output := formatter.FormatTerminal(result)
+
+		if !strings.Contains(output, "src/Settings.vue (line 8): OldDialog (deprecated, use AppModal)") {
+			t.Errorf("Output should show the suggested replacement, got:\n%s", output)
+		}
+	})
+
+	t.Run("lists matches under their file in the file layout", func(t *testing.T) {
+		result := &types.ScanResult{
+			Matches: []types.ComponentMatch{
+				{FilePath: "src/Form.vue", Line: 3, ComponentName: "q-btn", ComponentType: "button"},
+				{FilePath: "src/Form.vue", Line: 9, ComponentName: "q-btn", ComponentType: "button", Occurrences: 2},
+				{FilePath: "src/Toolbar.vue", Line: 4, ComponentName: "QBtn", ComponentType: "button"},
+			},
+			TotalCount:    4,
+			ComponentType: "button",
+			ScannedFiles:  2,
+		}
+
+		fileFormatter := NewOutputFormatter()
+		fileFormatter.SetLayout(LayoutFile)
+		output := fileFormatter.FormatTerminal(result)
+
+		expected := "  src/Form.vue: 3\n    line 3: q-btn\n    line 9: q-btn x2\n\n  src/Toolbar.vue: 1\n    line 4: QBtn\n"
+		if !strings.Contains(output, expected) {
+			t.Errorf("Output should list the matches under their file, got:\n%s", output)
+		}
+		if strings.Contains(output, "(line 3)") {
+			t.Error("Output should not repeat the file path on every match")
+		}
+	})
 }
 
 func TestFormatJSON(t *testing.T) {
@@ -200,6 +515,48 @@ func TestWrite(t *testing.T) {
 		}
 	})
 
+	t.Run("writes JSON to the output for the stdout path", func(t *testing.T) {
+		var out bytes.Buffer
+		stdoutFormatter := NewOutputFormatter()
+		stdoutFormatter.SetOutput(&out)
+
+		if err := stdoutFormatter.Write(result, "json", StdoutPath); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+
+		var parsed types.ScanResult
+		if err := json.Unmarshal(out.Bytes(), &parsed); err != nil {
+			t.Fatalf("Output is not only JSON: %v\n%s", err, out.String())
+		}
+		if parsed.TotalCount != 1 {
+			t.Errorf("Expected TotalCount 1, got %d", parsed.TotalCount)
+		}
+		if _, err := os.Stat(StdoutPath); !os.IsNotExist(err) {
+			t.Errorf("Expected no file named %s", StdoutPath)
+		}
+	})
+
+	t.Run("writes only JSON to the output in stdout format", func(t *testing.T) {
+		for _, tc := range []struct{ format, outputPath string }{{"stdout", ""}, {"both", StdoutPath}} {
+			var out, errOut bytes.Buffer
+			stdoutFormatter := NewOutputFormatter()
+			stdoutFormatter.SetOutput(&out)
+			stdoutFormatter.SetErrOutput(&errOut)
+
+			if err := stdoutFormatter.Write(result, tc.format, tc.outputPath); err != nil {
+				t.Fatalf("Write(%s) failed: %v", tc.format, err)
+			}
+
+			var parsed types.ScanResult
+			if err := json.Unmarshal(out.Bytes(), &parsed); err != nil {
+				t.Fatalf("Write(%s) output is not only JSON: %v\n%s", tc.format, err, out.String())
+			}
+			if tc.format == "both" && !strings.Contains(errOut.String(), "Total components found: 1") {
+				t.Errorf("Write(%s) error output = %q, want the terminal output", tc.format, errOut.String())
+			}
+		}
+	})
+
 	t.Run("returns error for unsupported format", func(t *testing.T) {
 		err := formatter.Write(result, "invalid", "")
 		if err == nil {
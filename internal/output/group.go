@@ -0,0 +1,86 @@
+package output
+
+import (
+	"path"
+	"path/filepath"
+	"sort"
+
+	"ui-elf/internal/types"
+)
+
+// Keys matches are grouped by with --group-by
+const (
+	GroupByFile      = "file"
+	GroupByComponent = "component"
+	GroupByDirectory = "directory"
+	GroupByLibrary   = "library"
+)
+
+// GroupKeys lists the valid --group-by values
+var GroupKeys = []string{GroupByFile, GroupByComponent, GroupByDirectory, GroupByLibrary}
+
+// noLibrary is the group of components without a registry library or import
+const noLibrary = "(none)"
+
+// GroupMatches groups matches by file, component (canonical name), directory or library
+// Groups are ordered by usage count, most used first, then by key; matches keep their order
+func GroupMatches(matches []types.ComponentMatch, by string) []types.MatchGroup {
+	index := make(map[string]int)
+	var groups []types.MatchGroup
+	for _, match := range matches {
+		key := groupKey(match, by)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, types.MatchGroup{Key: key})
+		}
+		groups[i].Count += max(match.Occurrences, 1)
+		groups[i].Matches = append(groups[i].Matches, match)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Key < groups[j].Key
+	})
+	return groups
+}
+
+// matchesByFile groups matches by file, in the order of their first match, for the file layout
+func matchesByFile(matches []types.ComponentMatch) []types.MatchGroup {
+	index := make(map[string]int)
+	var files []types.MatchGroup
+	for _, match := range matches {
+		i, ok := index[match.FilePath]
+		if !ok {
+			i = len(files)
+			index[match.FilePath] = i
+			files = append(files, types.MatchGroup{Key: match.FilePath})
+		}
+		files[i].Count += max(match.Occurrences, 1)
+		files[i].Matches = append(files[i].Matches, match)
+	}
+	return files
+}
+
+// groupKey returns the group of a match
+func groupKey(match types.ComponentMatch, by string) string {
+	switch by {
+	case GroupByComponent:
+		return componentName(match)
+	case GroupByDirectory:
+		return path.Dir(filepath.ToSlash(match.FilePath))
+	case GroupByLibrary:
+		if match.RegistryLibrary != "" {
+			return match.RegistryLibrary
+		}
+		if match.Library != "" {
+			return match.Library
+		}
+		return noLibrary
+	default:
+		return match.FilePath
+	}
+}
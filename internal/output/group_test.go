@@ -0,0 +1,71 @@
+package output
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestGroupMatches(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{FilePath: "src/views/Home.vue", Line: 3, ComponentName: "q-btn", CanonicalName: "QBtn", RegistryLibrary: "quasar"},
+		{FilePath: "src/views/Home.vue", Line: 8, ComponentName: "QBtn", CanonicalName: "QBtn", RegistryLibrary: "quasar", Occurrences: 2},
+		{FilePath: "src/components/Save.tsx", Line: 5, ComponentName: "Button", CanonicalName: "Button", Library: "@acme/ui"},
+		{FilePath: "src/views/About.vue", Line: 2, ComponentName: "button", CanonicalName: "button"},
+	}
+
+	tests := []struct {
+		by             string
+		expectedKeys   []string
+		expectedCounts []int
+	}{
+		{by: GroupByFile, expectedKeys: []string{"src/views/Home.vue", "src/components/Save.tsx", "src/views/About.vue"}, expectedCounts: []int{3, 1, 1}},
+		{by: GroupByComponent, expectedKeys: []string{"QBtn", "Button", "button"}, expectedCounts: []int{3, 1, 1}},
+		{by: GroupByDirectory, expectedKeys: []string{"src/views", "src/components"}, expectedCounts: []int{4, 1}},
+		{by: GroupByLibrary, expectedKeys: []string{"quasar", "(none)", "@acme/ui"}, expectedCounts: []int{3, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.by, func(t *testing.T) {
+			groups := GroupMatches(matches, tt.by)
+			var keys []string
+			var counts []int
+			for _, group := range groups {
+				keys = append(keys, group.Key)
+				counts = append(counts, group.Count)
+			}
+			if !reflect.DeepEqual(keys, tt.expectedKeys) || !reflect.DeepEqual(counts, tt.expectedCounts) {
+				t.Errorf("GroupMatches(%s) = %v %v, want %v %v", tt.by, keys, counts, tt.expectedKeys, tt.expectedCounts)
+			}
+		})
+	}
+}
+
+func TestFormatJSON_Grouped(t *testing.T) {
+	matches := []types.ComponentMatch{{FilePath: "src/App.vue", Line: 1, ComponentName: "q-btn"}}
+	result := &types.ScanResult{
+		Matches:    matches,
+		TotalCount: 1,
+		GroupBy:    GroupByFile,
+		Groups:     GroupMatches(matches, GroupByFile),
+	}
+
+	jsonStr, err := NewOutputFormatter().FormatJSON(result)
+	if err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &parsed); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if _, ok := parsed["matches"]; ok {
+		t.Error("Expected no flat match list in grouped JSON")
+	}
+	if !strings.Contains(jsonStr, `"key": "src/App.vue"`) {
+		t.Errorf("Expected the file group in JSON, got:\n%s", jsonStr)
+	}
+}
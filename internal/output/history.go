@@ -0,0 +1,70 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// trendBarWidth is the width of the bar of the largest total in a trend table
+const trendBarWidth = 30
+
+// shortCommitLength is the length of the commit SHAs shown in a trend table
+const shortCommitLength = 7
+
+// FormatTrend formats history entries as a table of the component counts over time, oldest first,
+// with the change from the previous entry of the same component type and a bar of the total
+// Only the last entries are listed, all of them if last is 0
+func (f *OutputFormatter) FormatTrend(entries []types.HistoryEntry, source string, last int) string {
+	var sb strings.Builder
+
+	// Header
+	fmt.Fprintf(&sb, "\n%s\n", f.loc.Sprintf("Component Usage Trend - %s", source))
+	sb.WriteString(strings.Repeat("=", 50))
+	sb.WriteString("\n\n")
+	if len(entries) == 0 {
+		fmt.Fprintf(&sb, "%s\n", f.loc.Sprintf("No history entries."))
+		return sb.String()
+	}
+
+	// Changes are computed from the entries before the first listed
+	first := 0
+	if last > 0 && len(entries) > last {
+		first = len(entries) - last
+	}
+	typeWidth := len(f.loc.Sprintf("Type"))
+	maxTotal := 0
+	for _, entry := range entries[first:] {
+		typeWidth = max(typeWidth, len(entry.ComponentType))
+		maxTotal = max(maxTotal, entry.TotalCount)
+	}
+
+	fmt.Fprintf(&sb, "  %-16s  %-*s  %-*s  %7s  %7s\n", f.loc.Sprintf("Date"), shortCommitLength, f.loc.Sprintf("Commit"),
+		typeWidth, f.loc.Sprintf("Type"), f.loc.Sprintf("Total"), f.loc.Sprintf("Change"))
+	previous := make(map[string]int)
+	for i, entry := range entries {
+		change := ""
+		if count, ok := previous[entry.ComponentType]; ok {
+			change = fmt.Sprintf("%+d", entry.TotalCount-count)
+		}
+		previous[entry.ComponentType] = entry.TotalCount
+		if i < first {
+			continue
+		}
+
+		commit := entry.Commit
+		if len(commit) > shortCommitLength {
+			commit = commit[:shortCommitLength]
+		}
+
+		bar := ""
+		if maxTotal > 0 {
+			bar = strings.Repeat("█", (entry.TotalCount*trendBarWidth+maxTotal-1)/maxTotal)
+		}
+		line := fmt.Sprintf("  %-16s  %-*s  %-*s  %7d  %7s  %s", entry.Time.Local().Format("2006-01-02 15:04"),
+			shortCommitLength, commit, typeWidth, entry.ComponentType, entry.TotalCount, change, bar)
+		fmt.Fprintf(&sb, "%s\n", strings.TrimRight(line, " "))
+	}
+	return sb.String()
+}
@@ -0,0 +1,49 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"ui-elf/internal/types"
+)
+
+func TestFormatTrend(t *testing.T) {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.Local)
+	entries := []types.HistoryEntry{
+		{Time: at, Commit: "1a2b3c4d5e6f", ComponentType: "deprecated", TotalCount: 40},
+		{Time: at.Add(24 * time.Hour), Commit: "5d6e7f8a9b0c", ComponentType: "button", TotalCount: 12},
+		{Time: at.Add(48 * time.Hour), Commit: "9f8e7d6c5b4a", ComponentType: "deprecated", TotalCount: 30},
+	}
+	formatter := NewOutputFormatter()
+
+	t.Run("lists the entries with their change and bar", func(t *testing.T) {
+		output := formatter.FormatTrend(entries, "history.jsonl", 0)
+		for _, expected := range []string{
+			"Component Usage Trend - history.jsonl",
+			"  2026-10-01 12:00  1a2b3c4  deprecated       40           " + strings.Repeat("█", 30) + "\n",
+			"  2026-10-02 12:00  5d6e7f8  button           12           " + strings.Repeat("█", 9) + "\n",
+			"  2026-10-03 12:00  9f8e7d6  deprecated       30      -10  " + strings.Repeat("█", 23) + "\n",
+		} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("Output should contain %q, got:\n%s", expected, output)
+			}
+		}
+	})
+
+	t.Run("computes the change from entries not listed", func(t *testing.T) {
+		output := formatter.FormatTrend(entries, "history.jsonl", 1)
+		if strings.Contains(output, "1a2b3c4") {
+			t.Errorf("Output should only list the last entry, got:\n%s", output)
+		}
+		if !strings.Contains(output, "      -10  ") {
+			t.Errorf("Output should show the change from the first entry, got:\n%s", output)
+		}
+	})
+
+	t.Run("reports an empty history", func(t *testing.T) {
+		if output := formatter.FormatTrend(nil, "history.jsonl", 0); !strings.Contains(output, "No history entries.") {
+			t.Errorf("Output should report no entries, got:\n%s", output)
+		}
+	})
+}
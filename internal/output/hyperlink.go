@@ -0,0 +1,66 @@
+package output
+
+import (
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// When terminal output links the matches to their files with --hyperlinks
+const (
+	HyperlinksAuto   = "auto"   // Only with a link template, on a terminal
+	HyperlinksAlways = "always" // Even when the output is piped, e.g. into less -R
+	HyperlinksNever  = "never"
+)
+
+// HyperlinkModes lists the valid --hyperlinks values
+var HyperlinkModes = []string{HyperlinksAuto, HyperlinksAlways, HyperlinksNever}
+
+// DefaultLinkTemplate links the matches to their files when no link template is given
+const DefaultLinkTemplate = "file://{path}"
+
+// LinkTemplate builds the URL of a match from a template such as vscode://file{path}:{line}
+// or https://github.com/org/repo/blob/main/{relpath}#L{line}
+// {path} is the absolute file path, {relpath} the path relative to Root and {line} the line
+type LinkTemplate struct {
+	Template string
+	Base     string // Directory the relative file paths of matches are relative to
+	Root     string // Directory {relpath} is relative to, usually the repository root
+}
+
+// URL returns the URL of a match, with its paths escaped for URLs
+func (t LinkTemplate) URL(match types.ComponentMatch) string {
+	absPath := filepath.FromSlash(match.FilePath)
+	if !filepath.IsAbs(absPath) {
+		absPath = filepath.Join(t.Base, absPath)
+	}
+	relPath, err := filepath.Rel(t.Root, absPath)
+	if err != nil {
+		relPath = absPath
+	}
+
+	replacer := strings.NewReplacer(
+		"{path}", escapePath(absPath),
+		"{relpath}", escapePath(relPath),
+		"{line}", strconv.Itoa(match.Line),
+	)
+	return replacer.Replace(t.Template)
+}
+
+// escapePath escapes each segment of a file path for a URL, with forward slashes
+func escapePath(path string) string {
+	segments := strings.Split(filepath.ToSlash(path), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// hyperlink wraps text in an OSC 8 escape sequence linking it to target; terminals without
+// hyperlink support print the text alone
+func hyperlink(target string, text string) string {
+	return "\x1b]8;;" + target + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
@@ -0,0 +1,65 @@
+package output
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestLinkTemplate_URL(t *testing.T) {
+	root := filepath.FromSlash("/work/repo")
+	base := filepath.Join(root, "app")
+
+	tests := []struct {
+		name     string
+		template string
+		path     string
+		expected string
+	}{
+		{
+			name:     "absolute path for editors",
+			template: "vscode://file{path}:{line}",
+			path:     "src/App.vue",
+			expected: "vscode://file" + filepath.ToSlash(base) + "/src/App.vue:12",
+		},
+		{
+			name:     "path relative to the repository root",
+			template: "https://github.com/acme/web/blob/main/{relpath}#L{line}",
+			path:     "src/App.vue",
+			expected: "https://github.com/acme/web/blob/main/app/src/App.vue#L12",
+		},
+		{
+			name:     "escapes the path",
+			template: "https://example.com/{relpath}",
+			path:     "src/My Page#1.vue",
+			expected: "https://example.com/app/src/My%20Page%231.vue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			links := LinkTemplate{Template: tt.template, Base: base, Root: root}
+			if url := links.URL(types.ComponentMatch{FilePath: tt.path, Line: 12}); url != tt.expected {
+				t.Errorf("URL() = %q, want %q", url, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatTerminal_Hyperlinks(t *testing.T) {
+	result := &types.ScanResult{
+		Matches:       []types.ComponentMatch{{FilePath: "src/App.vue", Line: 3, ComponentName: "q-btn"}},
+		TotalCount:    1,
+		ComponentType: "button",
+	}
+	formatter := NewOutputFormatter()
+	formatter.SetLinkTemplate(&LinkTemplate{Template: "vscode://file{path}:{line}", Base: "/repo", Root: "/repo"})
+
+	output := formatter.FormatTerminal(result)
+	expected := "\x1b]8;;vscode://file/repo/src/App.vue:3\x1b\\src/App.vue (line 3): q-btn\x1b]8;;\x1b\\"
+	if !strings.Contains(output, expected) {
+		t.Errorf("Output should link the match to its file, got:\n%q", output)
+	}
+}
@@ -0,0 +1,114 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"ui-elf/internal/types"
+)
+
+// schemaDialect is the JSON Schema draft of the result schema
+const schemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema returns the JSON Schema of the scan results written with --output json
+// The schema is derived from the result types and their json tags: fields without omitempty are
+// required, and the struct types are defined once under $defs
+func JSONSchema() (string, error) {
+	builder := &schemaBuilder{defs: make(map[string]any)}
+	schema := builder.object(reflect.TypeOf(types.ScanResult{}))
+
+	// Grouped and summarized results list no matches
+	schema["required"] = slices.DeleteFunc(schema["required"].([]string), func(name string) bool { return name == "matches" })
+	schema["$schema"] = schemaDialect
+	schema["title"] = "ui-elf scan result"
+	schema["description"] = fmt.Sprintf("Scan result written by ui-elf --output json, schema version %s", types.SchemaVersion)
+	schema["$defs"] = builder.defs
+
+	jsonBytes, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JSON schema: %w", err)
+	}
+	return string(jsonBytes), nil
+}
+
+// schemaBuilder builds the schemas of Go types, collecting the struct definitions
+type schemaBuilder struct {
+	defs map[string]any
+}
+
+// schema returns the schema of a type; structs refer to their definition
+func (b *schemaBuilder) schema(t reflect.Type) map[string]any {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return b.schema(t.Elem())
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": b.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": b.schema(t.Elem())}
+	case reflect.Struct:
+		if t == reflect.TypeOf(time.Time{}) {
+			return map[string]any{"type": "string", "format": "date-time"}
+		}
+		if _, ok := b.defs[t.Name()]; !ok {
+			// Reserved first, for types referring to themselves
+			b.defs[t.Name()] = nil
+			b.defs[t.Name()] = b.object(t)
+		}
+		return map[string]any{"$ref": "#/$defs/" + t.Name()}
+	default:
+		return map[string]any{}
+	}
+}
+
+// object returns the object schema of a struct type, with the fields of embedded structs inlined
+// as encoding/json does
+func (b *schemaBuilder) object(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	required := []string{}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		omitEmpty := slices.Contains(strings.Split(options, ","), "omitempty")
+
+		if field.Anonymous && name == "" {
+			embedded := b.object(field.Type)
+			for property, schema := range embedded["properties"].(map[string]any) {
+				properties[property] = schema
+			}
+			required = append(required, embedded["required"].([]string)...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema := b.schema(field.Type)
+		if !omitEmpty {
+			required = append(required, name)
+			// Nil slices, maps and pointers are written as null
+			switch field.Type.Kind() {
+			case reflect.Slice, reflect.Map, reflect.Pointer:
+				schema = map[string]any{"anyOf": []any{schema, map[string]any{"type": "null"}}}
+			}
+		}
+		properties[name] = schema
+	}
+	return map[string]any{"type": "object", "properties": properties, "required": required}
+}
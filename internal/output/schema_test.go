@@ -0,0 +1,68 @@
+package output
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestJSONSchema(t *testing.T) {
+	schemaJSON, err := JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema failed: %v", err)
+	}
+
+	var schema struct {
+		Schema     string                     `json:"$schema"`
+		Properties map[string]json.RawMessage `json:"properties"`
+		Required   []string                   `json:"required"`
+		Defs       map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+			Required   []string                   `json:"required"`
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal([]byte(schemaJSON), &schema); err != nil {
+		t.Fatalf("Schema is not valid JSON: %v", err)
+	}
+
+	if schema.Schema != schemaDialect {
+		t.Errorf("$schema = %q, want %q", schema.Schema, schemaDialect)
+	}
+
+	// Every field written in the JSON results is described
+	result := &types.ScanResult{
+		SchemaVersion: types.SchemaVersion,
+		Matches: []types.ComponentMatch{{
+			FilePath: "src/App.vue", Line: 3, ComponentName: "q-btn", ComponentType: "button",
+			Props: map[string]string{"color": "primary"}, Rule: &types.MatchRule{Type: "button", Library: "quasar", Pattern: "q-btn"},
+		}},
+		ComponentCounts: map[string]int{"QBtn": 1},
+		RuleCounts:      []types.RuleCount{{MatchRule: types.MatchRule{Type: "button"}, Count: 1}},
+		Top:             &types.TopUsage{Components: []types.RankedCount{{Rank: 1, Name: "QBtn", Count: 1}}},
+	}
+	resultJSON, err := NewOutputFormatter().FormatJSON(result)
+	if err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(resultJSON), &fields); err != nil {
+		t.Fatalf("Failed to parse result JSON: %v", err)
+	}
+	for field := range fields {
+		if _, ok := schema.Properties[field]; !ok {
+			t.Errorf("Schema should describe the result field %q", field)
+		}
+	}
+
+	if !slices.Contains(schema.Required, "schemaVersion") || slices.Contains(schema.Required, "matches") {
+		t.Errorf("Required = %v, want schemaVersion but not matches, which compact results omit", schema.Required)
+	}
+	if _, ok := schema.Defs["ComponentMatch"].Properties["filePath"]; !ok {
+		t.Error("Schema should define the properties of ComponentMatch")
+	}
+	if ruleCount := schema.Defs["RuleCount"]; !slices.Contains(ruleCount.Required, "type") || !slices.Contains(ruleCount.Required, "count") {
+		t.Errorf("RuleCount should inline the fields of the embedded MatchRule, got required %v", ruleCount.Required)
+	}
+}
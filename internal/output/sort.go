@@ -0,0 +1,67 @@
+package output
+
+import (
+	"cmp"
+	"slices"
+
+	"ui-elf/internal/types"
+)
+
+// Orders of the matches with --sort
+const (
+	SortByPath      = "path"
+	SortByLine      = "line"
+	SortByComponent = "component"
+	SortByCount     = "count"
+)
+
+// SortKeys lists the valid --sort values
+var SortKeys = []string{SortByPath, SortByLine, SortByComponent, SortByCount}
+
+// SortMatches orders matches by path (then line), line (then path), component name (then path
+// and line) or count, the usages of their component in matches (then path and line)
+// Orders are ascending unless desc is set; ties keep their order
+func SortMatches(matches []types.ComponentMatch, by string, desc bool) {
+	var counts map[string]int
+	if by == SortByCount {
+		counts = make(map[string]int)
+		for _, match := range matches {
+			counts[componentName(match)] += max(match.Occurrences, 1)
+		}
+	}
+
+	byLocation := func(a, b types.ComponentMatch) int {
+		return cmp.Or(cmp.Compare(a.FilePath, b.FilePath), cmp.Compare(a.Line, b.Line))
+	}
+	compare := func(a, b types.ComponentMatch) int {
+		switch by {
+		case SortByLine:
+			return cmp.Or(cmp.Compare(a.Line, b.Line), cmp.Compare(a.FilePath, b.FilePath))
+		case SortByComponent:
+			return cmp.Or(cmp.Compare(componentName(a), componentName(b)), byLocation(a, b))
+		case SortByCount:
+			return cmp.Or(cmp.Compare(counts[componentName(a)], counts[componentName(b)]), byLocation(a, b))
+		default:
+			return byLocation(a, b)
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b types.ComponentMatch) int {
+		if desc {
+			return compare(b, a)
+		}
+		return compare(a, b)
+	})
+}
+
+// componentName returns the name the component of a match is counted and grouped under: the
+// component an alias or wrapper resolves to, or else the canonical name shared by its casings
+func componentName(match types.ComponentMatch) string {
+	if match.ResolvedName != "" {
+		return match.ResolvedName
+	}
+	if match.CanonicalName != "" {
+		return match.CanonicalName
+	}
+	return match.ComponentName
+}
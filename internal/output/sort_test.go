@@ -0,0 +1,49 @@
+package output
+
+import (
+	"reflect"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestSortMatches(t *testing.T) {
+	matches := func() []types.ComponentMatch {
+		return []types.ComponentMatch{
+			{FilePath: "src/b.vue", Line: 2, ComponentName: "QBtn", CanonicalName: "QBtn"},
+			{FilePath: "src/a.vue", Line: 9, ComponentName: "q-btn", CanonicalName: "QBtn"},
+			{FilePath: "src/a.vue", Line: 4, ComponentName: "QDialog", CanonicalName: "QDialog"},
+			{FilePath: "src/c.vue", Line: 1, ComponentName: "QBtn", CanonicalName: "QBtn"},
+		}
+	}
+	location := func(sorted []types.ComponentMatch) []string {
+		var locations []string
+		for _, match := range sorted {
+			locations = append(locations, match.FilePath+":"+string(rune('0'+match.Line)))
+		}
+		return locations
+	}
+
+	tests := []struct {
+		by       string
+		desc     bool
+		expected []string
+	}{
+		{by: SortByPath, expected: []string{"src/a.vue:4", "src/a.vue:9", "src/b.vue:2", "src/c.vue:1"}},
+		{by: SortByPath, desc: true, expected: []string{"src/c.vue:1", "src/b.vue:2", "src/a.vue:9", "src/a.vue:4"}},
+		{by: SortByLine, expected: []string{"src/c.vue:1", "src/b.vue:2", "src/a.vue:4", "src/a.vue:9"}},
+		{by: SortByComponent, expected: []string{"src/a.vue:9", "src/b.vue:2", "src/c.vue:1", "src/a.vue:4"}},
+		{by: SortByCount, expected: []string{"src/a.vue:4", "src/a.vue:9", "src/b.vue:2", "src/c.vue:1"}},
+		{by: SortByCount, desc: true, expected: []string{"src/c.vue:1", "src/b.vue:2", "src/a.vue:9", "src/a.vue:4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.by, func(t *testing.T) {
+			sorted := matches()
+			SortMatches(sorted, tt.by, tt.desc)
+			if result := location(sorted); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SortMatches(%s, desc=%v) = %v, want %v", tt.by, tt.desc, result, tt.expected)
+			}
+		})
+	}
+}
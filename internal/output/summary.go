@@ -0,0 +1,83 @@
+package output
+
+import (
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// topDirectoryCount is the number of directories listed in a summary
+const topDirectoryCount = 5
+
+// Summarize computes the aggregate statistics of matches: the distinct components, the files
+// with matches and the directories with the most usages
+func Summarize(matches []types.ComponentMatch) *types.ScanSummary {
+	components := make(map[string]bool)
+	files := make(map[string]bool)
+	for _, match := range matches {
+		components[componentName(match)] = true
+		files[match.FilePath] = true
+	}
+
+	directories := CountByDirectory(matches, 0)
+	if len(directories) > topDirectoryCount {
+		directories = directories[:topDirectoryCount]
+	}
+	return &types.ScanSummary{
+		UniqueComponents: len(components),
+		FilesAffected:    len(files),
+		TopDirectories:   directories,
+	}
+}
+
+// CountByDirectory counts the usages and files with matches per directory of the matched files
+// A depth above 0 rolls the directories up to their first depth path segments, so src/pages/admin
+// counts as src/pages with depth 2. Directories are ordered by usage count, most used first, then by path
+func CountByDirectory(matches []types.ComponentMatch, depth int) []types.DirectoryCount {
+	index := make(map[string]int)
+	files := make(map[string]bool)
+	var directories []types.DirectoryCount
+	for _, match := range matches {
+		directory := rollUp(path.Dir(filepath.ToSlash(match.FilePath)), depth)
+		i, ok := index[directory]
+		if !ok {
+			i = len(directories)
+			index[directory] = i
+			directories = append(directories, types.DirectoryCount{Directory: directory})
+		}
+		directories[i].Count += max(match.Occurrences, 1)
+		if !files[match.FilePath] {
+			files[match.FilePath] = true
+			directories[i].Files++
+		}
+	}
+
+	sort.SliceStable(directories, func(i, j int) bool {
+		if directories[i].Count != directories[j].Count {
+			return directories[i].Count > directories[j].Count
+		}
+		return directories[i].Directory < directories[j].Directory
+	})
+	return directories
+}
+
+// rollUp returns the first depth path segments of a slash-separated directory, or the directory
+// itself if it has no more segments or depth is 0
+// The root of an absolute path and leading ".." segments are kept without counting as segments
+func rollUp(directory string, depth int) string {
+	if depth <= 0 {
+		return directory
+	}
+	segments := strings.Split(directory, "/")
+	keep := 0
+	for keep < len(segments) && (segments[keep] == "" || segments[keep] == "..") {
+		keep++
+	}
+	if len(segments) > keep+depth {
+		segments = segments[:keep+depth]
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,162 @@
+package output
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestSummarize(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{FilePath: "src/pages/Home.vue", Line: 3, ComponentName: "q-btn", CanonicalName: "QBtn"},
+		{FilePath: "src/pages/Home.vue", Line: 8, ComponentName: "QBtn", CanonicalName: "QBtn", Occurrences: 2},
+		{FilePath: "src/pages/About.vue", Line: 2, ComponentName: "q-input", CanonicalName: "QInput"},
+		{FilePath: "src/components/Save.vue", Line: 5, ComponentName: "q-btn", CanonicalName: "QBtn"},
+		{FilePath: "App.vue", Line: 1, ComponentName: "q-btn", CanonicalName: "QBtn"},
+		{FilePath: "App.vue", Line: 4, ComponentName: "SaveBtn", CanonicalName: "SaveBtn", ResolvedName: "QBtn"},
+	}
+
+	summary := Summarize(matches)
+	if summary.UniqueComponents != 2 {
+		t.Errorf("UniqueComponents = %d, want 2", summary.UniqueComponents)
+	}
+	if summary.FilesAffected != 4 {
+		t.Errorf("FilesAffected = %d, want 4", summary.FilesAffected)
+	}
+	expected := []types.DirectoryCount{
+		{Directory: "src/pages", Count: 4, Files: 2},
+		{Directory: ".", Count: 2, Files: 1},
+		{Directory: "src/components", Count: 1, Files: 1},
+	}
+	if !reflect.DeepEqual(summary.TopDirectories, expected) {
+		t.Errorf("TopDirectories = %+v, want %+v", summary.TopDirectories, expected)
+	}
+}
+
+func TestSummarize_TopDirectoriesOnly(t *testing.T) {
+	var matches []types.ComponentMatch
+	for _, directory := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		matches = append(matches, types.ComponentMatch{FilePath: directory + "/X.vue", Line: 1, ComponentName: "q-btn"})
+	}
+
+	if directories := Summarize(matches).TopDirectories; len(directories) != topDirectoryCount {
+		t.Errorf("Expected the top %d directories, got %d", topDirectoryCount, len(directories))
+	}
+}
+
+func TestCountByDirectory(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{FilePath: "src/pages/admin/Users.vue", Line: 3, ComponentName: "q-btn", Occurrences: 3},
+		{FilePath: "src/pages/Home.vue", Line: 8, ComponentName: "q-btn"},
+		{FilePath: "src/components/Save.vue", Line: 5, ComponentName: "q-btn"},
+		{FilePath: "/repo/src/App.vue", Line: 1, ComponentName: "q-btn"},
+		{FilePath: "../shared/ui/Card.vue", Line: 1, ComponentName: "q-btn"},
+	}
+
+	tests := []struct {
+		name     string
+		depth    int
+		expected []types.DirectoryCount
+	}{
+		{
+			name:  "full directory",
+			depth: 0,
+			expected: []types.DirectoryCount{
+				{Directory: "src/pages/admin", Count: 3, Files: 1},
+				{Directory: "../shared/ui", Count: 1, Files: 1},
+				{Directory: "/repo/src", Count: 1, Files: 1},
+				{Directory: "src/components", Count: 1, Files: 1},
+				{Directory: "src/pages", Count: 1, Files: 1},
+			},
+		},
+		{
+			name:  "rolled up to two segments",
+			depth: 2,
+			expected: []types.DirectoryCount{
+				{Directory: "src/pages", Count: 4, Files: 2},
+				{Directory: "../shared/ui", Count: 1, Files: 1},
+				{Directory: "/repo/src", Count: 1, Files: 1},
+				{Directory: "src/components", Count: 1, Files: 1},
+			},
+		},
+		{
+			name:  "rolled up to one segment",
+			depth: 1,
+			expected: []types.DirectoryCount{
+				{Directory: "src", Count: 5, Files: 3},
+				{Directory: "../shared", Count: 1, Files: 1},
+				{Directory: "/repo", Count: 1, Files: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if directories := CountByDirectory(matches, tt.depth); !reflect.DeepEqual(directories, tt.expected) {
+				t.Errorf("CountByDirectory(%d) = %+v, want %+v", tt.depth, directories, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatTerminal_DirectoryCounts(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{FilePath: "src/pages/Home.vue", Line: 3, ComponentName: "q-btn"},
+		{FilePath: "src/pages/About.vue", Line: 2, ComponentName: "q-btn"},
+	}
+	result := &types.ScanResult{
+		Matches:         matches,
+		TotalCount:      2,
+		ComponentType:   "button",
+		DirectoryCounts: CountByDirectory(matches, 0),
+	}
+
+	output := NewOutputFormatter().FormatTerminal(result)
+	if !strings.Contains(output, "By directory:\n  src/pages: 2 in 2 file(s)\n") {
+		t.Errorf("Output should list the usages per directory, got:\n%s", output)
+	}
+}
+
+func TestFormat_Summary(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{FilePath: "src/pages/Home.vue", Line: 3, ComponentName: "q-btn", CanonicalName: "QBtn"},
+		{FilePath: "src/pages/About.vue", Line: 2, ComponentName: "q-input", CanonicalName: "QInput"},
+	}
+	result := &types.ScanResult{
+		Matches:         matches,
+		TotalCount:      2,
+		ComponentType:   "all",
+		ScannedFiles:    5,
+		ComponentCounts: map[string]int{"QBtn": 1, "QInput": 1},
+		Summary:         Summarize(matches),
+	}
+	formatter := NewOutputFormatter()
+
+	output := formatter.FormatTerminal(result)
+	for _, expected := range []string{"Unique components: 2", "Files affected: 2", "Top directories:\n  src/pages: 2 in 2 file(s)", "By component:\n  QBtn: 1"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Terminal summary should contain %q, got:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "(line 3)") || strings.Contains(output, "Found components in:") {
+		t.Errorf("Terminal summary should not list the matches, got:\n%s", output)
+	}
+
+	jsonStr, err := formatter.FormatJSON(result)
+	if err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &parsed); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if _, ok := parsed["matches"]; ok {
+		t.Error("Expected no match list in summary JSON")
+	}
+	if !strings.Contains(jsonStr, `"filesAffected": 2`) {
+		t.Errorf("Expected the summary in JSON, got:\n%s", jsonStr)
+	}
+}
@@ -0,0 +1,54 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// Rank ranks the n components and the n files with the most usages, most used first, then by name
+func Rank(matches []types.ComponentMatch, n int) *types.TopUsage {
+	components := make(map[string]int)
+	files := make(map[string]int)
+	for _, match := range matches {
+		components[componentName(match)] += max(match.Occurrences, 1)
+		files[match.FilePath] += max(match.Occurrences, 1)
+	}
+	return &types.TopUsage{
+		Components: ranked(components, n),
+		Files:      ranked(files, n),
+	}
+}
+
+// ranked returns the first n of the counts, most used first
+func ranked(counts map[string]int, n int) []types.RankedCount {
+	sorted := sortCounts(counts)
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	ranking := make([]types.RankedCount, len(sorted))
+	for i, count := range sorted {
+		ranking[i] = types.RankedCount{Rank: i + 1, Name: count.name, Count: count.count}
+	}
+	return ranking
+}
+
+// writeRanking writes a title followed by a table of ranked counts, with aligned columns
+func writeRanking(sb *strings.Builder, title string, ranking []types.RankedCount) {
+	if len(ranking) == 0 {
+		return
+	}
+	nameWidth, countWidth := 0, 0
+	for _, count := range ranking {
+		nameWidth = max(nameWidth, len(count.Name))
+		countWidth = max(countWidth, len(strconv.Itoa(count.Count)))
+	}
+	rankWidth := len(strconv.Itoa(len(ranking)))
+
+	fmt.Fprintf(sb, "%s\n", title)
+	for _, count := range ranking {
+		fmt.Fprintf(sb, "  %*d. %-*s  %*d\n", rankWidth, count.Rank, nameWidth, count.Name, countWidth, count.Count)
+	}
+}
@@ -0,0 +1,53 @@
+package output
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestRank(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{FilePath: "src/pages/Home.vue", Line: 3, ComponentName: "q-btn", CanonicalName: "QBtn"},
+		{FilePath: "src/pages/Home.vue", Line: 8, ComponentName: "QBtn", CanonicalName: "QBtn", Occurrences: 2},
+		{FilePath: "src/pages/About.vue", Line: 2, ComponentName: "q-input", CanonicalName: "QInput"},
+		{FilePath: "src/pages/About.vue", Line: 4, ComponentName: "q-input", CanonicalName: "QInput"},
+		{FilePath: "src/components/Save.vue", Line: 5, ComponentName: "AppCard"},
+	}
+
+	top := Rank(matches, 2)
+	expectedComponents := []types.RankedCount{
+		{Rank: 1, Name: "QBtn", Count: 3},
+		{Rank: 2, Name: "QInput", Count: 2},
+	}
+	if !reflect.DeepEqual(top.Components, expectedComponents) {
+		t.Errorf("Components = %+v, want %+v", top.Components, expectedComponents)
+	}
+	expectedFiles := []types.RankedCount{
+		{Rank: 1, Name: "src/pages/Home.vue", Count: 3},
+		{Rank: 2, Name: "src/pages/About.vue", Count: 2},
+	}
+	if !reflect.DeepEqual(top.Files, expectedFiles) {
+		t.Errorf("Files = %+v, want %+v", top.Files, expectedFiles)
+	}
+}
+
+func TestFormatTerminal_Top(t *testing.T) {
+	result := &types.ScanResult{
+		Matches:       []types.ComponentMatch{{FilePath: "src/App.vue", Line: 3, ComponentName: "q-btn"}},
+		TotalCount:    12,
+		ComponentType: "all",
+		Top: &types.TopUsage{
+			Components: []types.RankedCount{{Rank: 1, Name: "QBtn", Count: 10}, {Rank: 2, Name: "QInput", Count: 2}},
+			Files:      []types.RankedCount{{Rank: 1, Name: "src/App.vue", Count: 12}},
+		},
+	}
+
+	output := NewOutputFormatter().FormatTerminal(result)
+	expected := "Top components:\n  1. QBtn    10\n  2. QInput   2\nTop files:\n  1. src/App.vue  12\n"
+	if !strings.Contains(output, expected) {
+		t.Errorf("Output should contain the ranked tables %q, got:\n%s", expected, output)
+	}
+}
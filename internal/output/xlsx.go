@@ -0,0 +1,299 @@
+package output
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"ui-elf/internal/types"
+)
+
+// DefaultXLSXPath is the file Excel results are written to when no output file is given
+const DefaultXLSXPath = "ui-elf-results.xlsx"
+
+// Widths of the spreadsheet columns, in characters
+const (
+	xlsxMinColumnWidth = 8
+	xlsxMaxColumnWidth = 60
+)
+
+// xlsxModified is the modification time of the parts of a workbook, fixed for reproducible files
+var xlsxModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Namespaces of the SpreadsheetML parts
+const (
+	xlsxMainNamespace          = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	xlsxRelationshipsNamespace = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	xlsxPackageRelsNamespace   = "http://schemas.openxmlformats.org/package/2006/relationships"
+	xlsxContentTypesNamespace  = "http://schemas.openxmlformats.org/package/2006/content-types"
+)
+
+// xlsxPart is a file of the zip package of a workbook
+type xlsxPart struct {
+	name    string
+	content string
+}
+
+// xlsxSheet is a worksheet of a workbook; its cells are strings or ints
+type xlsxSheet struct {
+	name   string
+	rows   [][]any
+	bold   map[int]bool // Indexes of the rows written in bold
+	filter bool         // Freeze the header row and add a filter to it
+}
+
+// FormatXLSX formats the scan result as an Excel workbook with a summary sheet, holding the
+// totals and the usages per component and directory, and a sheet listing the matches
+func (f *OutputFormatter) FormatXLSX(result *types.ScanResult) ([]byte, error) {
+	sheets := []xlsxSheet{summarySheet(result), matchesSheet(result)}
+	parts := []xlsxPart{
+		{"[Content_Types].xml", xlsxContentTypes(len(sheets))},
+		{"_rels/.rels", `<Relationships xmlns="` + xlsxPackageRelsNamespace + `">` +
+			`<Relationship Id="rId1" Type="` + xlsxRelationshipsNamespace + `/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xlsxWorkbook(sheets)},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels(len(sheets))},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for i, sheet := range sheets {
+		parts = append(parts, xlsxPart{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheet.xml()})
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, part := range parts {
+		w, err := archive.CreateHeader(&zip.FileHeader{Name: part.name, Method: zip.Deflate, Modified: xlsxModified})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create workbook part %s: %w", part.name, err)
+		}
+		if _, err := w.Write([]byte(xml.Header + part.content)); err != nil {
+			return nil, fmt.Errorf("failed to write workbook part %s: %w", part.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write workbook: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// summarySheet returns the sheet of the totals of a result, followed by the usages and files
+// per component and per directory, most used first
+func summarySheet(result *types.ScanResult) xlsxSheet {
+	summary := Summarize(result.Matches)
+	sheet := xlsxSheet{
+		name: "Summary",
+		rows: [][]any{
+			{"Component type", result.ComponentType},
+			{"Total components", result.TotalCount},
+			{"Unique components", summary.UniqueComponents},
+			{"Files affected", summary.FilesAffected},
+			{"Files scanned", result.ScannedFiles},
+			{"Scan time (ms)", int(result.ScanTimeMs)},
+		},
+		bold: make(map[int]bool),
+	}
+
+	usages := make(map[string]int)
+	files := make(map[string]map[string]bool)
+	for _, match := range result.Matches {
+		name := componentName(match)
+		usages[name] += max(match.Occurrences, 1)
+		if files[name] == nil {
+			files[name] = make(map[string]bool)
+		}
+		files[name][match.FilePath] = true
+	}
+	sheet.rows = append(sheet.rows, nil)
+	sheet.bold[len(sheet.rows)] = true
+	sheet.rows = append(sheet.rows, []any{"Component", "Usages", "Files"})
+	for _, component := range sortCounts(usages) {
+		sheet.rows = append(sheet.rows, []any{component.name, component.count, len(files[component.name])})
+	}
+
+	sheet.rows = append(sheet.rows, nil)
+	sheet.bold[len(sheet.rows)] = true
+	sheet.rows = append(sheet.rows, []any{"Directory", "Usages", "Files"})
+	for _, directory := range CountByDirectory(result.Matches, 0) {
+		sheet.rows = append(sheet.rows, []any{directory.Directory, directory.Count, directory.Files})
+	}
+	return sheet
+}
+
+// matchesSheet returns the sheet listing the matches of a result, one per row
+func matchesSheet(result *types.ScanResult) xlsxSheet {
+	sheet := xlsxSheet{
+		name:   "Matches",
+		rows:   [][]any{{"File", "Line", "Component", "Canonical Name", "Type", "Library", "Usage Kind", "Usages", "Replacement"}},
+		bold:   map[int]bool{0: true},
+		filter: true,
+	}
+	for _, match := range result.Matches {
+		sheet.rows = append(sheet.rows, []any{
+			match.FilePath, match.Line, match.ComponentName, componentName(match), match.ComponentType,
+			match.Library, match.UsageKind, max(match.Occurrences, 1), match.Replacement,
+		})
+	}
+	return sheet
+}
+
+// xml returns the worksheet part of the sheet
+func (s xlsxSheet) xml() string {
+	columns := 0
+	for _, row := range s.rows {
+		columns = max(columns, len(row))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<worksheet xmlns="` + xlsxMainNamespace + `">`)
+	if s.filter {
+		sb.WriteString(`<sheetViews><sheetView workbookViewId="0">` +
+			`<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/>` +
+			`</sheetView></sheetViews>`)
+	}
+	if columns > 0 {
+		sb.WriteString("<cols>")
+		for column := range columns {
+			fmt.Fprintf(&sb, `<col min="%d" max="%d" width="%d" customWidth="1"/>`, column+1, column+1, s.columnWidth(column))
+		}
+		sb.WriteString("</cols>")
+	}
+
+	sb.WriteString("<sheetData>")
+	for i, row := range s.rows {
+		if len(row) == 0 {
+			continue
+		}
+		style := ""
+		if s.bold[i] {
+			style = ` s="1"`
+		}
+		fmt.Fprintf(&sb, `<row r="%d">`, i+1)
+		for column, value := range row {
+			ref := cellRef(column, i)
+			switch value := value.(type) {
+			case int:
+				fmt.Fprintf(&sb, `<c r="%s"%s><v>%d</v></c>`, ref, style, value)
+			case string:
+				if value == "" {
+					continue
+				}
+				fmt.Fprintf(&sb, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, style, escapeXML(value))
+			}
+		}
+		sb.WriteString("</row>")
+	}
+	sb.WriteString("</sheetData>")
+
+	if s.filter && columns > 0 {
+		fmt.Fprintf(&sb, `<autoFilter ref="A1:%s"/>`, cellRef(columns-1, max(len(s.rows)-1, 0)))
+	}
+	sb.WriteString("</worksheet>")
+	return sb.String()
+}
+
+// columnWidth returns the width of a column fitting its longest cell, within the width limits
+func (s xlsxSheet) columnWidth(column int) int {
+	width := xlsxMinColumnWidth
+	for _, row := range s.rows {
+		if column < len(row) {
+			width = max(width, len(fmt.Sprint(row[column]))+2)
+		}
+	}
+	return min(width, xlsxMaxColumnWidth)
+}
+
+// cellRef returns the A1 reference of a cell from its zero-based column and row
+func cellRef(column int, row int) string {
+	name := ""
+	for column++; column > 0; column = (column - 1) / 26 {
+		name = string(rune('A'+(column-1)%26)) + name
+	}
+	return name + strconv.Itoa(row+1)
+}
+
+// escapeXML escapes text for XML content, replacing the characters XML cannot hold
+func escapeXML(text string) string {
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(text))
+	return sb.String()
+}
+
+// xlsxContentTypes returns the content types part of a workbook with the number of sheets
+func xlsxContentTypes(sheets int) string {
+	var sb strings.Builder
+	sb.WriteString(`<Types xmlns="` + xlsxContentTypesNamespace + `">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range sheets {
+		fmt.Fprintf(&sb, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	sb.WriteString("</Types>")
+	return sb.String()
+}
+
+// xlsxWorkbook returns the workbook part listing the sheets
+func xlsxWorkbook(sheets []xlsxSheet) string {
+	var sb strings.Builder
+	sb.WriteString(`<workbook xmlns="` + xlsxMainNamespace + `" xmlns:r="` + xlsxRelationshipsNamespace + `"><sheets>`)
+	for i, sheet := range sheets {
+		fmt.Fprintf(&sb, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escapeXML(sheet.name), i+1, i+1)
+	}
+	sb.WriteString("</sheets></workbook>")
+	return sb.String()
+}
+
+// xlsxWorkbookRels returns the relationships of the workbook to its sheets and styles
+func xlsxWorkbookRels(sheets int) string {
+	var sb strings.Builder
+	sb.WriteString(`<Relationships xmlns="` + xlsxPackageRelsNamespace + `">`)
+	for i := range sheets {
+		fmt.Fprintf(&sb, `<Relationship Id="rId%d" Type="%s/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, xlsxRelationshipsNamespace, i+1)
+	}
+	fmt.Fprintf(&sb, `<Relationship Id="rId%d" Type="%s/styles" Target="styles.xml"/>`, sheets+1, xlsxRelationshipsNamespace)
+	sb.WriteString("</Relationships>")
+	return sb.String()
+}
+
+// xlsxStyles is the styles part of a workbook: style 0 is the default, style 1 bold
+const xlsxStyles = `<styleSheet xmlns="` + xlsxMainNamespace + `">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+// writeXLSX writes the scan result as an Excel workbook to outputPath, or DefaultXLSXPath if it
+// is empty
+// Returns the path of the file written, or "" if the workbook was written to the output (StdoutPath)
+func (f *OutputFormatter) writeXLSX(result *types.ScanResult, outputPath string) (string, error) {
+	workbook, err := f.FormatXLSX(result)
+	if err != nil {
+		return "", err
+	}
+
+	if outputPath == StdoutPath {
+		if _, err := f.out.Write(workbook); err != nil {
+			return "", fmt.Errorf("failed to write workbook: %w", err)
+		}
+		return "", nil
+	}
+	if outputPath == "" {
+		outputPath = DefaultXLSXPath
+	}
+
+	if err := os.WriteFile(outputPath, workbook, 0644); err != nil {
+		return "", fmt.Errorf("failed to write XLSX file: %w", err)
+	}
+	f.logger.Debug("wrote XLSX results", "path", outputPath, "bytes", len(workbook))
+	return outputPath, nil
+}
@@ -0,0 +1,143 @@
+package output
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+// readWorkbook returns the parts of a workbook by name, failing if one is not well-formed XML
+func readWorkbook(t *testing.T, workbook []byte) map[string]string {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(workbook), int64(len(workbook)))
+	if err != nil {
+		t.Fatalf("Workbook is not a zip package: %v", err)
+	}
+	parts := make(map[string]string)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("Failed to open part %s: %v", file.Name, err)
+		}
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("Failed to read part %s: %v", file.Name, err)
+		}
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("Part %s is not well-formed XML: %v", file.Name, err)
+			}
+		}
+		parts[file.Name] = string(content)
+	}
+	return parts
+}
+
+func TestFormatXLSX(t *testing.T) {
+	result := &types.ScanResult{
+		Matches: []types.ComponentMatch{
+			{FilePath: "src/App.vue", Line: 3, ComponentName: "q-btn", CanonicalName: "QBtn", ComponentType: "button", Library: "quasar"},
+			{FilePath: "src/App.vue", Line: 8, ComponentName: "QBtn", CanonicalName: "QBtn", ComponentType: "button", Occurrences: 2},
+			{FilePath: "src/pages/<Admin> & Co.vue", Line: 1, ComponentName: "q-btn", CanonicalName: "QBtn", ComponentType: "button"},
+		},
+		TotalCount:    4,
+		ComponentType: "button",
+		ScannedFiles:  12,
+	}
+
+	workbook, err := NewOutputFormatter().FormatXLSX(result)
+	if err != nil {
+		t.Fatalf("FormatXLSX failed: %v", err)
+	}
+	parts := readWorkbook(t, workbook)
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("Workbook should contain the part %s", name)
+		}
+	}
+	if workbookXML := parts["xl/workbook.xml"]; !strings.Contains(workbookXML, `<sheet name="Summary" sheetId="1" r:id="rId1"/>`) ||
+		!strings.Contains(workbookXML, `<sheet name="Matches" sheetId="2" r:id="rId2"/>`) {
+		t.Errorf("Workbook should list the summary and matches sheets, got:\n%s", workbookXML)
+	}
+
+	t.Run("summarizes the usages per component and directory", func(t *testing.T) {
+		summary := parts["xl/worksheets/sheet1.xml"]
+		for _, expected := range []string{
+			`<row r="2"><c r="A2" t="inlineStr"><is><t xml:space="preserve">Total components</t></is></c><c r="B2"><v>4</v></c></row>`,
+			`<c r="A9" t="inlineStr"><is><t xml:space="preserve">QBtn</t></is></c><c r="B9"><v>4</v></c><c r="C9"><v>2</v></c>`,
+			`<c r="A11" s="1" t="inlineStr"><is><t xml:space="preserve">Directory</t></is></c>`,
+		} {
+			if !strings.Contains(summary, expected) {
+				t.Errorf("Summary sheet should contain %q, got:\n%s", expected, summary)
+			}
+		}
+	})
+
+	t.Run("lists the matches under a filtered header", func(t *testing.T) {
+		matches := parts["xl/worksheets/sheet2.xml"]
+		for _, expected := range []string{
+			`<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">File</t></is></c>`,
+			`<c r="A3" t="inlineStr"><is><t xml:space="preserve">src/App.vue</t></is></c><c r="B3"><v>8</v></c>`,
+			`<c r="H3"><v>2</v></c>`,
+			`src/pages/&lt;Admin&gt; &amp; Co.vue`,
+			`<autoFilter ref="A1:I4"/>`,
+		} {
+			if !strings.Contains(matches, expected) {
+				t.Errorf("Matches sheet should contain %q, got:\n%s", expected, matches)
+			}
+		}
+	})
+}
+
+func TestCellRef(t *testing.T) {
+	tests := []struct {
+		column   int
+		row      int
+		expected string
+	}{
+		{0, 0, "A1"},
+		{25, 9, "Z10"},
+		{26, 0, "AA1"},
+		{701, 0, "ZZ1"},
+		{702, 0, "AAA1"},
+	}
+
+	for _, tt := range tests {
+		if ref := cellRef(tt.column, tt.row); ref != tt.expected {
+			t.Errorf("cellRef(%d, %d) = %s, want %s", tt.column, tt.row, ref, tt.expected)
+		}
+	}
+}
+
+func TestWrite_XLSX(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.xlsx")
+	var out bytes.Buffer
+	formatter := NewOutputFormatter()
+	formatter.SetOutput(&out)
+
+	if err := formatter.Write(&types.ScanResult{ComponentType: "button"}, "xlsx", path); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	workbook, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	if parts := readWorkbook(t, workbook); parts["xl/worksheets/sheet2.xml"] == "" {
+		t.Error("Workbook of a result without matches should have a matches sheet")
+	}
+	if !strings.Contains(out.String(), "Results written to "+path) {
+		t.Errorf("Output should name the file written, got:\n%s", out.String())
+	}
+}
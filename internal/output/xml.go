@@ -0,0 +1,284 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"ui-elf/internal/types"
+)
+
+// DefaultXMLPath is the file XML results are written to when no output file is given
+const DefaultXMLPath = "ui-elf-results.xml"
+
+// xmlResult is the XML document of a scan result; the scalar fields are attributes of the root
+// and the lists and counts are child elements, named after their JSON keys
+type xmlResult struct {
+	XMLName         xml.Name                    `xml:"scanResult"`
+	ComponentType   string                      `xml:"componentType,attr"`
+	TotalCount      int                         `xml:"totalCount,attr"`
+	ScannedFiles    int                         `xml:"scannedFiles,attr"`
+	ScanTimeMs      int64                       `xml:"scanTimeMs,attr"`
+	MatchMode       string                      `xml:"matchMode,attr,omitempty"`
+	GroupBy         string                      `xml:"groupBy,attr,omitempty"`
+	Matches         *xmlList[xmlMatch]          `xml:"matches"`
+	Groups          *xmlList[xmlGroup]          `xml:"groups"`
+	Summary         *xmlSummary                 `xml:"summary"`
+	ComponentCounts *xmlList[xmlCount]          `xml:"componentCounts"`
+	TypeCounts      *xmlList[xmlCount]          `xml:"typeCounts"`
+	RuleCounts      *xmlList[xmlRuleCount]      `xml:"ruleCounts"`
+	RootCounts      *xmlList[xmlRootCount]      `xml:"rootCounts"`
+	DirectoryCounts *xmlList[xmlDirectoryCount] `xml:"directoryCounts"`
+	Warnings        *xmlList[xmlWarning]        `xml:"warnings"`
+}
+
+// xmlList is a list element, left out if it is nil; its items are named by their XMLName tag
+// (encoding/xml writes empty "parent>child" lists despite omitempty)
+type xmlList[T any] struct {
+	Items []T
+}
+
+// newXMLList returns the list element of items, or nil if there are none
+func newXMLList[T any](items []T) *xmlList[T] {
+	if len(items) == 0 {
+		return nil
+	}
+	return &xmlList[T]{Items: items}
+}
+
+// xmlMatch is a component match; props, snippet and rule are child elements
+type xmlMatch struct {
+	XMLName         xml.Name          `xml:"match"`
+	FilePath        string            `xml:"filePath,attr"`
+	Line            int               `xml:"line,attr"`
+	ComponentName   string            `xml:"componentName,attr"`
+	CanonicalName   string            `xml:"canonicalName,attr,omitempty"`
+	ComponentType   string            `xml:"componentType,attr"`
+	Story           bool              `xml:"story,attr,omitempty"`
+	Test            bool              `xml:"test,attr,omitempty"`
+	Docs            bool              `xml:"docs,attr,omitempty"`
+	UsageKind       string            `xml:"usageKind,attr,omitempty"`
+	Namespace       string            `xml:"namespace,attr,omitempty"`
+	Expression      string            `xml:"expression,attr,omitempty"`
+	ImportPath      string            `xml:"importPath,attr,omitempty"`
+	ResolvedName    string            `xml:"resolvedName,attr,omitempty"`
+	Library         string            `xml:"library,attr,omitempty"`
+	RegistryLibrary string            `xml:"registryLibrary,attr,omitempty"`
+	Occurrences     int               `xml:"occurrences,attr,omitempty"`
+	Fingerprint     string            `xml:"fingerprint,attr,omitempty"`
+	Registered      bool              `xml:"registered,attr,omitempty"`
+	Replacement     string            `xml:"replacement,attr,omitempty"`
+	Props           *xmlList[xmlProp] `xml:"props"`
+	Snippet         string            `xml:"snippet,omitempty"`
+	Rule            *xmlRule          `xml:"rule"`
+}
+
+// xmlProp is an attribute of a matched component tag
+type xmlProp struct {
+	XMLName xml.Name `xml:"prop"`
+	Name    string   `xml:"name,attr"`
+	Value   string   `xml:"value,attr"`
+}
+
+// xmlRule is the registry rule that matched a component
+type xmlRule struct {
+	Type    string `xml:"type,attr"`
+	Library string `xml:"library,attr,omitempty"`
+	Pattern string `xml:"pattern,attr,omitempty"`
+}
+
+// xmlGroup is a group of matches
+type xmlGroup struct {
+	XMLName xml.Name `xml:"group"`
+	Key     string   `xml:"key,attr"`
+	Count   int      `xml:"count,attr"`
+	Matches []xmlMatch
+}
+
+// xmlSummary holds the aggregate statistics of a summary
+type xmlSummary struct {
+	UniqueComponents int                         `xml:"uniqueComponents,attr"`
+	FilesAffected    int                         `xml:"filesAffected,attr"`
+	TopDirectories   *xmlList[xmlDirectoryCount] `xml:"topDirectories"`
+}
+
+// xmlCount is the number of usages of a name (component or type)
+type xmlCount struct {
+	XMLName xml.Name `xml:"count"`
+	Name    string   `xml:"name,attr"`
+	Count   int      `xml:"count,attr"`
+}
+
+// xmlRuleCount is the number of usages matched by a registry rule
+type xmlRuleCount struct {
+	XMLName xml.Name `xml:"count"`
+	Type    string   `xml:"type,attr"`
+	Library string   `xml:"library,attr,omitempty"`
+	Pattern string   `xml:"pattern,attr,omitempty"`
+	Count   int      `xml:"count,attr"`
+}
+
+// xmlRootCount is the number of usages and files scanned in a scan root
+type xmlRootCount struct {
+	XMLName      xml.Name `xml:"count"`
+	Root         string   `xml:"root,attr"`
+	ScannedFiles int      `xml:"scannedFiles,attr"`
+	Count        int      `xml:"count,attr"`
+}
+
+// xmlDirectoryCount is the number of usages and files with matches in a directory
+type xmlDirectoryCount struct {
+	XMLName   xml.Name `xml:"count"`
+	Directory string   `xml:"directory,attr"`
+	Count     int      `xml:"count,attr"`
+	Files     int      `xml:"files,attr"`
+}
+
+// xmlWarning is a problem found while scanning
+type xmlWarning struct {
+	XMLName xml.Name `xml:"warning"`
+	Text    string   `xml:",chardata"`
+}
+
+// FormatXML formats the scan result as an XML document
+// Like JSON, grouped matches are only listed in their groups, and a summary replaces the matches
+func (f *OutputFormatter) FormatXML(result *types.ScanResult) (string, error) {
+	doc := xmlResult{
+		ComponentType:   result.ComponentType,
+		TotalCount:      result.TotalCount,
+		ScannedFiles:    result.ScannedFiles,
+		ScanTimeMs:      result.ScanTimeMs,
+		MatchMode:       result.MatchMode,
+		GroupBy:         result.GroupBy,
+		ComponentCounts: newXMLList(toXMLCounts(result.ComponentCounts)),
+		TypeCounts:      newXMLList(toXMLCounts(result.TypeCounts)),
+		DirectoryCounts: newXMLList(toXMLDirectoryCounts(result.DirectoryCounts)),
+	}
+	if result.GroupBy == "" && result.Summary == nil {
+		doc.Matches = newXMLList(toXMLMatches(result.Matches))
+	}
+	var groups []xmlGroup
+	for _, group := range result.Groups {
+		groups = append(groups, xmlGroup{Key: group.Key, Count: group.Count, Matches: toXMLMatches(group.Matches)})
+	}
+	doc.Groups = newXMLList(groups)
+	if result.Summary != nil {
+		doc.Summary = &xmlSummary{
+			UniqueComponents: result.Summary.UniqueComponents,
+			FilesAffected:    result.Summary.FilesAffected,
+			TopDirectories:   newXMLList(toXMLDirectoryCounts(result.Summary.TopDirectories)),
+		}
+	}
+	var ruleCounts []xmlRuleCount
+	for _, rule := range result.RuleCounts {
+		ruleCounts = append(ruleCounts, xmlRuleCount{Type: rule.Type, Library: rule.Library, Pattern: rule.Pattern, Count: rule.Count})
+	}
+	doc.RuleCounts = newXMLList(ruleCounts)
+	var rootCounts []xmlRootCount
+	for _, root := range result.RootCounts {
+		rootCounts = append(rootCounts, xmlRootCount{Root: root.Root, ScannedFiles: root.ScannedFiles, Count: root.Count})
+	}
+	doc.RootCounts = newXMLList(rootCounts)
+	var warnings []xmlWarning
+	for _, warning := range result.Warnings {
+		warnings = append(warnings, xmlWarning{Text: warning})
+	}
+	doc.Warnings = newXMLList(warnings)
+
+	xmlBytes, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal XML: %w", err)
+	}
+	return xml.Header + string(xmlBytes), nil
+}
+
+// toXMLMatches converts matches to their XML elements
+func toXMLMatches(matches []types.ComponentMatch) []xmlMatch {
+	elements := make([]xmlMatch, len(matches))
+	for i, match := range matches {
+		elements[i] = xmlMatch{
+			FilePath:        match.FilePath,
+			Line:            match.Line,
+			ComponentName:   match.ComponentName,
+			CanonicalName:   match.CanonicalName,
+			ComponentType:   match.ComponentType,
+			Story:           match.Story,
+			Test:            match.Test,
+			Docs:            match.Docs,
+			UsageKind:       match.UsageKind,
+			Namespace:       match.Namespace,
+			Expression:      match.Expression,
+			ImportPath:      match.ImportPath,
+			ResolvedName:    match.ResolvedName,
+			Library:         match.Library,
+			RegistryLibrary: match.RegistryLibrary,
+			Occurrences:     match.Occurrences,
+			Fingerprint:     match.Fingerprint,
+			Registered:      match.Registered,
+			Replacement:     match.Replacement,
+			Snippet:         match.Snippet,
+		}
+		var props []xmlProp
+		for _, name := range sortedKeys(match.Props) {
+			props = append(props, xmlProp{Name: name, Value: match.Props[name]})
+		}
+		elements[i].Props = newXMLList(props)
+		if match.Rule != nil {
+			rule := xmlRule(*match.Rule)
+			elements[i].Rule = &rule
+		}
+	}
+	return elements
+}
+
+// toXMLCounts converts counts per name to their XML elements, most used first
+func toXMLCounts(counts map[string]int) []xmlCount {
+	var elements []xmlCount
+	for _, count := range sortCounts(counts) {
+		elements = append(elements, xmlCount{Name: count.name, Count: count.count})
+	}
+	return elements
+}
+
+// toXMLDirectoryCounts converts directory counts to their XML elements
+func toXMLDirectoryCounts(directories []types.DirectoryCount) []xmlDirectoryCount {
+	var elements []xmlDirectoryCount
+	for _, directory := range directories {
+		elements = append(elements, xmlDirectoryCount{Directory: directory.Directory, Count: directory.Count, Files: directory.Files})
+	}
+	return elements
+}
+
+// sortedKeys returns the keys of a map in ascending order
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeXML writes the scan result as XML to outputPath, or DefaultXMLPath if it is empty
+// Returns the path of the file written, or "" if the XML was written to the output (StdoutPath)
+func (f *OutputFormatter) writeXML(result *types.ScanResult, outputPath string) (string, error) {
+	xmlStr, err := f.FormatXML(result)
+	if err != nil {
+		return "", err
+	}
+
+	if outputPath == StdoutPath {
+		fmt.Fprintln(f.out, xmlStr)
+		return "", nil
+	}
+	if outputPath == "" {
+		outputPath = DefaultXMLPath
+	}
+
+	outputPath, err = f.writeFile(outputPath, []byte(xmlStr+"\n"))
+	if err != nil {
+		return "", fmt.Errorf("failed to write XML file: %w", err)
+	}
+	f.logger.Debug("wrote XML results", "path", outputPath, "bytes", len(xmlStr))
+	return outputPath, nil
+}
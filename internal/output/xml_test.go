@@ -0,0 +1,111 @@
+package output
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestFormatXML(t *testing.T) {
+	result := &types.ScanResult{
+		Matches: []types.ComponentMatch{
+			{
+				FilePath:      "src/Form.vue",
+				Line:          3,
+				ComponentName: "q-btn",
+				CanonicalName: "QBtn",
+				ComponentType: "button",
+				Props:         map[string]string{"label": "Save & close", "flat": ""},
+				Rule:          &types.MatchRule{Type: "button", Library: "quasar", Pattern: "q-btn"},
+			},
+		},
+		TotalCount:      1,
+		ComponentType:   "button",
+		ScannedFiles:    4,
+		ComponentCounts: map[string]int{"QBtn": 1},
+		Warnings:        []string{"package quasar is not installed"},
+	}
+
+	xmlStr, err := NewOutputFormatter().FormatXML(result)
+	if err != nil {
+		t.Fatalf("FormatXML failed: %v", err)
+	}
+	if err := xml.Unmarshal([]byte(xmlStr), new(any)); err != nil {
+		t.Fatalf("Invalid XML: %v\n%s", err, xmlStr)
+	}
+
+	for _, expected := range []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<scanResult componentType="button" totalCount="1" scannedFiles="4" scanTimeMs="0">`,
+		`<match filePath="src/Form.vue" line="3" componentName="q-btn" canonicalName="QBtn" componentType="button">`,
+		`<prop name="flat" value=""></prop>`,
+		`<prop name="label" value="Save &amp; close"></prop>`,
+		`<rule type="button" library="quasar" pattern="q-btn"></rule>`,
+		`<count name="QBtn" count="1"></count>`,
+		`<warning>package quasar is not installed</warning>`,
+	} {
+		if !strings.Contains(xmlStr, expected) {
+			t.Errorf("XML should contain %s, got:\n%s", expected, xmlStr)
+		}
+	}
+}
+
+func TestFormatXML_Grouped(t *testing.T) {
+	matches := []types.ComponentMatch{{FilePath: "src/App.vue", Line: 1, ComponentName: "q-btn"}}
+	result := &types.ScanResult{
+		Matches:    matches,
+		TotalCount: 1,
+		GroupBy:    GroupByFile,
+		Groups:     GroupMatches(matches, GroupByFile),
+	}
+
+	xmlStr, err := NewOutputFormatter().FormatXML(result)
+	if err != nil {
+		t.Fatalf("FormatXML failed: %v", err)
+	}
+	if strings.Contains(xmlStr, "<matches>") {
+		t.Error("Expected no flat match list in grouped XML")
+	}
+	if !strings.Contains(xmlStr, `<group key="src/App.vue" count="1">`) {
+		t.Errorf("Expected the file group in XML, got:\n%s", xmlStr)
+	}
+}
+
+func TestWrite_XML(t *testing.T) {
+	result := &types.ScanResult{TotalCount: 0, ComponentType: "button"}
+
+	t.Run("writes XML to file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "results.xml")
+		formatter := NewOutputFormatter()
+		formatter.SetOutput(&bytes.Buffer{})
+
+		if err := formatter.Write(result, "xml", path); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Failed to read output file: %v", err)
+		}
+		if !strings.Contains(string(content), `<scanResult componentType="button"`) {
+			t.Errorf("Output file should contain the XML result, got:\n%s", content)
+		}
+	})
+
+	t.Run("writes XML to the output", func(t *testing.T) {
+		var out bytes.Buffer
+		formatter := NewOutputFormatter()
+		formatter.SetOutput(&out)
+
+		if err := formatter.Write(result, "xml", StdoutPath); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+		if !strings.HasPrefix(out.String(), "<?xml") {
+			t.Errorf("Output should start with the XML header, got:\n%s", out.String())
+		}
+	})
+}
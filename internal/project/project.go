@@ -0,0 +1,320 @@
+// Package project inspects the scanned project's package manifest.
+package project
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// ManifestFileName is the name of the npm package manifest
+const ManifestFileName = "package.json"
+
+// libraryPackages maps npm packages to the registry library they provide
+// Entries ending in "/" match every package of the scope
+var libraryPackages = map[string]string{
+	"quasar":                     "quasar",
+	"vuetify":                    "vuetify",
+	"@mui/material":              "mui",
+	"@material-ui/core":          "mui",
+	"@material-ui/pickers":       "mui",
+	"@mui/x-data-grid":           "mui",
+	"@mui/x-date-pickers":        "mui",
+	"antd":                       "antd",
+	"@radix-ui/":                 "radix",
+	"@ionic/vue":                 "ionic",
+	"@ionic/react":               "ionic",
+	"@ionic/angular":             "ionic",
+	"@ionic/core":                "ionic",
+	"element-plus":               "element-plus",
+	"naive-ui":                   "naive-ui",
+	"primevue":                   "primevue",
+	"@chakra-ui/react":           "chakra",
+	"@mantine/core":              "mantine",
+	"@mantine/modals":            "mantine",
+	"@mantine/dates":             "mantine",
+	"react-bootstrap":            "react-bootstrap",
+	"bootstrap-vue":              "bootstrap-vue",
+	"bootstrap-vue-next":         "bootstrap-vue",
+	"@ng-bootstrap/ng-bootstrap": "ng-bootstrap",
+	"react-native":               "react-native",
+	"@builder.io/qwik":           "qwik",
+	"@qwik.dev/core":             "qwik",
+}
+
+// frameworkPackages maps npm packages to the UI framework they provide
+// Meta-frameworks (nuxt, next, sveltekit) are reported in addition to their framework
+var frameworkPackages = map[string]string{
+	"vue":              "vue",
+	"nuxt":             "nuxt",
+	"react":            "react",
+	"next":             "next",
+	"preact":           "preact",
+	"solid-js":         "solid",
+	"svelte":           "svelte",
+	"@sveltejs/kit":    "sveltekit",
+	"@angular/core":    "angular",
+	"lit":              "lit",
+	"ember-source":     "ember",
+	"@builder.io/qwik": "qwik",
+	"@qwik.dev/core":   "qwik",
+}
+
+// versionPackages lists the packages whose major version is the version of a library, in order of preference
+// Other packages of a library (e.g. @mui/x-date-pickers) are versioned independently
+var versionPackages = map[string][]string{
+	"quasar":       {"quasar"},
+	"vuetify":      {"vuetify"},
+	"mui":          {"@mui/material", "@material-ui/core"},
+	"antd":         {"antd"},
+	"ionic":        {"@ionic/core", "@ionic/vue", "@ionic/react", "@ionic/angular"},
+	"element-plus": {"element-plus"},
+	"naive-ui":     {"naive-ui"},
+	"primevue":     {"primevue"},
+	"chakra":       {"@chakra-ui/react"},
+	"mantine":      {"@mantine/core"},
+	"react-native": {"react-native"},
+}
+
+// majorVersionRegex matches the major version of a version range (e.g. 3 in "^3.4.0", ">=3 <4", "3.x")
+var majorVersionRegex = regexp.MustCompile(`\d+`)
+
+// Manifest holds the dependencies declared in a package.json file
+type Manifest struct {
+	Path         string
+	Dependencies map[string]string // Package name -> version range, of all dependency kinds
+}
+
+// LoadManifest reads the package.json of dir or of its closest parent directory
+// Returns nil if there is no package.json
+func LoadManifest(dir string) (*Manifest, error) {
+	path := findManifest(dir)
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var pkg struct {
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		PeerDependencies     map[string]string `json:"peerDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	manifest := &Manifest{Path: path, Dependencies: make(map[string]string)}
+	for _, deps := range []map[string]string{pkg.OptionalDependencies, pkg.PeerDependencies, pkg.DevDependencies, pkg.Dependencies} {
+		for name, version := range deps {
+			manifest.Dependencies[name] = version
+		}
+	}
+	return manifest, nil
+}
+
+// findManifest returns the path of the package.json in dir or its closest parent, or ""
+func findManifest(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		if path := FindFile(dir, []string{ManifestFileName}); path != "" {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// FindFile returns the path of the first of the file names existing in dir as a regular file, or ""
+func FindFile(dir string, names []string) string {
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
+
+// FindRepoRoot returns the absolute path of the git repository containing dir: the closest
+// directory with a .git directory or file (worktrees, submodules), or "" if there is none
+func FindRepoRoot(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// HeadCommit returns the SHA of the commit checked out in the git repository containing dir,
+// or "" if dir is not in a repository or git is not installed
+func HeadCommit(dir string) string {
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// Has checks if the manifest declares a dependency on the package
+func (m *Manifest) Has(pkg string) bool {
+	_, ok := m.Dependencies[pkg]
+	return ok
+}
+
+// Libraries returns the registry libraries installed in the project, sorted
+func (m *Manifest) Libraries() []string {
+	found := make(map[string]bool)
+	for name := range m.Dependencies {
+		if library, ok := packageLibrary(name); ok {
+			found[library] = true
+		}
+	}
+	return sortedKeys(found)
+}
+
+// Frameworks returns the UI frameworks installed in the project, sorted
+func (m *Manifest) Frameworks() []string {
+	found := make(map[string]bool)
+	for name := range m.Dependencies {
+		if framework, ok := frameworkPackages[name]; ok {
+			found[framework] = true
+		}
+	}
+	return sortedKeys(found)
+}
+
+// LibraryVersions returns the installed major version of each library whose version can be determined
+func (m *Manifest) LibraryVersions() map[string]int {
+	versions := make(map[string]int)
+	for library, packages := range versionPackages {
+		for _, pkg := range packages {
+			if version := majorVersion(m.Dependencies[pkg]); version > 0 {
+				versions[library] = version
+				break
+			}
+		}
+	}
+	return versions
+}
+
+// majorVersion returns the major version of an npm version range, or 0 if there is none
+// (e.g. "workspace:*", "latest"); aliases ("npm:vuetify@3.4.0") are versioned after the last "@"
+func majorVersion(versionRange string) int {
+	if idx := strings.LastIndex(versionRange, "@"); idx >= 0 {
+		versionRange = versionRange[idx+1:]
+	}
+	match := majorVersionRegex.FindString(versionRange)
+	if match == "" {
+		return 0
+	}
+	version, err := strconv.Atoi(match)
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+// MissingLibraries returns the detectable registry libraries that are not installed, sorted
+// Libraries that cannot be detected from package.json (native HTML, shadcn/ui components copied
+// into the project, custom libraries of a registry file) are never reported missing
+func (m *Manifest) MissingLibraries() []string {
+	installed := make(map[string]bool)
+	for _, library := range m.Libraries() {
+		installed[library] = true
+	}
+
+	missing := make(map[string]bool)
+	for _, library := range libraryPackages {
+		if !installed[library] {
+			missing[library] = true
+		}
+	}
+	return sortedKeys(missing)
+}
+
+// MissingDependencyWarnings warns about matches imported from packages the manifest does not declare
+// Relative imports, path aliases and Node built-ins are ignored
+func (m *Manifest) MissingDependencyWarnings(matches []types.ComponentMatch) []string {
+	counts := make(map[string]int)
+	for _, match := range matches {
+		if pkg := PackageName(match.Library); pkg != "" && !m.Has(pkg) {
+			counts[pkg]++
+		}
+	}
+
+	var warnings []string
+	for _, pkg := range sortedKeys(counts) {
+		warnings = append(warnings, fmt.Sprintf("%d match(es) imported from '%s', which is not listed in %s", counts[pkg], pkg, m.Path))
+	}
+	return warnings
+}
+
+// PackageName returns the npm package of a module specifier, or "" for local modules
+// e.g. "@mui/material" for "@mui/material/Button" and "quasar" for "quasar/src/components"
+func PackageName(specifier string) string {
+	if specifier == "" || strings.HasPrefix(specifier, ".") || strings.HasPrefix(specifier, "/") ||
+		strings.HasPrefix(specifier, "@/") || strings.HasPrefix(specifier, "~") ||
+		strings.HasPrefix(specifier, "#") || strings.HasPrefix(specifier, "node:") {
+		return ""
+	}
+
+	parts := strings.SplitN(specifier, "/", 3)
+	if strings.HasPrefix(specifier, "@") {
+		if len(parts) < 2 {
+			return ""
+		}
+		return parts[0] + "/" + parts[1]
+	}
+	return parts[0]
+}
+
+// packageLibrary returns the registry library provided by an npm package
+func packageLibrary(name string) (string, bool) {
+	if library, ok := libraryPackages[name]; ok {
+		return library, true
+	}
+	for pkg, library := range libraryPackages {
+		if strings.HasSuffix(pkg, "/") && strings.HasPrefix(name, pkg) {
+			return library, true
+		}
+	}
+	return "", false
+}
+
+// sortedKeys returns the keys of a map, sorted
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
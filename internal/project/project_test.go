@@ -0,0 +1,219 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+// writeManifest writes a package.json with the given content to dir
+func writeManifest(t *testing.T, dir string, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, ManifestFileName)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create package.json: %v", err)
+	}
+	return path
+}
+
+func TestLoadManifest(t *testing.T) {
+	tempDir := t.TempDir()
+
+	manifest, err := LoadManifest(tempDir)
+	if err != nil || manifest != nil {
+		t.Fatalf("LoadManifest() = %v, %v, want nil, nil without package.json", manifest, err)
+	}
+
+	path := writeManifest(t, tempDir, `{
+  "name": "app",
+  "dependencies": { "vue": "^3.4.0", "quasar": "^2.14.0", "@radix-ui/react-dialog": "^1.0.0" },
+  "devDependencies": { "vite": "^5.0.0" },
+  "peerDependencies": { "@mui/material": "^5.0.0" }
+}`)
+
+	// The manifest of a parent directory is used when scanning a subdirectory
+	srcDir := filepath.Join(tempDir, "src")
+	if err := os.Mkdir(srcDir, 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	manifest, err = LoadManifest(srcDir)
+	if err != nil {
+		t.Fatalf("LoadManifest() error = %v", err)
+	}
+	if manifest.Path != path {
+		t.Errorf("Path = %q, want %q", manifest.Path, path)
+	}
+	for _, pkg := range []string{"vue", "quasar", "vite", "@mui/material"} {
+		if !manifest.Has(pkg) {
+			t.Errorf("Has(%q) = false, want true", pkg)
+		}
+	}
+	if libraries := manifest.Libraries(); !reflect.DeepEqual(libraries, []string{"mui", "quasar", "radix"}) {
+		t.Errorf("Libraries() = %v, want [mui quasar radix]", libraries)
+	}
+
+	missing := manifest.MissingLibraries()
+	for _, library := range []string{"antd", "vuetify", "ionic", "chakra", "mantine"} {
+		if !slices.Contains(missing, library) {
+			t.Errorf("MissingLibraries() = %v, should contain %q", missing, library)
+		}
+	}
+	for _, library := range []string{"quasar", "mui", "radix", "native", "shadcn"} {
+		if slices.Contains(missing, library) {
+			t.Errorf("MissingLibraries() = %v, should not contain %q", missing, library)
+		}
+	}
+}
+
+func TestLoadManifest_InvalidJSON(t *testing.T) {
+	tempDir := t.TempDir()
+	writeManifest(t, tempDir, `{ "dependencies": `)
+
+	if _, err := LoadManifest(tempDir); err == nil || !strings.Contains(err.Error(), "failed to parse") {
+		t.Errorf("LoadManifest() error = %v, want parse error", err)
+	}
+}
+
+func TestFrameworks(t *testing.T) {
+	manifest := &Manifest{Dependencies: map[string]string{
+		"nuxt":     "^3.10.0",
+		"vue":      "^3.4.0",
+		"quasar":   "^2.14.0",
+		"vite":     "^5.0.0",
+		"solid-js": "^1.8.0",
+	}}
+
+	expected := []string{"nuxt", "solid", "vue"}
+	if frameworks := manifest.Frameworks(); !reflect.DeepEqual(frameworks, expected) {
+		t.Errorf("Frameworks() = %v, want %v", frameworks, expected)
+	}
+}
+
+func TestLibraryVersions(t *testing.T) {
+	manifest := &Manifest{Dependencies: map[string]string{
+		"vuetify":             "^2.6.14",
+		"@mui/material":       "~5.15.0",
+		"@mui/x-date-pickers": "^7.0.0",
+		"quasar":              "workspace:*",
+		"antd":                "npm:antd@4.24.0",
+		"element-plus":        ">=2.3 <3",
+		"naive-ui":            "2.x",
+		"primevue":            "latest",
+	}}
+
+	expected := map[string]int{"vuetify": 2, "mui": 5, "antd": 4, "element-plus": 2, "naive-ui": 2}
+	if versions := manifest.LibraryVersions(); !reflect.DeepEqual(versions, expected) {
+		t.Errorf("LibraryVersions() = %v, want %v", versions, expected)
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	tests := []struct {
+		specifier string
+		expected  string
+	}{
+		{"quasar", "quasar"},
+		{"quasar/src/components/btn", "quasar"},
+		{"@mui/material", "@mui/material"},
+		{"@mui/material/Button", "@mui/material"},
+		{"./Button", ""},
+		{"../shared/Dialog.vue", ""},
+		{"@/components/Button.vue", ""},
+		{"~/components/Button.vue", ""},
+		{"#components", ""},
+		{"node:path", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.specifier, func(t *testing.T) {
+			if result := PackageName(tt.specifier); result != tt.expected {
+				t.Errorf("PackageName(%q) = %q, want %q", tt.specifier, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMissingDependencyWarnings(t *testing.T) {
+	manifest := &Manifest{Path: "package.json", Dependencies: map[string]string{"quasar": "^2.0.0"}}
+	matches := []types.ComponentMatch{
+		{ComponentName: "QBtn", Library: "quasar"},
+		{ComponentName: "Button", Library: "@mui/material"},
+		{ComponentName: "Dialog", Library: "@mui/material/Dialog"},
+		{ComponentName: "Modal", Library: "antd"},
+		{ComponentName: "MyDialog", Library: "./MyDialog.vue"},
+		{ComponentName: "q-form"},
+	}
+
+	expected := []string{
+		"2 match(es) imported from '@mui/material', which is not listed in package.json",
+		"1 match(es) imported from 'antd', which is not listed in package.json",
+	}
+	if warnings := manifest.MissingDependencyWarnings(matches); !reflect.DeepEqual(warnings, expected) {
+		t.Errorf("MissingDependencyWarnings() = %q, want %q", warnings, expected)
+	}
+}
+
+func TestFindFile(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tempDir, "b.yml"), nil, 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "c.json"), nil, 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(tempDir, "a.yaml"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		names    []string
+		expected string
+	}{
+		{"first existing name", []string{"b.yml", "c.json"}, "b.yml"},
+		{"names in order", []string{"c.json", "b.yml"}, "c.json"},
+		{"directories skipped", []string{"a.yaml", "b.yml"}, "b.yml"},
+		{"no existing name", []string{"missing.yaml"}, ""},
+		{"no names", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := tt.expected
+			if expected != "" {
+				expected = filepath.Join(tempDir, expected)
+			}
+			if result := FindFile(tempDir, tt.names); result != expected {
+				t.Errorf("FindFile(%v) = %q, want %q", tt.names, result, expected)
+			}
+		})
+	}
+}
+
+func TestFindRepoRoot(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(tempDir, ".git"), 0755); err != nil {
+		t.Fatalf("Failed to create .git: %v", err)
+	}
+	srcDir := filepath.Join(tempDir, "packages", "ui", "src")
+	if err := os.MkdirAll(srcDir, 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	if root := FindRepoRoot(srcDir); root != tempDir {
+		t.Errorf("FindRepoRoot(%s) = %s, want %s", srcDir, root, tempDir)
+	}
+}
+
+func TestHeadCommit_OutsideRepository(t *testing.T) {
+	if commit := HeadCommit(t.TempDir()); commit != "" {
+		t.Errorf("HeadCommit() = %q, want \"\" outside a repository", commit)
+	}
+}
@@ -0,0 +1,132 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"ui-elf/internal/project"
+)
+
+// FileNames lists the registry file names looked up in the scanned directory, in order
+// JSON files are parsed with the YAML parser, as JSON is a subset of YAML
+var FileNames = []string{"ui-elf.registry.yaml", "ui-elf.registry.yml", "ui-elf.registry.json"}
+
+// GlobalFileNames lists the registry file names looked up in the global configuration directory, in order
+var GlobalFileNames = []string{"registry.yaml", "registry.yml", "registry.json"}
+
+// registryFile is the structure of a registry file
+//
+//	types:
+//	  button:
+//	    patterns:
+//	      acme: [AcmeButton, acme-button]
+//	    imperative:
+//	      acme: [useAcmeToast]
+//	    exclude: [AcmeIconButton]
+//	deprecated:
+//	  OldDialog: AppModal
+type registryFile struct {
+	Types      map[string]registryType `yaml:"types" json:"types"`
+	Deprecated map[string]string       `yaml:"deprecated,omitempty" json:"deprecated,omitempty"` // Deprecated component name -> suggested replacement
+}
+
+// registryType is the mapping of a component type in a registry file
+type registryType struct {
+	Patterns   map[string][]string `yaml:"patterns" json:"patterns"`
+	Imperative map[string][]string `yaml:"imperative,omitempty" json:"imperative,omitempty"`
+	Exclude    []string            `yaml:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// FindFile returns the path of the registry file in dir, or "" if there is none
+func FindFile(dir string) string {
+	return project.FindFile(dir, FileNames)
+}
+
+// FindGlobalFile returns the path of the registry file in the global configuration directory, or ""
+func FindGlobalFile(globalDir string) string {
+	if globalDir == "" {
+		return ""
+	}
+	return project.FindFile(globalDir, GlobalFileNames)
+}
+
+// LoadFile merges the mappings of a registry file over the registry
+func (r *ComponentMappingRegistry) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read registry file: %w", err)
+	}
+	return r.merge(data, path)
+}
+
+// MarshalYAML encodes the registry in the registry file format, for displaying the effective mappings
+func (r *ComponentMappingRegistry) MarshalYAML() (interface{}, error) {
+	return r.file(), nil
+}
+
+// MarshalJSON encodes the registry in the registry file format, for exporting the effective mappings
+func (r *ComponentMappingRegistry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.file())
+}
+
+// file returns the mappings and deprecations of the registry in the registry file format
+// Patterns of disabled libraries are included, as a registry file does not disable libraries
+func (r *ComponentMappingRegistry) file() registryFile {
+	file := registryFile{Types: make(map[string]registryType), Deprecated: r.Deprecations()}
+	for componentType, mapping := range r.mappings {
+		file.Types[componentType] = registryType{
+			Patterns:   mapping.Patterns,
+			Imperative: mapping.Imperative,
+			Exclude:    mapping.Exclude,
+		}
+	}
+	if len(file.Deprecated) == 0 {
+		file.Deprecated = nil
+	}
+	return file
+}
+
+// merge merges registry file content over the registry; source names it in errors
+// Component types and libraries not yet known are added; the component
+// names of a library already known for a type are replaced, and excluded
+// names are added to those of the type. Deprecated components are added
+// with their suggested replacement.
+func (r *ComponentMappingRegistry) merge(data []byte, source string) error {
+	var file registryFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse registry file %s: %w", source, err)
+	}
+
+	for name, entry := range file.Types {
+		componentType := strings.ToLower(strings.TrimSpace(name))
+		if isReservedType(componentType) {
+			return fmt.Errorf("invalid component type '%s' in %s", name, source)
+		}
+
+		mapping, exists := r.mappings[componentType]
+		if !exists {
+			mapping = ComponentMapping{Type: componentType, Patterns: make(map[string][]string)}
+		}
+		for library, patterns := range entry.Patterns {
+			mapping.Patterns[library] = patterns
+		}
+		if len(entry.Imperative) > 0 && mapping.Imperative == nil {
+			mapping.Imperative = make(map[string][]string)
+		}
+		for library, calls := range entry.Imperative {
+			mapping.Imperative[library] = calls
+		}
+		mapping.Exclude = append(mapping.Exclude, entry.Exclude...)
+		r.mappings[componentType] = mapping
+	}
+
+	for name, replacement := range file.Deprecated {
+		r.Deprecate(strings.TrimSpace(name), strings.TrimSpace(replacement))
+	}
+
+	return nil
+}
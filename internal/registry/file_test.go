@@ -0,0 +1,218 @@
+package registry
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestFindFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	if path := FindFile(tempDir); path != "" {
+		t.Errorf("FindFile() = %q, want \"\" for a directory without registry file", path)
+	}
+
+	path := filepath.Join(tempDir, "ui-elf.registry.json")
+	if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+		t.Fatalf("Failed to create registry file: %v", err)
+	}
+	if result := FindFile(tempDir); result != path {
+		t.Errorf("FindFile() = %q, want %q", result, path)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+	}{
+		{
+			name:     "yaml",
+			fileName: "ui-elf.registry.yaml",
+			content: `types:
+  button:
+    patterns:
+      acme: [AcmeButton, acme-button]
+      mui: [Button]
+    exclude: [IconButton]
+  Chart:
+    patterns:
+      acme: [AcmeChart]
+    imperative:
+      acme: [useAcmeChart]
+deprecated:
+  OldDialog: AppModal
+`,
+		},
+		{
+			name:     "json",
+			fileName: "ui-elf.registry.json",
+			content: `{"types": {
+  "button": {"patterns": {"acme": ["AcmeButton", "acme-button"], "mui": ["Button"]}, "exclude": ["IconButton"]},
+  "Chart": {"patterns": {"acme": ["AcmeChart"]}, "imperative": {"acme": ["useAcmeChart"]}}
+}, "deprecated": {"OldDialog": "AppModal"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.fileName)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to create registry file: %v", err)
+			}
+
+			registry := NewComponentMappingRegistry()
+			if err := registry.LoadFile(path); err != nil {
+				t.Fatalf("LoadFile() error = %v", err)
+			}
+
+			// New library merged over the built-in button mappings
+			if !registry.MatchesComponentType("acme-button", "button") || !registry.MatchesComponentType("QBtn", "button") {
+				t.Errorf("Expected acme-button and the built-in QBtn to be buttons")
+			}
+			// Known library replaced
+			if registry.MatchesComponentType("MuiButton", "button") || !registry.MatchesComponentType("Button", "button") {
+				t.Errorf("Expected the mui button patterns to be replaced")
+			}
+			// Excluded names are not matched, although the chakra patterns list them
+			if registry.MatchesComponentType("iconbutton", "button") {
+				t.Errorf("Expected IconButton to be excluded from buttons")
+			}
+			// New component type, normalized to lower case
+			if types := registry.Types(); !slices.Contains(types, "chart") || !sort.StringsAreSorted(types) {
+				t.Errorf("Types() = %v, want a sorted list containing chart", types)
+			}
+			if !registry.MatchesComponentType("AcmeChart", "chart") {
+				t.Errorf("Expected AcmeChart to be a chart")
+			}
+			if calls := registry.ImperativeCalls("chart"); !reflect.DeepEqual(calls, []string{"useAcmeChart"}) {
+				t.Errorf("ImperativeCalls(chart) = %v, want [useAcmeChart]", calls)
+			}
+			// Deprecated components, looked up case-insensitively
+			if replacement, deprecated := registry.Replacement("olddialog"); !deprecated || replacement != "AppModal" {
+				t.Errorf("Replacement(olddialog) = %q, %v, want AppModal, true", replacement, deprecated)
+			}
+			if deprecations := registry.Deprecations(); !reflect.DeepEqual(deprecations, map[string]string{"OldDialog": "AppModal"}) {
+				t.Errorf("Deprecations() = %v, want map[OldDialog:AppModal]", deprecations)
+			}
+		})
+	}
+}
+
+func TestLoadFile_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"invalid yaml", "types: [", "failed to parse registry file"},
+		{"custom type", "types:\n  custom:\n    patterns:\n      acme: [AcmeWidget]\n", "invalid component type 'custom'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ui-elf.registry.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to create registry file: %v", err)
+			}
+
+			err := NewComponentMappingRegistry().LoadFile(path)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("LoadFile() error = %v, want error containing %q", err, tt.expected)
+			}
+		})
+	}
+
+	if err := NewComponentMappingRegistry().LoadFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Errorf("LoadFile() should fail for a missing file")
+	}
+}
+
+func TestFindGlobalFile(t *testing.T) {
+	globalDir := t.TempDir()
+
+	if path := FindGlobalFile(""); path != "" {
+		t.Errorf("FindGlobalFile(\"\") = %q, want \"\"", path)
+	}
+	if path := FindGlobalFile(globalDir); path != "" {
+		t.Errorf("FindGlobalFile() = %q, want \"\" without registry file", path)
+	}
+
+	path := filepath.Join(globalDir, "registry.json")
+	if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+		t.Fatalf("Failed to create registry file: %v", err)
+	}
+	if found := FindGlobalFile(globalDir); found != path {
+		t.Errorf("FindGlobalFile() = %q, want %q", found, path)
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+	registry.Deprecate("OldButton", "AcmeButton")
+	if err := registry.AddPatterns("button", "AcmeButton"); err != nil {
+		t.Fatalf("AddPatterns() error = %v", err)
+	}
+
+	data, err := yaml.Marshal(registry)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	// The exported registry loads back into the same mappings
+	loaded := &ComponentMappingRegistry{
+		mappings:   make(map[string]ComponentMapping),
+		disabled:   make(map[string]bool),
+		deprecated: make(map[string]deprecation),
+		versions:   make(map[string]int),
+		groups:     make(map[string][]string),
+	}
+	if err := loaded.merge(data, "exported"); err != nil {
+		t.Fatalf("merge() error = %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Types(), registry.Types()) {
+		t.Errorf("Types() = %v, want %v", loaded.Types(), registry.Types())
+	}
+	if !loaded.MatchesComponentType("AcmeButton", "button") || !loaded.MatchesComponentType("q-btn", "button") {
+		t.Errorf("Expected the exported mappings to match AcmeButton and q-btn as buttons")
+	}
+	if replacement, _ := loaded.Replacement("OldButton"); replacement != "AcmeButton" {
+		t.Errorf("Replacement(OldButton) = %q, want AcmeButton", replacement)
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+	if err := registry.merge([]byte("types:\n  button:\n    exclude: [AcmeIconButton]\n"), "test"); err != nil {
+		t.Fatalf("merge() error = %v", err)
+	}
+
+	data, err := json.Marshal(registry)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var file registryFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	button := file.Types["button"]
+	if !slices.Equal(button.Patterns["quasar"], []string{"q-btn", "QBtn"}) {
+		t.Errorf("quasar button patterns = %v, want [q-btn QBtn]", button.Patterns["quasar"])
+	}
+	if !slices.Equal(button.Exclude, []string{"AcmeIconButton"}) {
+		t.Errorf("button exclude = %v, want [AcmeIconButton]", button.Exclude)
+	}
+	if file.Deprecated != nil {
+		t.Errorf("Deprecated = %v, want it omitted without deprecations", file.Deprecated)
+	}
+}
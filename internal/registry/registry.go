@@ -1,32 +1,78 @@
 // Package registry maintains mappings between component types and library-specific implementations.
 package registry
 
-import "strings"
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ui-elf/internal/types"
+)
 
 // ComponentMapping defines the mapping structure for a component type
 type ComponentMapping struct {
-	Type     string
-	Patterns map[string][]string // library name -> component names
+	Type       string
+	Patterns   map[string][]string // library name -> component names
+	Imperative map[string][]string // library name -> API calls creating the component programmatically
+	Exclude    []string            // Component names never matched, even if a library pattern lists them
 }
 
 // ComponentMappingRegistry manages mappings between component types and actual component names
+// Library names may be scoped to a major version ("vuetify@2"); these patterns are only matched
+// when the installed version of the library is unknown or the same
 type ComponentMappingRegistry struct {
-	mappings map[string]ComponentMapping
+	mappings   map[string]ComponentMapping
+	disabled   map[string]bool        // Libraries whose components are not matched (e.g. not installed)
+	deprecated map[string]deprecation // Lowercase deprecated component name -> deprecation
+	versions   map[string]int         // Installed major version per library
+	groups     map[string][]string    // Composite type name -> member types
+	matchMode  string                 // How component names are compared to patterns: MatchExact, MatchPrefix or MatchFuzzy
+}
+
+// Match modes comparing component names to the patterns of a type, case-insensitively
+const (
+	MatchExact  = "exact"  // The name is the pattern (q-btn)
+	MatchPrefix = "prefix" // The name starts with the pattern (q-btn-dropdown, ButtonGroup)
+	MatchFuzzy  = "fuzzy"  // The name contains the pattern, ignoring "-", "_" and "." (QBtnDropdown, IconButton)
+)
+
+// deprecation is a deprecated component with its suggested replacement
+type deprecation struct {
+	name        string
+	replacement string
 }
 
 // NewComponentMappingRegistry creates a new registry with hardcoded mappings
 func NewComponentMappingRegistry() *ComponentMappingRegistry {
 	registry := &ComponentMappingRegistry{
-		mappings: make(map[string]ComponentMapping),
+		mappings:   make(map[string]ComponentMapping),
+		disabled:   make(map[string]bool),
+		deprecated: make(map[string]deprecation),
+		versions:   make(map[string]int),
+		groups:     make(map[string][]string),
+		matchMode:  MatchExact,
 	}
 
 	// Form mappings
 	registry.mappings["form"] = ComponentMapping{
 		Type: "form",
 		Patterns: map[string][]string{
-			"native":   {"form"},
-			"quasar":   {"q-form", "QForm"},
-			"material": {"v-form", "VForm", "Form", "MuiForm"},
+			"native":          {"form"},
+			"quasar":          {"q-form", "QForm"},
+			"vuetify":         {"v-form", "VForm"},
+			"mui":             {"Form", "MuiForm"},
+			"qwik":            {"Form"},
+			"mudblazor":       {"MudForm"},
+			"antd":            {"Form"},
+			"radix":           {"Form.Root"},
+			"element-plus":    {"el-form", "ElForm"},
+			"naive-ui":        {"n-form", "NForm"},
+			"primevue":        {"p-form", "PrimeForm"},
+			"shadcn":          {"Form"},
+			"react-bootstrap": {"Form"},
+			"bootstrap-vue":   {"b-form", "BForm"},
 		},
 	}
 
@@ -34,9 +80,24 @@ func NewComponentMappingRegistry() *ComponentMappingRegistry {
 	registry.mappings["button"] = ComponentMapping{
 		Type: "button",
 		Patterns: map[string][]string{
-			"native":   {"button"},
-			"quasar":   {"q-btn", "QBtn"},
-			"material": {"v-btn", "VBtn", "Button", "MuiButton"},
+			"native":          {"button"},
+			"quasar":          {"q-btn", "QBtn"},
+			"vuetify":         {"v-btn", "VBtn"},
+			"mui":             {"Button", "MuiButton"},
+			"ionic":           {"ion-button", "IonButton"},
+			"mudblazor":       {"MudButton"},
+			"antd":            {"Button"},
+			"element-plus":    {"el-button", "ElButton"},
+			"naive-ui":        {"n-button", "NButton"},
+			"primevue":        {"p-button", "PrimeButton"},
+			"chakra":          {"Button", "IconButton"},
+			"mantine":         {"Button", "ActionIcon"},
+			"shadcn":          {"Button"},
+			"react-bootstrap": {"Button", "CloseButton"},
+			"bootstrap-vue":   {"b-button", "BButton", "b-btn", "BBtn"},
+			"react-native": {
+				"Button", "Pressable", "TouchableOpacity", "TouchableHighlight", "TouchableWithoutFeedback",
+			},
 		},
 	}
 
@@ -44,9 +105,256 @@ func NewComponentMappingRegistry() *ComponentMappingRegistry {
 	registry.mappings["dialog"] = ComponentMapping{
 		Type: "dialog",
 		Patterns: map[string][]string{
-			"native":   {"dialog"},
-			"quasar":   {"q-dialog", "QDialog"},
-			"material": {"v-dialog", "VDialog", "Dialog", "MuiDialog"},
+			"native":          {"dialog"},
+			"quasar":          {"q-dialog", "QDialog"},
+			"vuetify":         {"v-dialog", "VDialog"},
+			"mui":             {"Dialog", "MuiDialog"},
+			"ionic":           {"ion-modal", "IonModal", "ion-alert", "IonAlert"},
+			"mudblazor":       {"MudDialog"},
+			"react-native":    {"Modal"},
+			"antd":            {"Modal"},
+			"radix":           {"Dialog.Root", "AlertDialog.Root"},
+			"element-plus":    {"el-dialog", "ElDialog"},
+			"naive-ui":        {"n-modal", "NModal", "n-dialog", "NDialog"},
+			"primevue":        {"p-dialog", "PrimeDialog", "p-confirm-dialog", "PrimeConfirmDialog"},
+			"chakra@2":        {"Modal", "AlertDialog"},
+			"chakra@3":        {"Dialog.Root", "AlertDialog.Root"},
+			"mantine":         {"Modal"},
+			"shadcn":          {"Dialog", "AlertDialog"},
+			"react-bootstrap": {"Modal"},
+			"bootstrap-vue":   {"b-modal", "BModal"},
+		},
+		Imperative: map[string][]string{
+			"quasar":        {"$q.dialog", "Dialog.create"},
+			"mui":           {"useSnackbar", "useDialogs"},
+			"antd":          {"Modal.confirm", "Modal.info", "Modal.success", "Modal.error", "Modal.warning", "modal.confirm"},
+			"element-plus":  {"ElMessageBox", "ElMessageBox.confirm", "ElMessageBox.alert", "ElMessageBox.prompt"},
+			"naive-ui":      {"useDialog", "useModal"},
+			"primevue":      {"useConfirm", "useDialog"},
+			"mantine":       {"modals.open", "modals.openConfirmModal", "modals.openContextModal"},
+			"bootstrap-vue": {"$bvModal.show", "$bvModal.msgBoxOk", "$bvModal.msgBoxConfirm"},
+			"ng-bootstrap":  {"modalService.open"},
+			"ionic":         {"modalController.create", "alertController.create"},
+			"react-native":  {"Alert.alert"},
+		},
+	}
+
+	// Input mappings
+	registry.mappings["input"] = ComponentMapping{
+		Type: "input",
+		Patterns: map[string][]string{
+			"native":          {"input", "textarea"},
+			"quasar":          {"q-input", "QInput", "q-file", "QFile"},
+			"vuetify":         {"v-text-field", "VTextField", "v-textarea", "VTextarea", "v-file-input", "VFileInput"},
+			"mui":             {"TextField", "MuiTextField", "OutlinedInput", "FilledInput"},
+			"ionic":           {"ion-input", "IonInput", "ion-textarea", "IonTextarea"},
+			"mudblazor":       {"MudTextField", "MudNumericField"},
+			"antd":            {"Input", "Input.TextArea", "Input.Password", "InputNumber"},
+			"element-plus":    {"el-input", "ElInput", "el-input-number", "ElInputNumber"},
+			"naive-ui":        {"n-input", "NInput", "n-input-number", "NInputNumber"},
+			"primevue":        {"p-input-text", "PrimeInputText", "p-textarea", "PrimeTextarea", "p-input-number", "PrimeInputNumber"},
+			"chakra":          {"Input", "Textarea"},
+			"mantine":         {"TextInput", "Textarea", "PasswordInput", "NumberInput"},
+			"shadcn":          {"Input", "Textarea"},
+			"react-bootstrap": {"Form.Control"},
+			"bootstrap-vue":   {"b-form-input", "BFormInput", "b-form-textarea", "BFormTextarea"},
+			"react-native":    {"TextInput"},
+		},
+	}
+
+	// Select mappings
+	registry.mappings["select"] = ComponentMapping{
+		Type: "select",
+		Patterns: map[string][]string{
+			"native":          {"select"},
+			"quasar":          {"q-select", "QSelect"},
+			"vuetify":         {"v-select", "VSelect", "v-autocomplete", "VAutocomplete", "v-combobox", "VCombobox"},
+			"mui":             {"Select", "MuiSelect", "Autocomplete", "NativeSelect"},
+			"ionic":           {"ion-select", "IonSelect"},
+			"mudblazor":       {"MudSelect", "MudAutocomplete"},
+			"antd":            {"Select", "AutoComplete", "Cascader", "TreeSelect"},
+			"radix":           {"Select.Root"},
+			"element-plus":    {"el-select", "ElSelect", "el-cascader", "ElCascader"},
+			"naive-ui":        {"n-select", "NSelect", "n-cascader", "NCascader"},
+			"primevue":        {"p-select", "PrimeSelect", "p-dropdown", "PrimeDropdown", "p-multi-select", "PrimeMultiSelect"},
+			"chakra":          {"Select", "Select.Root"},
+			"mantine":         {"Select", "MultiSelect", "Autocomplete", "NativeSelect"},
+			"shadcn":          {"Select"},
+			"react-bootstrap": {"Form.Select"},
+			"bootstrap-vue":   {"b-form-select", "BFormSelect"},
+		},
+	}
+
+	// Table mappings
+	registry.mappings["table"] = ComponentMapping{
+		Type: "table",
+		Patterns: map[string][]string{
+			"native":    {"table"},
+			"quasar":    {"q-table", "QTable", "q-markup-table", "QMarkupTable"},
+			"vuetify":   {"v-data-table", "VDataTable"},
+			"vuetify@2": {"v-simple-table", "VSimpleTable"},
+			"vuetify@3": {
+				"v-table", "VTable", "v-data-table-server", "VDataTableServer", "v-data-table-virtual", "VDataTableVirtual",
+			},
+			"mui":             {"Table", "MuiTable", "DataGrid"},
+			"mudblazor":       {"MudTable", "MudDataGrid"},
+			"antd":            {"Table"},
+			"element-plus":    {"el-table", "ElTable", "el-table-v2", "ElTableV2"},
+			"naive-ui":        {"n-data-table", "NDataTable", "n-table", "NTable"},
+			"primevue":        {"p-data-table", "PrimeDataTable"},
+			"chakra@2":        {"Table"},
+			"chakra@3":        {"Table.Root"},
+			"mantine":         {"Table"},
+			"shadcn":          {"Table"},
+			"react-bootstrap": {"Table"},
+			"bootstrap-vue":   {"b-table", "BTable", "b-table-simple", "BTableSimple"},
+		},
+	}
+
+	// Modal mappings
+	registry.mappings["modal"] = ComponentMapping{
+		Type: "modal",
+		Patterns: map[string][]string{
+			"mui":             {"Modal", "MuiModal"},
+			"ionic":           {"ion-modal", "IonModal"},
+			"antd":            {"Modal"},
+			"naive-ui":        {"n-modal", "NModal"},
+			"chakra@2":        {"Modal"},
+			"mantine":         {"Modal"},
+			"react-bootstrap": {"Modal"},
+			"bootstrap-vue":   {"b-modal", "BModal"},
+			"react-native":    {"Modal"},
+		},
+		Imperative: map[string][]string{
+			"antd":          {"Modal.confirm", "Modal.info", "Modal.success", "Modal.error", "Modal.warning", "modal.confirm"},
+			"naive-ui":      {"useModal"},
+			"mantine":       {"modals.open", "modals.openConfirmModal", "modals.openContextModal"},
+			"bootstrap-vue": {"$bvModal.show", "$bvModal.msgBoxOk", "$bvModal.msgBoxConfirm"},
+			"ng-bootstrap":  {"modalService.open"},
+			"ionic":         {"modalController.create"},
+		},
+	}
+
+	// Menu mappings
+	registry.mappings["menu"] = ComponentMapping{
+		Type: "menu",
+		Patterns: map[string][]string{
+			"native":          {"menu"},
+			"quasar":          {"q-menu", "QMenu"},
+			"vuetify":         {"v-menu", "VMenu"},
+			"mui":             {"Menu", "MuiMenu"},
+			"ionic":           {"ion-menu", "IonMenu"},
+			"mudblazor":       {"MudMenu"},
+			"antd":            {"Menu", "Dropdown"},
+			"radix":           {"DropdownMenu.Root", "ContextMenu.Root", "Menubar.Root"},
+			"element-plus":    {"el-menu", "ElMenu", "el-dropdown", "ElDropdown"},
+			"naive-ui":        {"n-menu", "NMenu", "n-dropdown", "NDropdown"},
+			"primevue":        {"p-menu", "PrimeMenu", "p-menubar", "PrimeMenubar", "p-context-menu", "PrimeContextMenu"},
+			"chakra":          {"Menu", "Menu.Root"},
+			"mantine":         {"Menu"},
+			"shadcn":          {"DropdownMenu", "ContextMenu", "Menubar"},
+			"react-bootstrap": {"Dropdown"},
+			"bootstrap-vue":   {"b-dropdown", "BDropdown"},
+		},
+	}
+
+	// Card mappings
+	registry.mappings["card"] = ComponentMapping{
+		Type: "card",
+		Patterns: map[string][]string{
+			"quasar":          {"q-card", "QCard"},
+			"vuetify":         {"v-card", "VCard"},
+			"mui":             {"Card", "MuiCard"},
+			"ionic":           {"ion-card", "IonCard"},
+			"mudblazor":       {"MudCard"},
+			"antd":            {"Card"},
+			"element-plus":    {"el-card", "ElCard"},
+			"naive-ui":        {"n-card", "NCard"},
+			"primevue":        {"p-card", "PrimeCard"},
+			"chakra":          {"Card", "Card.Root"},
+			"mantine":         {"Card"},
+			"shadcn":          {"Card"},
+			"react-bootstrap": {"Card"},
+			"bootstrap-vue":   {"b-card", "BCard"},
+		},
+	}
+
+	// Tooltip mappings
+	registry.mappings["tooltip"] = ComponentMapping{
+		Type: "tooltip",
+		Patterns: map[string][]string{
+			"quasar":          {"q-tooltip", "QTooltip"},
+			"vuetify":         {"v-tooltip", "VTooltip"},
+			"mui":             {"Tooltip", "MuiTooltip"},
+			"mudblazor":       {"MudTooltip"},
+			"antd":            {"Tooltip"},
+			"radix":           {"Tooltip.Root"},
+			"element-plus":    {"el-tooltip", "ElTooltip"},
+			"naive-ui":        {"n-tooltip", "NTooltip"},
+			"chakra":          {"Tooltip", "Tooltip.Root"},
+			"mantine":         {"Tooltip"},
+			"shadcn":          {"Tooltip"},
+			"react-bootstrap": {"Tooltip", "OverlayTrigger"},
+			"bootstrap-vue":   {"b-tooltip", "BTooltip"},
+		},
+	}
+
+	// Tabs mappings
+	registry.mappings["tabs"] = ComponentMapping{
+		Type: "tabs",
+		Patterns: map[string][]string{
+			"quasar":          {"q-tabs", "QTabs"},
+			"vuetify":         {"v-tabs", "VTabs"},
+			"mui":             {"Tabs", "MuiTabs"},
+			"ionic":           {"ion-tabs", "IonTabs"},
+			"mudblazor":       {"MudTabs"},
+			"antd":            {"Tabs"},
+			"radix":           {"Tabs.Root"},
+			"element-plus":    {"el-tabs", "ElTabs"},
+			"naive-ui":        {"n-tabs", "NTabs"},
+			"primevue":        {"p-tabs", "PrimeTabs", "p-tab-view", "PrimeTabView"},
+			"chakra":          {"Tabs", "Tabs.Root"},
+			"mantine":         {"Tabs"},
+			"shadcn":          {"Tabs"},
+			"react-bootstrap": {"Tabs"},
+			"bootstrap-vue":   {"b-tabs", "BTabs"},
+		},
+	}
+
+	// Date picker mappings
+	registry.mappings["date-picker"] = ComponentMapping{
+		Type: "date-picker",
+		Patterns: map[string][]string{
+			"quasar":        {"q-date", "QDate"},
+			"vuetify":       {"v-date-picker", "VDatePicker"},
+			"mui":           {"DatePicker", "DateTimePicker", "DateRangePicker"},
+			"mui@4":         {"KeyboardDatePicker", "KeyboardDateTimePicker"},
+			"ionic":         {"ion-datetime", "IonDatetime"},
+			"mudblazor":     {"MudDatePicker", "MudDateRangePicker"},
+			"antd":          {"DatePicker", "DatePicker.RangePicker"},
+			"element-plus":  {"el-date-picker", "ElDatePicker"},
+			"naive-ui":      {"n-date-picker", "NDatePicker"},
+			"primevue":      {"p-date-picker", "PrimeDatePicker", "p-calendar", "PrimeCalendar"},
+			"mantine":       {"DatePicker", "DatePickerInput", "DateInput"},
+			"shadcn":        {"Calendar", "DatePicker"},
+			"bootstrap-vue": {"b-form-datepicker", "BFormDatepicker"},
+			"ng-bootstrap":  {"ngb-datepicker"},
+		},
+	}
+
+	// Icon mappings
+	registry.mappings["icon"] = ComponentMapping{
+		Type: "icon",
+		Patterns: map[string][]string{
+			"quasar":        {"q-icon", "QIcon"},
+			"vuetify":       {"v-icon", "VIcon"},
+			"mui":           {"Icon", "SvgIcon"},
+			"ionic":         {"ion-icon", "IonIcon"},
+			"mudblazor":     {"MudIcon"},
+			"element-plus":  {"el-icon", "ElIcon"},
+			"naive-ui":      {"n-icon", "NIcon"},
+			"chakra":        {"Icon"},
+			"bootstrap-vue": {"b-icon", "BIcon"},
 		},
 	}
 
@@ -59,6 +367,236 @@ func (r *ComponentMappingRegistry) GetMapping(componentType string) (ComponentMa
 	return mapping, exists
 }
 
+// AdHocLibrary is the library of the component names added with AddPatterns
+const AdHocLibrary = "ad-hoc"
+
+// NativeLibrary is the library of the plain HTML elements (form, button, dialog, ...)
+const NativeLibrary = "native"
+
+// AddPatterns adds component names to a component type, creating the type if it is not known
+// The names are matched regardless of the installed libraries
+func (r *ComponentMappingRegistry) AddPatterns(componentType string, patterns ...string) error {
+	componentType = strings.ToLower(strings.TrimSpace(componentType))
+	if isReservedType(componentType) {
+		return fmt.Errorf("invalid component type '%s'", componentType)
+	}
+
+	mapping, exists := r.mappings[componentType]
+	if !exists {
+		mapping = ComponentMapping{Type: componentType, Patterns: make(map[string][]string)}
+	}
+	mapping.Patterns[AdHocLibrary] = append(mapping.Patterns[AdHocLibrary], patterns...)
+	r.mappings[componentType] = mapping
+	return nil
+}
+
+// isReservedType checks if a component type name cannot be used for registry mappings
+// The empty name and the types matched without patterns (custom, deprecated, all) are reserved
+func isReservedType(componentType string) bool {
+	return componentType == "" || componentType == types.ComponentTypeCustom ||
+		componentType == types.ComponentTypeDeprecated || componentType == types.ComponentTypeAll
+}
+
+// SetMatchMode sets how component names are compared to the patterns of a type
+// Excluded names are always compared exactly
+func (r *ComponentMappingRegistry) SetMatchMode(mode string) error {
+	switch mode {
+	case MatchExact, MatchPrefix, MatchFuzzy:
+		r.matchMode = mode
+		return nil
+	default:
+		return fmt.Errorf("invalid match mode '%s': must be one of: %s, %s, %s", mode, MatchExact, MatchPrefix, MatchFuzzy)
+	}
+}
+
+// MatchMode returns how component names are compared to the patterns of a type
+func (r *ComponentMappingRegistry) MatchMode() string {
+	return r.matchMode
+}
+
+// matchesPattern compares a component name to a pattern according to the match mode
+func (r *ComponentMappingRegistry) matchesPattern(componentName string, pattern string) bool {
+	switch r.matchMode {
+	case MatchPrefix:
+		return strings.HasPrefix(strings.ToLower(componentName), strings.ToLower(pattern))
+	case MatchFuzzy:
+		return strings.Contains(fuzzyName(componentName), fuzzyName(pattern))
+	default:
+		return strings.EqualFold(componentName, pattern)
+	}
+}
+
+// fuzzyNameReplacer removes the separators ignored by fuzzy matching
+var fuzzyNameReplacer = strings.NewReplacer("-", "", "_", "", ".", "")
+
+// fuzzyName returns the lowercase form of a name without separators (qbtn for q-btn and QBtn)
+func fuzzyName(name string) string {
+	return strings.ToLower(fuzzyNameReplacer.Replace(name))
+}
+
+// DefineGroup defines a composite type matching the components of its member types
+// (e.g. "interactive" for button, menu and tabs). Members are registry types, custom or
+// deprecated; a group name cannot be one of them
+func (r *ComponentMappingRegistry) DefineGroup(name string, members ...string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if _, exists := r.mappings[name]; exists || isReservedType(name) {
+		return fmt.Errorf("invalid group name '%s': already a component type", name)
+	}
+	if len(members) == 0 {
+		return fmt.Errorf("invalid group '%s': no member types", name)
+	}
+
+	var memberTypes []string
+	for _, member := range members {
+		member = strings.ToLower(strings.TrimSpace(member))
+		_, exists := r.mappings[member]
+		if !exists && member != types.ComponentTypeCustom && member != types.ComponentTypeDeprecated {
+			return fmt.Errorf("invalid group '%s': unknown member type '%s'", name, member)
+		}
+		if !slices.Contains(memberTypes, member) {
+			memberTypes = append(memberTypes, member)
+		}
+	}
+	r.groups[name] = memberTypes
+	return nil
+}
+
+// GroupMembers returns the member types of a composite type, in the order defined
+func (r *ComponentMappingRegistry) GroupMembers(name string) ([]string, bool) {
+	members, exists := r.groups[strings.ToLower(name)]
+	return members, exists
+}
+
+// Groups returns the names of the composite types, sorted
+func (r *ComponentMappingRegistry) Groups() []string {
+	groups := make([]string, 0, len(r.groups))
+	for name := range r.groups {
+		groups = append(groups, name)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// Deprecate marks a component as deprecated, suggesting a replacement
+func (r *ComponentMappingRegistry) Deprecate(componentName string, replacement string) {
+	r.deprecated[strings.ToLower(componentName)] = deprecation{name: componentName, replacement: replacement}
+}
+
+// Replacement returns the suggested replacement of a deprecated component
+// Component names are compared case-insensitively, like the mapping patterns
+func (r *ComponentMappingRegistry) Replacement(componentName string) (string, bool) {
+	entry, deprecated := r.deprecated[strings.ToLower(componentName)]
+	return entry.replacement, deprecated
+}
+
+// Deprecations returns the deprecated component names mapped to their suggested replacement
+func (r *ComponentMappingRegistry) Deprecations() map[string]string {
+	deprecations := make(map[string]string, len(r.deprecated))
+	for _, entry := range r.deprecated {
+		deprecations[entry.name] = entry.replacement
+	}
+	return deprecations
+}
+
+// DisableLibraries stops matching the components and API calls of the given libraries
+// Used to restrict matching to the libraries installed in the scanned project
+func (r *ComponentMappingRegistry) DisableLibraries(libraries ...string) {
+	for _, library := range libraries {
+		r.disabled[library] = true
+	}
+}
+
+// SetLibraryVersions sets the installed major version of libraries, selecting their version-scoped patterns
+func (r *ComponentMappingRegistry) SetLibraryVersions(versions map[string]int) {
+	for library, version := range versions {
+		r.versions[library] = version
+	}
+}
+
+// libraryEnabled checks if the patterns listed under a library name are matched
+func (r *ComponentMappingRegistry) libraryEnabled(name string) bool {
+	library, version := splitLibraryVersion(name)
+	if r.disabled[library] {
+		return false
+	}
+	installed := r.versions[library]
+	return version == 0 || installed == 0 || installed == version
+}
+
+// splitLibraryVersion splits a library name scoped to a major version ("vuetify@2")
+// Returns version 0 for unscoped names
+func splitLibraryVersion(name string) (string, int) {
+	idx := strings.LastIndex(name, "@")
+	if idx <= 0 {
+		return name, 0
+	}
+	version, err := strconv.Atoi(name[idx+1:])
+	if err != nil || version <= 0 {
+		return name, 0
+	}
+	return name[:idx], version
+}
+
+// Libraries returns the libraries with component names or API calls in the registry, sorted
+// Version-scoped names are reported as their library ("vuetify" for "vuetify@2")
+func (r *ComponentMappingRegistry) Libraries() []string {
+	found := make(map[string]bool)
+	for _, mapping := range r.mappings {
+		for name := range mapping.Patterns {
+			library, _ := splitLibraryVersion(name)
+			found[library] = true
+		}
+		for name := range mapping.Imperative {
+			library, _ := splitLibraryVersion(name)
+			found[library] = true
+		}
+	}
+
+	libraries := make([]string, 0, len(found))
+	for library := range found {
+		libraries = append(libraries, library)
+	}
+	sort.Strings(libraries)
+	return libraries
+}
+
+// RestrictLibraries disables every library except the given ones
+func (r *ComponentMappingRegistry) RestrictLibraries(libraries ...string) {
+	for _, library := range r.Libraries() {
+		if !slices.Contains(libraries, library) {
+			r.disabled[library] = true
+		}
+	}
+}
+
+// Types returns the registered component types, sorted
+func (r *ComponentMappingRegistry) Types() []string {
+	types := make([]string, 0, len(r.mappings))
+	for componentType := range r.mappings {
+		types = append(types, componentType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ImperativeCalls returns the API calls that create components of the given type programmatically
+// The result is sorted and empty for types without imperative APIs
+func (r *ComponentMappingRegistry) ImperativeCalls(componentType string) []string {
+	mapping, exists := r.GetMapping(componentType)
+	if !exists {
+		return nil
+	}
+
+	var calls []string
+	for library, libraryCalls := range mapping.Imperative {
+		if r.libraryEnabled(library) {
+			calls = append(calls, libraryCalls...)
+		}
+	}
+	sort.Strings(calls)
+	return calls
+}
+
 // MatchesComponentType checks if a component name matches a given component type
 func (r *ComponentMappingRegistry) MatchesComponentType(componentName string, componentType string) bool {
 	mapping, exists := r.GetMapping(componentType)
@@ -67,10 +605,19 @@ func (r *ComponentMappingRegistry) MatchesComponentType(componentName string, co
 		return strings.EqualFold(componentName, componentType)
 	}
 
-	// Check all patterns for the component type
-	for _, patterns := range mapping.Patterns {
+	for _, excluded := range mapping.Exclude {
+		if strings.EqualFold(componentName, excluded) {
+			return false
+		}
+	}
+
+	// Check all patterns of enabled libraries for the component type
+	for library, patterns := range mapping.Patterns {
+		if !r.libraryEnabled(library) {
+			continue
+		}
 		for _, pattern := range patterns {
-			if strings.EqualFold(componentName, pattern) {
+			if r.matchesPattern(componentName, pattern) {
 				return true
 			}
 		}
@@ -78,3 +625,48 @@ func (r *ComponentMappingRegistry) MatchesComponentType(componentName string, co
 
 	return false
 }
+
+// MatchingLibraries returns the enabled libraries listing a component name or API call for a
+// component type, sorted. Version-scoped names are reported as their library ("vuetify" for "vuetify@2")
+func (r *ComponentMappingRegistry) MatchingLibraries(componentName string, componentType string) []string {
+	var libraries []string
+	for name := range r.MatchingPatterns(componentName, componentType) {
+		library, _ := splitLibraryVersion(name)
+		if !slices.Contains(libraries, library) {
+			libraries = append(libraries, library)
+		}
+	}
+	sort.Strings(libraries)
+	return libraries
+}
+
+// MatchingPatterns returns the first pattern or API call matching a component name per enabled
+// library of a component type, keyed by the library name as listed ("vuetify@2")
+// Returns nil if the name is excluded or the type is unknown
+func (r *ComponentMappingRegistry) MatchingPatterns(componentName string, componentType string) map[string]string {
+	mapping, exists := r.GetMapping(componentType)
+	if !exists {
+		return nil
+	}
+	for _, excluded := range mapping.Exclude {
+		if strings.EqualFold(componentName, excluded) {
+			return nil
+		}
+	}
+
+	matched := make(map[string]string)
+	for _, names := range []map[string][]string{mapping.Patterns, mapping.Imperative} {
+		for name, patterns := range names {
+			if _, found := matched[name]; found || !r.libraryEnabled(name) {
+				continue
+			}
+			for _, pattern := range patterns {
+				if r.matchesPattern(componentName, pattern) {
+					matched[name] = pattern
+					break
+				}
+			}
+		}
+	}
+	return matched
+}
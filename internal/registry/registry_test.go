@@ -1,6 +1,11 @@
 package registry
 
-import "testing"
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
 
 func TestNewComponentMappingRegistry(t *testing.T) {
 	registry := NewComponentMappingRegistry()
@@ -25,6 +30,9 @@ func TestGetMapping(t *testing.T) {
 		{"form mapping exists", "form", true},
 		{"button mapping exists", "button", true},
 		{"dialog mapping exists", "dialog", true},
+		{"input mapping exists", "input", true},
+		{"modal mapping exists", "modal", true},
+		{"date-picker mapping exists", "date-picker", true},
 		{"unknown mapping", "unknown", false},
 	}
 
@@ -49,10 +57,20 @@ func TestMatchesComponentType_Forms(t *testing.T) {
 		{"native form", "form", true},
 		{"quasar q-form", "q-form", true},
 		{"quasar QForm", "QForm", true},
-		{"material v-form", "v-form", true},
-		{"material VForm", "VForm", true},
-		{"material Form", "Form", true},
-		{"material MuiForm", "MuiForm", true},
+		{"vuetify v-form", "v-form", true},
+		{"vuetify VForm", "VForm", true},
+		{"mui Form", "Form", true},
+		{"mui MuiForm", "MuiForm", true},
+		{"radix Form.Root", "Form.Root", true},
+		{"element-plus el-form", "el-form", true},
+		{"element-plus ElForm", "ElForm", true},
+		{"naive-ui n-form", "n-form", true},
+		{"naive-ui NForm", "NForm", true},
+		{"primevue p-form", "p-form", true},
+		{"primevue PrimeForm", "PrimeForm", true},
+		{"bootstrap-vue b-form", "b-form", true},
+		{"bootstrap-vue BForm", "BForm", true},
+		{"antd form item", "Form.Item", false},
 		{"case insensitive", "FORM", true},
 		{"case insensitive quasar", "Q-FORM", true},
 		{"non-form component", "button", false},
@@ -80,10 +98,25 @@ func TestMatchesComponentType_Buttons(t *testing.T) {
 		{"native button", "button", true},
 		{"quasar q-btn", "q-btn", true},
 		{"quasar QBtn", "QBtn", true},
-		{"material v-btn", "v-btn", true},
-		{"material VBtn", "VBtn", true},
-		{"material Button", "Button", true},
-		{"material MuiButton", "MuiButton", true},
+		{"vuetify v-btn", "v-btn", true},
+		{"vuetify VBtn", "VBtn", true},
+		{"mui Button", "Button", true},
+		{"mui MuiButton", "MuiButton", true},
+		{"ionic ion-button", "ion-button", true},
+		{"ionic IonButton", "IonButton", true},
+		{"mudblazor MudButton", "MudButton", true},
+		{"react-native Pressable", "Pressable", true},
+		{"react-native TouchableOpacity", "TouchableOpacity", true},
+		{"element-plus el-button", "el-button", true},
+		{"element-plus ElButton", "ElButton", true},
+		{"naive-ui n-button", "n-button", true},
+		{"naive-ui NButton", "NButton", true},
+		{"primevue p-button", "p-button", true},
+		{"primevue PrimeButton", "PrimeButton", true},
+		{"chakra IconButton", "IconButton", true},
+		{"mantine ActionIcon", "ActionIcon", true},
+		{"bootstrap-vue b-button", "b-button", true},
+		{"bootstrap-vue BBtn", "BBtn", true},
 		{"case insensitive", "BUTTON", true},
 		{"non-button component", "form", false},
 	}
@@ -110,10 +143,28 @@ func TestMatchesComponentType_Dialogs(t *testing.T) {
 		{"native dialog", "dialog", true},
 		{"quasar q-dialog", "q-dialog", true},
 		{"quasar QDialog", "QDialog", true},
-		{"material v-dialog", "v-dialog", true},
-		{"material VDialog", "VDialog", true},
-		{"material Dialog", "Dialog", true},
-		{"material MuiDialog", "MuiDialog", true},
+		{"vuetify v-dialog", "v-dialog", true},
+		{"vuetify VDialog", "VDialog", true},
+		{"mui Dialog", "Dialog", true},
+		{"mui MuiDialog", "MuiDialog", true},
+		{"ionic ion-modal", "ion-modal", true},
+		{"ionic IonAlert", "IonAlert", true},
+		{"react-native Modal", "Modal", true},
+		{"radix Dialog.Root", "Dialog.Root", true},
+		{"radix AlertDialog.Root", "AlertDialog.Root", true},
+		{"radix dialog part", "Dialog.Trigger", false},
+		{"element-plus el-dialog", "el-dialog", true},
+		{"element-plus ElDialog", "ElDialog", true},
+		{"naive-ui n-modal", "n-modal", true},
+		{"naive-ui NDialog", "NDialog", true},
+		{"primevue p-dialog", "p-dialog", true},
+		{"primevue PrimeConfirmDialog", "PrimeConfirmDialog", true},
+		{"chakra Modal", "Modal", true},
+		{"chakra AlertDialog", "AlertDialog", true},
+		{"chakra modal part", "ModalContent", false},
+		{"shadcn dialog part", "DialogContent", false},
+		{"bootstrap-vue b-modal", "b-modal", true},
+		{"bootstrap-vue BModal", "BModal", true},
 		{"case insensitive", "DIALOG", true},
 		{"non-dialog component", "button", false},
 	}
@@ -129,6 +180,84 @@ func TestMatchesComponentType_Dialogs(t *testing.T) {
 	}
 }
 
+func TestMatchesComponentType_Inputs(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+
+	tests := []struct {
+		name          string
+		componentName string
+		shouldMatch   bool
+	}{
+		{"native input", "input", true},
+		{"native textarea", "textarea", true},
+		{"chakra Input", "Input", true},
+		{"mantine TextInput", "TextInput", true},
+		{"mantine PasswordInput", "PasswordInput", true},
+		{"react-bootstrap Form.Control", "Form.Control", true},
+		{"bootstrap-vue b-form-input", "b-form-input", true},
+		{"case insensitive", "TEXTINPUT", true},
+		{"non-input component", "button", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := registry.MatchesComponentType(tt.componentName, "input")
+			if matches != tt.shouldMatch {
+				t.Errorf("MatchesComponentType(%q, %q) = %v, want %v",
+					tt.componentName, "input", matches, tt.shouldMatch)
+			}
+		})
+	}
+}
+
+func TestMatchesComponentType_MoreTypes(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+
+	tests := []struct {
+		componentName string
+		componentType string
+		shouldMatch   bool
+	}{
+		{"q-input", "input", true},
+		{"VTextField", "input", true},
+		{"q-select", "select", true},
+		{"v-autocomplete", "select", true},
+		{"QTable", "table", true},
+		{"v-data-table", "table", true},
+		{"v-simple-table", "table", true},
+		{"VTable", "table", true},
+		{"q-menu", "menu", true},
+		{"v-card", "card", true},
+		{"QTooltip", "tooltip", true},
+		{"v-tabs", "tabs", true},
+		{"q-date", "date-picker", true},
+		{"VDatePicker", "date-picker", true},
+		{"q-icon", "icon", true},
+		{"v-icon", "icon", true},
+		{"MuiModal", "modal", true},
+		{"b-modal", "modal", true},
+		{"el-dialog", "modal", false},
+		{"TextField", "input", true},
+		{"Select.Root", "select", true},
+		{"n-data-table", "table", true},
+		{"DropdownMenu.Root", "menu", true},
+		{"ion-card", "card", true},
+		{"Tooltip.Root", "tooltip", true},
+		{"p-tab-view", "tabs", true},
+		{"ion-datetime", "date-picker", true},
+		{"ngb-datepicker", "date-picker", true},
+		{"MudIcon", "icon", true},
+		{"q-card", "table", false},
+		{"q-tab", "tabs", false},
+	}
+
+	for _, tt := range tests {
+		if matches := registry.MatchesComponentType(tt.componentName, tt.componentType); matches != tt.shouldMatch {
+			t.Errorf("MatchesComponentType(%q, %q) = %v, want %v", tt.componentName, tt.componentType, matches, tt.shouldMatch)
+		}
+	}
+}
+
 func TestMatchesComponentType_CustomComponent(t *testing.T) {
 	registry := NewComponentMappingRegistry()
 
@@ -154,3 +283,301 @@ func TestMatchesComponentType_CustomComponent(t *testing.T) {
 		})
 	}
 }
+
+func TestImperativeCalls(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+
+	calls := registry.ImperativeCalls("dialog")
+	for _, expected := range []string{"$q.dialog", "Modal.confirm", "useSnackbar", "modals.openConfirmModal"} {
+		found := false
+		for _, call := range calls {
+			if call == expected {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("ImperativeCalls(dialog) should contain %q, got %v", expected, calls)
+		}
+	}
+
+	if calls := registry.ImperativeCalls("button"); len(calls) != 0 {
+		t.Errorf("ImperativeCalls(button) = %v, want none", calls)
+	}
+	if calls := registry.ImperativeCalls("unknown"); calls != nil {
+		t.Errorf("ImperativeCalls(unknown) = %v, want nil", calls)
+	}
+}
+
+func TestDisableLibraries(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+	registry.DisableLibraries("mui", "antd", "react-native", "chakra", "mantine", "react-bootstrap")
+
+	tests := []struct {
+		componentName string
+		componentType string
+		shouldMatch   bool
+	}{
+		{"QBtn", "button", true},
+		{"v-btn", "button", true},
+		{"button", "button", true},
+		{"MuiButton", "button", false},
+		{"MuiDialog", "dialog", false},
+		{"Modal", "dialog", false},
+		{"Dialog.Root", "dialog", true},
+	}
+
+	for _, tt := range tests {
+		if matches := registry.MatchesComponentType(tt.componentName, tt.componentType); matches != tt.shouldMatch {
+			t.Errorf("MatchesComponentType(%q, %q) = %v, want %v", tt.componentName, tt.componentType, matches, tt.shouldMatch)
+		}
+	}
+
+	calls := registry.ImperativeCalls("dialog")
+	for _, call := range calls {
+		if call == "useSnackbar" || call == "Modal.confirm" || call == "Alert.alert" {
+			t.Errorf("ImperativeCalls(dialog) should not contain %q of a disabled library", call)
+		}
+	}
+	if len(calls) == 0 {
+		t.Errorf("ImperativeCalls(dialog) should keep the calls of enabled libraries")
+	}
+}
+
+func TestAddPatterns(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+
+	if err := registry.AddPatterns("Form", "AppForm", "XForm"); err != nil {
+		t.Fatalf("AddPatterns() error = %v", err)
+	}
+	if err := registry.AddPatterns("widget", "AppWidget"); err != nil {
+		t.Fatalf("AddPatterns() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		componentName string
+		componentType string
+	}{
+		{"AppForm", "form"},
+		{"XForm", "form"},
+		{"q-form", "form"},
+		{"AppWidget", "widget"},
+	} {
+		if !registry.MatchesComponentType(tt.componentName, tt.componentType) {
+			t.Errorf("MatchesComponentType(%q, %q) = false, want true", tt.componentName, tt.componentType)
+		}
+	}
+
+	for _, componentType := range []string{"custom", " "} {
+		if err := registry.AddPatterns(componentType, "Name"); err == nil {
+			t.Errorf("AddPatterns(%q) should fail", componentType)
+		}
+	}
+}
+
+func TestSetLibraryVersions(t *testing.T) {
+	tests := []struct {
+		name          string
+		versions      map[string]int
+		componentName string
+		componentType string
+		shouldMatch   bool
+	}{
+		{"unknown version matches all", nil, "v-simple-table", "table", true},
+		{"unknown version matches all v3", nil, "v-table", "table", true},
+		{"vuetify 2 table", map[string]int{"vuetify": 2}, "v-simple-table", "table", true},
+		{"vuetify 2 without v3 table", map[string]int{"vuetify": 2}, "v-table", "table", false},
+		{"vuetify 3 table", map[string]int{"vuetify": 3}, "VTable", "table", true},
+		{"vuetify 3 without v2 table", map[string]int{"vuetify": 3}, "v-simple-table", "table", false},
+		{"unscoped patterns of any version", map[string]int{"vuetify": 3}, "v-data-table", "table", true},
+		{"mui 4 pickers", map[string]int{"mui": 4}, "KeyboardDatePicker", "date-picker", true},
+		{"mui 5 without v4 pickers", map[string]int{"mui": 5}, "KeyboardDatePicker", "date-picker", false},
+		{"chakra 3 dialog", map[string]int{"chakra": 3}, "Dialog.Root", "dialog", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewComponentMappingRegistry()
+			registry.SetLibraryVersions(tt.versions)
+			if matches := registry.MatchesComponentType(tt.componentName, tt.componentType); matches != tt.shouldMatch {
+				t.Errorf("MatchesComponentType(%q, %q) = %v, want %v", tt.componentName, tt.componentType, matches, tt.shouldMatch)
+			}
+		})
+	}
+
+	// Scoped names are listed as their library
+	if libraries := NewComponentMappingRegistry().Libraries(); slices.Contains(libraries, "vuetify@2") || !slices.Contains(libraries, "vuetify") {
+		t.Errorf("Libraries() = %v, want vuetify without version", libraries)
+	}
+}
+
+func TestRestrictLibraries(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+
+	libraries := registry.Libraries()
+	for _, library := range []string{"native", "quasar", "mui", "react-native"} {
+		if !slices.Contains(libraries, library) {
+			t.Errorf("Libraries() = %v, should contain %q", libraries, library)
+		}
+	}
+
+	registry.RestrictLibraries("quasar")
+
+	tests := []struct {
+		componentName string
+		componentType string
+		shouldMatch   bool
+	}{
+		{"q-btn", "button", true},
+		{"QBtn", "button", true},
+		{"button", "button", false},
+		{"Button", "button", false},
+		{"v-btn", "button", false},
+		{"q-dialog", "dialog", true},
+	}
+
+	for _, tt := range tests {
+		if matches := registry.MatchesComponentType(tt.componentName, tt.componentType); matches != tt.shouldMatch {
+			t.Errorf("MatchesComponentType(%q, %q) = %v, want %v", tt.componentName, tt.componentType, matches, tt.shouldMatch)
+		}
+	}
+
+	if calls := registry.ImperativeCalls("dialog"); !reflect.DeepEqual(calls, []string{"$q.dialog", "Dialog.create"}) {
+		t.Errorf("ImperativeCalls(dialog) = %v, want the quasar calls only", calls)
+	}
+}
+
+func TestDefineGroup(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+
+	if err := registry.DefineGroup("Interactive", "button", "Menu", "tabs", "button"); err != nil {
+		t.Fatalf("DefineGroup() error = %v", err)
+	}
+	members, ok := registry.GroupMembers("interactive")
+	if !ok || !slices.Equal(members, []string{"button", "menu", "tabs"}) {
+		t.Errorf("GroupMembers(interactive) = %v, %v, want [button menu tabs], true", members, ok)
+	}
+	if _, ok := registry.GroupMembers("button"); ok {
+		t.Errorf("GroupMembers(button) should not report a component type as a group")
+	}
+	if groups := registry.Groups(); !slices.Equal(groups, []string{"interactive"}) {
+		t.Errorf("Groups() = %v, want [interactive]", groups)
+	}
+
+	tests := []struct {
+		name     string
+		group    string
+		members  []string
+		expected string
+	}{
+		{"type name", "button", []string{"menu"}, "already a component type"},
+		{"reserved name", "custom", []string{"menu"}, "already a component type"},
+		{"inventory name", "all", []string{"menu"}, "already a component type"},
+		{"no members", "overlays", nil, "no member types"},
+		{"unknown member", "overlays", []string{"dialog", "popover"}, "unknown member type 'popover'"},
+		{"group member", "everything", []string{"interactive"}, "unknown member type 'interactive'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := registry.DefineGroup(tt.group, tt.members...)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("DefineGroup(%q, %v) error = %v, want error containing %q", tt.group, tt.members, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMatchingLibraries(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+	registry.SetLibraryVersions(map[string]int{"vuetify": 2})
+
+	tests := []struct {
+		name          string
+		componentName string
+		componentType string
+		expected      []string
+	}{
+		{"single library", "q-btn", "button", []string{"quasar"}},
+		{"version-scoped library", "v-btn", "button", []string{"vuetify"}},
+		{"shared name", "Button", "button", []string{"antd", "chakra", "mantine", "mui", "native", "react-bootstrap", "react-native", "shadcn"}},
+		{"api call", "$q.dialog", "dialog", []string{"quasar"}},
+		{"other type", "q-btn", "form", nil},
+		{"unknown type", "q-btn", "chart", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if libraries := registry.MatchingLibraries(tt.componentName, tt.componentType); !slices.Equal(libraries, tt.expected) {
+				t.Errorf("MatchingLibraries(%q, %q) = %v, want %v", tt.componentName, tt.componentType, libraries, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetMatchMode(t *testing.T) {
+	tests := []struct {
+		mode          string
+		componentName string
+		shouldMatch   bool
+	}{
+		{MatchExact, "q-btn", true},
+		{MatchExact, "q-btn-dropdown", false},
+		{MatchPrefix, "q-btn-dropdown", true},
+		{MatchPrefix, "ButtonGroup", true},
+		{MatchPrefix, "SaveButton", false},
+		{MatchFuzzy, "SaveButton", true},
+		{MatchFuzzy, "QBtnDropdown", true},
+		{MatchFuzzy, "AppLink", false},
+		{MatchFuzzy, "AcmeIconButton", false}, // Excluded names are compared exactly
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode+"/"+tt.componentName, func(t *testing.T) {
+			registry := NewComponentMappingRegistry()
+			if err := registry.merge([]byte("types:\n  button:\n    exclude: [AcmeIconButton]\n"), "test"); err != nil {
+				t.Fatalf("merge() error = %v", err)
+			}
+			if err := registry.SetMatchMode(tt.mode); err != nil {
+				t.Fatalf("SetMatchMode(%q) error = %v", tt.mode, err)
+			}
+			if matches := registry.MatchesComponentType(tt.componentName, "button"); matches != tt.shouldMatch {
+				t.Errorf("MatchesComponentType(%q, button) in %s mode = %v, want %v", tt.componentName, tt.mode, matches, tt.shouldMatch)
+			}
+		})
+	}
+
+	registry := NewComponentMappingRegistry()
+	if registry.MatchMode() != MatchExact {
+		t.Errorf("MatchMode() = %q, want %q by default", registry.MatchMode(), MatchExact)
+	}
+	if err := registry.SetMatchMode("regex"); err == nil {
+		t.Errorf("SetMatchMode(regex): expected an error")
+	}
+}
+
+func TestMatchingPatterns(t *testing.T) {
+	registry := NewComponentMappingRegistry()
+
+	patterns := registry.MatchingPatterns("q-btn", "button")
+	if !reflect.DeepEqual(patterns, map[string]string{"quasar": "q-btn"}) {
+		t.Errorf("MatchingPatterns(q-btn, button) = %v, want map[quasar:q-btn]", patterns)
+	}
+
+	// Version-scoped libraries are keyed as listed
+	patterns = registry.MatchingPatterns("VSimpleTable", "table")
+	if !reflect.DeepEqual(patterns, map[string]string{"vuetify@2": "VSimpleTable"}) {
+		t.Errorf("MatchingPatterns(VSimpleTable, table) = %v, want map[vuetify@2:VSimpleTable]", patterns)
+	}
+
+	if err := registry.SetMatchMode(MatchPrefix); err != nil {
+		t.Fatalf("SetMatchMode() error = %v", err)
+	}
+	patterns = registry.MatchingPatterns("q-btn-dropdown", "button")
+	if !reflect.DeepEqual(patterns, map[string]string{"quasar": "q-btn"}) {
+		t.Errorf("MatchingPatterns(q-btn-dropdown, button) in prefix mode = %v, want map[quasar:q-btn]", patterns)
+	}
+
+	if patterns := registry.MatchingPatterns("q-btn", "chart"); patterns != nil {
+		t.Errorf("MatchingPatterns(q-btn, chart) = %v, want nil for an unknown type", patterns)
+	}
+}
@@ -0,0 +1,94 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// CommandPrefix marks a registry source run as a command, whose output is the registry
+const CommandPrefix = "cmd:"
+
+// fetchTimeout bounds the download of a registry URL and the run of a registry command
+const fetchTimeout = 30 * time.Second
+
+// maxSourceSize limits the size of a downloaded or generated registry (10 MiB)
+const maxSourceSize = 10 << 20
+
+// Load merges the mappings of a registry source over the registry
+// A source is a file path, an http(s) URL or a command prefixed with "cmd:"
+// (e.g. "cmd:./scripts/registry.sh --org acme") printing a registry file
+func (r *ComponentMappingRegistry) Load(source string) error {
+	switch {
+	case strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://"):
+		data, err := fetchURL(source)
+		if err != nil {
+			return err
+		}
+		return r.merge(data, source)
+	case strings.HasPrefix(source, CommandPrefix):
+		data, err := runCommand(strings.TrimPrefix(source, CommandPrefix))
+		if err != nil {
+			return err
+		}
+		return r.merge(data, source)
+	default:
+		return r.LoadFile(source)
+	}
+}
+
+// fetchURL downloads a registry file
+func fetchURL(url string) ([]byte, error) {
+	client := &http.Client{Timeout: fetchTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch registry %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch registry %s: %s", url, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch registry %s: %w", url, err)
+	}
+	if len(data) > maxSourceSize {
+		return nil, fmt.Errorf("failed to fetch registry %s: larger than %d bytes", url, maxSourceSize)
+	}
+	return data, nil
+}
+
+// runCommand runs a registry command and returns its standard output
+// The command line is split on whitespace and run without a shell
+func runCommand(command string) ([]byte, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, fmt.Errorf("invalid registry command: empty command")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("failed to run registry command '%s': %w: %s", command, err, message)
+		}
+		return nil, fmt.Errorf("failed to run registry command '%s': %w", command, err)
+	}
+	if stdout.Len() > maxSourceSize {
+		return nil, fmt.Errorf("registry command '%s' printed more than %d bytes", command, maxSourceSize)
+	}
+	return stdout.Bytes(), nil
+}
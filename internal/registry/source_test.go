@@ -0,0 +1,107 @@
+package registry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	orgRegistry := `types:
+  button:
+    patterns:
+      acme: [AcmeButton]
+deprecated:
+  OldButton: AcmeButton
+`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/registry.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(orgRegistry))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "registry.yaml")
+	if err := os.WriteFile(path, []byte(orgRegistry), 0644); err != nil {
+		t.Fatalf("Failed to create registry file: %v", err)
+	}
+
+	sources := []struct {
+		name   string
+		source string
+	}{
+		{"file", path},
+		{"url", server.URL + "/registry.yaml"},
+		{"command", CommandPrefix + "cat " + path},
+	}
+
+	for _, tt := range sources {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewComponentMappingRegistry()
+			if err := registry.Load(tt.source); err != nil {
+				t.Fatalf("Load(%q) error = %v", tt.source, err)
+			}
+			if !registry.MatchesComponentType("AcmeButton", "button") {
+				t.Errorf("Expected AcmeButton to be a button")
+			}
+			if replacement, _ := registry.Replacement("OldButton"); replacement != "AcmeButton" {
+				t.Errorf("Replacement(OldButton) = %q, want AcmeButton", replacement)
+			}
+		})
+	}
+}
+
+func TestLoad_Precedence(t *testing.T) {
+	dir := t.TempDir()
+	org := filepath.Join(dir, "org.yaml")
+	repo := filepath.Join(dir, "repo.yaml")
+	if err := os.WriteFile(org, []byte("types:\n  button:\n    patterns:\n      acme: [AcmeButton, AcmeLegacyButton]\n"), 0644); err != nil {
+		t.Fatalf("Failed to create registry file: %v", err)
+	}
+	if err := os.WriteFile(repo, []byte("types:\n  button:\n    patterns:\n      acme: [AcmeButton]\n"), 0644); err != nil {
+		t.Fatalf("Failed to create registry file: %v", err)
+	}
+
+	// Later sources replace the names of a library given by earlier ones
+	registry := NewComponentMappingRegistry()
+	for _, source := range []string{org, repo} {
+		if err := registry.Load(source); err != nil {
+			t.Fatalf("Load(%q) error = %v", source, err)
+		}
+	}
+	if registry.MatchesComponentType("AcmeLegacyButton", "button") || !registry.MatchesComponentType("AcmeButton", "button") {
+		t.Errorf("Expected the acme names of the later registry to replace the earlier ones")
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{"missing file", filepath.Join(t.TempDir(), "missing.yaml"), "failed to read registry file"},
+		{"url not found", server.URL + "/registry.yaml", "404 Not Found"},
+		{"empty command", CommandPrefix + "  ", "empty command"},
+		{"failing command", CommandPrefix + "cat " + filepath.Join(t.TempDir(), "missing.yaml"), "failed to run registry command"},
+		{"invalid command output", CommandPrefix + "echo types: [", "failed to parse registry file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewComponentMappingRegistry().Load(tt.source)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Load(%q) error = %v, want error containing %q", tt.source, err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// AlpineParser inventories Alpine.js widgets in server-rendered HTML
+// Named x-data components (x-data="dropdown()") and x-component directives are
+// reported as directive usage, Alpine.data('dropdown', ...) registrations in
+// scripts as definitions
+type AlpineParser struct{}
+
+// NewAlpineParser creates a new AlpineParser instance
+func NewAlpineParser() *AlpineParser {
+	return &AlpineParser{}
+}
+
+var (
+	// alpineDataRegex matches x-data directives referencing a named component,
+	// optionally called with arguments (inline object literals are anonymous)
+	alpineDataRegex = regexp.MustCompile(`\bx-data\s*=\s*["']\s*([A-Za-z_$][\w$]*)\s*(?:\(|["'])`)
+
+	// alpineComponentRegex matches x-component directives (x-component="name" or x-component:name)
+	alpineComponentRegex = regexp.MustCompile(`\bx-component(?::([A-Za-z][\w-]*)|\s*=\s*["']([A-Za-z][\w-]*)["'])`)
+
+	// alpineRegistrationRegex matches Alpine.data('name', ...) registrations
+	alpineRegistrationRegex = regexp.MustCompile(`\bAlpine\s*\.\s*data\(\s*['"]([A-Za-z_$][\w$]*)['"]`)
+)
+
+// SupportsFile checks if the file is an HTML, Blade or script file
+func (p *AlpineParser) SupportsFile(filePath string) bool {
+	lowerPath := strings.ToLower(filePath)
+	return strings.HasSuffix(lowerPath, ".html") || strings.HasSuffix(lowerPath, ".htm") ||
+		strings.HasSuffix(lowerPath, ".blade.php") || isPlainScriptFile(lowerPath)
+}
+
+// Parse extracts Alpine component usage and registrations
+func (p *AlpineParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	if isPlainScriptFile(strings.ToLower(filePath)) {
+		matches := collectTagMatches(fileContent, filePath, 1, nil, alpineRegistrationRegex)
+		for i := range matches {
+			matches[i].UsageKind = types.UsageKindDefinition
+		}
+		return matches, nil
+	}
+
+	var matches []types.ComponentMatch
+	for lineIdx, line := range strings.Split(fileContent, "\n") {
+		var names []string
+		for _, match := range alpineDataRegex.FindAllStringSubmatch(line, -1) {
+			names = append(names, match[1])
+		}
+		for _, match := range alpineComponentRegex.FindAllStringSubmatch(line, -1) {
+			names = append(names, match[1]+match[2])
+		}
+
+		for _, name := range names {
+			matches = append(matches, types.ComponentMatch{
+				FilePath:      filePath,
+				Line:          lineIdx + 1,
+				ComponentName: name,
+				ComponentType: "", // Will be set by scanner based on registry
+				UsageKind:     types.UsageKindDirective,
+			})
+		}
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,94 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestAlpineParser_SupportsFile(t *testing.T) {
+	parser := NewAlpineParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"html file", "public/index.html", true},
+		{"htm file", "public/index.htm", true},
+		{"blade file", "resources/views/nav.blade.php", true},
+		{"script file", "resources/js/app.js", true},
+		{"vue file", "src/App.vue", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAlpineParser_Parse(t *testing.T) {
+	parser := NewAlpineParser()
+
+	t.Run("html usage", func(t *testing.T) {
+		content := `<nav x-data="dropdown()">
+  <div x-data="{ open: false }"></div>
+  <div x-data='tabs({ active: 1 })' x-component="tab-panel"></div>
+  <section x-data="modal"></section>
+  <div x-component:date-picker></div>
+</nav>`
+
+		matches, err := parser.Parse(content, "index.html")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+
+		expected := []struct {
+			name string
+			line int
+		}{
+			{"dropdown", 1},
+			{"tabs", 3},
+			{"tab-panel", 3},
+			{"modal", 4},
+			{"date-picker", 5},
+		}
+
+		if len(matches) != len(expected) {
+			t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+		}
+
+		for i, exp := range expected {
+			if matches[i].ComponentName != exp.name || matches[i].Line != exp.line {
+				t.Errorf("Match %d: got %s on line %d, want %s on line %d",
+					i, matches[i].ComponentName, matches[i].Line, exp.name, exp.line)
+			}
+			if matches[i].UsageKind != types.UsageKindDirective {
+				t.Errorf("Match %d: got usage kind %q, want %q", i, matches[i].UsageKind, types.UsageKindDirective)
+			}
+		}
+	})
+
+	t.Run("script registrations", func(t *testing.T) {
+		content := `import Alpine from 'alpinejs';
+
+Alpine.data('dropdown', () => ({ open: false }));
+Alpine.start();`
+
+		matches, err := parser.Parse(content, "app.js")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+
+		if len(matches) != 1 || matches[0].ComponentName != "dropdown" || matches[0].Line != 3 {
+			t.Fatalf("Expected dropdown registration on line 3, got %+v", matches)
+		}
+		if matches[0].UsageKind != types.UsageKindDefinition {
+			t.Errorf("Got usage kind %q, want %q", matches[0].UsageKind, types.UsageKindDefinition)
+		}
+	})
+}
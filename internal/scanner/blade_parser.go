@@ -0,0 +1,69 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// BladeParser parses Laravel Blade templates (.blade.php files)
+// Detects anonymous/class components (<x-button>), Livewire components
+// (<livewire:form> and @livewire('form')) and embedded Vue/custom element tags
+type BladeParser struct {
+	htmlTags htmlTagSet // Tags skipped as plain HTML, the built-in tags when nil
+}
+
+// NewBladeParser creates a new BladeParser instance
+func NewBladeParser() *BladeParser {
+	return &BladeParser{}
+}
+
+// setHTMLTags sets the tags skipped as plain HTML
+func (p *BladeParser) setHTMLTags(tags htmlTagSet) {
+	p.htmlTags = tags
+}
+
+var (
+	// bladeTagRegex matches tags, allowing the dotted and colon-separated names
+	// used by Blade (<x-forms.input>) and Livewire (<livewire:user.profile>)
+	bladeTagRegex = regexp.MustCompile(`<([A-Za-z][A-Za-z0-9-]*(?:[.:][A-Za-z0-9_-]+)*)(?:[\s>/]|$)`)
+
+	// livewireDirectiveRegex matches the @livewire('name') directive
+	livewireDirectiveRegex = regexp.MustCompile(`@livewire\(\s*['"]([A-Za-z0-9_.-]+)['"]`)
+)
+
+// SupportsFile checks if the file is a .blade.php file
+func (p *BladeParser) SupportsFile(filePath string) bool {
+	return strings.HasSuffix(strings.ToLower(filePath), ".blade.php")
+}
+
+// Parse extracts component matches from Blade template content
+// Blade comments, echo statements and @php blocks are ignored
+func (p *BladeParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	content := maskRegions(fileContent, "{{--", "--}}")
+	content = maskRegions(content, "{!!", "!!}")
+	content = maskRegions(content, "{{", "}}")
+	content = maskRegions(content, "@php", "@endphp")
+
+	matches := collectTagMatches(content, filePath, 1, p.isNonComponent, bladeTagRegex)
+
+	// @livewire('name') directives are reported with the tag syntax name
+	for lineIdx, line := range strings.Split(content, "\n") {
+		for _, match := range livewireDirectiveRegex.FindAllStringSubmatch(line, -1) {
+			matches = append(matches, types.ComponentMatch{
+				FilePath:      filePath,
+				Line:          lineIdx + 1,
+				ComponentName: "livewire:" + match[1],
+				ComponentType: "", // Will be set by scanner based on registry
+			})
+		}
+	}
+
+	return matches, nil
+}
+
+// isNonComponent reports whether a tag is plain HTML or a Blade slot
+func (p *BladeParser) isNonComponent(tagName string) bool {
+	return p.htmlTags.contains(tagName) || tagName == "x-slot" || strings.HasPrefix(tagName, "x-slot:")
+}
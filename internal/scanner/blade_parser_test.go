@@ -0,0 +1,82 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestBladeParser_SupportsFile(t *testing.T) {
+	parser := NewBladeParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"blade file", "resources/views/welcome.blade.php", true},
+		{"uppercase blade file", "resources/views/Welcome.BLADE.PHP", true},
+		{"plain php file", "app/Http/Controllers/HomeController.php", false},
+		{"vue file", "resources/js/App.vue", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBladeParser_Parse(t *testing.T) {
+	parser := NewBladeParser()
+
+	content := `@extends('layouts.app')
+
+@section('content')
+  {{-- <x-old-button /> --}}
+  <x-card>
+    <x-slot:title>Profile</x-slot>
+    <x-forms.input name="email" :value="old('email')" />
+    <x-button type="submit">{{ __('<x-ignored>') }}</x-button>
+  </x-card>
+  <livewire:user.profile :user="$user" />
+  @livewire('search-form')
+  <div id="app">
+    <q-btn label="Vue button"></q-btn>
+  </div>
+  @php
+    $html = '<x-not-a-component>';
+  @endphp
+@endsection`
+
+	matches, err := parser.Parse(content, "profile.blade.php")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"x-card", 5},
+		{"x-forms.input", 7},
+		{"x-button", 8},
+		{"livewire:user.profile", 10},
+		{"q-btn", 13},
+		{"livewire:search-form", 11},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, exp.name)
+		}
+		if matches[i].Line != exp.line {
+			t.Errorf("Match %d (%s): got line %d, want %d", i, exp.name, matches[i].Line, exp.line)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// CustomElementParser detects Web Component definitions in .js and .ts files
+// Both customElements.define('my-widget', ...) calls and Lit's
+// @customElement('my-widget') decorator are reported as component declarations
+type CustomElementParser struct{}
+
+// NewCustomElementParser creates a new CustomElementParser instance
+func NewCustomElementParser() *CustomElementParser {
+	return &CustomElementParser{}
+}
+
+var (
+	// customElementsDefineRegex matches customElements.define calls with a literal tag name
+	customElementsDefineRegex = regexp.MustCompile(`\bcustomElements\s*\.\s*define\(\s*['"` + "`" + `]([a-z][a-z0-9]*(?:-[a-z0-9]+)+)['"` + "`" + `]`)
+
+	// customElementDecoratorRegex matches Lit's @customElement decorator
+	customElementDecoratorRegex = regexp.MustCompile(`@customElement\(\s*['"]([a-z][a-z0-9]*(?:-[a-z0-9]+)+)['"]`)
+)
+
+// SupportsFile checks if the file is a .js or .ts file
+func (p *CustomElementParser) SupportsFile(filePath string) bool {
+	return isPlainScriptFile(strings.ToLower(filePath))
+}
+
+// Parse extracts custom element definitions from script content
+func (p *CustomElementParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	if !strings.Contains(fileContent, "ustomElement") {
+		return nil, nil
+	}
+
+	matches := collectTagMatches(fileContent, filePath, 1, nil, customElementsDefineRegex, customElementDecoratorRegex)
+	for i := range matches {
+		matches[i].UsageKind = types.UsageKindDefinition
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,68 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestCustomElementParser_Parse(t *testing.T) {
+	parser := NewCustomElementParser()
+
+	tests := []struct {
+		name          string
+		content       string
+		expectedNames []string
+		expectedLines []int
+	}{
+		{
+			name: "customElements.define",
+			content: `class MyWidget extends HTMLElement {}
+
+customElements.define('my-widget', MyWidget);
+window.customElements.define("user-card", class extends HTMLElement {});`,
+			expectedNames: []string{"my-widget", "user-card"},
+			expectedLines: []int{3, 4},
+		},
+		{
+			name: "lit decorator",
+			content: `import { LitElement } from 'lit';
+import { customElement } from 'lit/decorators.js';
+
+@customElement('todo-list')
+export class TodoList extends LitElement {}`,
+			expectedNames: []string{"todo-list"},
+			expectedLines: []int{4},
+		},
+		{
+			name:          "dynamic tag names are ignored",
+			content:       `customElements.define(tagName, MyWidget);`,
+			expectedNames: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, "widgets.ts")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(matches) != len(tt.expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(tt.expectedNames), matches)
+			}
+
+			for i, expectedName := range tt.expectedNames {
+				if matches[i].ComponentName != expectedName {
+					t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+				}
+				if matches[i].Line != tt.expectedLines[i] {
+					t.Errorf("Match %d: got line %d, want %d", i, matches[i].Line, tt.expectedLines[i])
+				}
+				if matches[i].UsageKind != types.UsageKindDefinition {
+					t.Errorf("Match %d: got usage kind %q, want %q", i, matches[i].UsageKind, types.UsageKindDefinition)
+				}
+			}
+		})
+	}
+}
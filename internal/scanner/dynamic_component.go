@@ -0,0 +1,81 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+var (
+	// dynamicComponentRegex matches a Vue <component> tag, capturing its attributes
+	// Quoted attribute values may contain ">" (e.g. :is="a > b ? X : Y")
+	dynamicComponentRegex = regexp.MustCompile(`<component((?:\s+[^\s=>/]+(?:\s*=\s*(?:"[^"]*"|'[^']*'|[^\s"'>]+))?)*)\s*/?>`)
+
+	// identifierRegex matches a plain JavaScript identifier
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_$][\w$]*$`)
+
+	// quotedStringRegex matches a single or double quoted string literal, capturing its value
+	quotedStringRegex = regexp.MustCompile(`^(?:'([^']*)'|"([^"]*)")$`)
+)
+
+// dynamicComponentName is the name reported for <component :is> usage that cannot be resolved
+const dynamicComponentName = "component"
+
+// parseDynamicComponents extracts Vue dynamic component usage (<component :is="...">)
+// The bound expression is recorded; static strings (is="q-btn", :is="'q-btn'") and
+// identifiers imported in the script blocks are resolved to the component name.
+func parseDynamicComponents(templateContent string, imports []importBinding, filePath string, baseLineNumber int) []types.ComponentMatch {
+	if !strings.Contains(templateContent, "<component") {
+		return nil
+	}
+
+	var matches []types.ComponentMatch
+	for _, loc := range dynamicComponentRegex.FindAllStringSubmatchIndex(templateContent, -1) {
+		attrs := parseSFCAttrs(templateContent[loc[2]:loc[3]])
+
+		name := dynamicComponentName
+		expression, bound := attrs[":is"]
+		if !bound {
+			expression, bound = attrs["v-bind:is"]
+		}
+
+		switch {
+		case bound:
+			expression = strings.TrimSpace(expression)
+			if resolved := resolveDynamicExpression(expression, imports); resolved != "" {
+				name = resolved
+			}
+		case attrs["is"] != "":
+			// Static is attribute; "vue:" prefixes target native elements in Vue 3
+			expression = attrs["is"]
+			name = strings.TrimPrefix(expression, "vue:")
+		default:
+			continue
+		}
+
+		matches = append(matches, types.ComponentMatch{
+			FilePath:      filePath,
+			Line:          baseLineNumber + strings.Count(templateContent[:loc[0]], "\n"),
+			ComponentName: name,
+			ComponentType: "", // Will be set by scanner based on registry
+			UsageKind:     types.UsageKindDynamic,
+			Expression:    expression,
+		})
+	}
+
+	return matches
+}
+
+// resolveDynamicExpression resolves a bound :is expression to a component name, or ""
+func resolveDynamicExpression(expression string, imports []importBinding) string {
+	if match := quotedStringRegex.FindStringSubmatch(expression); match != nil {
+		return match[1] + match[2]
+	}
+	if identifierRegex.MatchString(expression) {
+		if _, ok := findImport(imports, expression); ok {
+			return expression
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+package scanner
+
+import (
+	"bytes"
+	"os"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Byte order marks of the supported Unicode encodings
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+)
+
+// readSourceFile reads a source file and returns its content as UTF-8
+func readSourceFile(filePath string) (string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	return decodeSource(data), nil
+}
+
+// decodeSource converts file content to UTF-8 text
+// A UTF-8 byte order mark is stripped and UTF-16 content (with a BOM, or detected by
+// its NUL bytes) is decoded. Content that is not valid UTF-8 is treated as Latin-1
+// (ISO-8859-1), the usual encoding of legacy files, so every byte stays one character
+// and line numbers are preserved.
+func decodeSource(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, bomUTF8):
+		data = data[len(bomUTF8):]
+	case bytes.HasPrefix(data, bomUTF16LE):
+		return decodeUTF16(data[len(bomUTF16LE):], false)
+	case bytes.HasPrefix(data, bomUTF16BE):
+		return decodeUTF16(data[len(bomUTF16BE):], true)
+	default:
+		if bigEndian, ok := detectUTF16(data); ok {
+			return decodeUTF16(data, bigEndian)
+		}
+	}
+
+	if utf8.Valid(data) {
+		return string(data)
+	}
+	return decodeLatin1(data)
+}
+
+// detectUTF16 checks if BOM-less content looks like UTF-16 text
+// ASCII-heavy source code in UTF-16 has a NUL byte in every other position
+// Returns whether the content is big endian and whether it is UTF-16 at all
+func detectUTF16(data []byte) (bool, bool) {
+	sample := data
+	if len(sample) > 512 {
+		sample = sample[:512]
+	}
+	if len(sample) < 4 {
+		return false, false
+	}
+
+	var evenNUL, oddNUL int
+	for i, b := range sample {
+		if b != 0 {
+			continue
+		}
+		if i%2 == 0 {
+			evenNUL++
+		} else {
+			oddNUL++
+		}
+	}
+
+	half := len(sample) / 2
+	switch {
+	case oddNUL > half*3/4 && evenNUL == 0:
+		return false, true
+	case evenNUL > half*3/4 && oddNUL == 0:
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// decodeUTF16 decodes UTF-16 content in the given byte order
+func decodeUTF16(data []byte, bigEndian bool) string {
+	units := make([]uint16, len(data)/2)
+	for i := range units {
+		if bigEndian {
+			units[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+		} else {
+			units[i] = uint16(data[2*i+1])<<8 | uint16(data[2*i])
+		}
+	}
+	return string(utf16.Decode(units))
+}
+
+// decodeLatin1 decodes ISO-8859-1 content, where every byte is the code point of its character
+func decodeLatin1(data []byte) string {
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
@@ -0,0 +1,78 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"unicode/utf16"
+
+	"ui-elf/internal/registry"
+)
+
+// encodeUTF16 encodes text as UTF-16 in the given byte order, optionally with a BOM
+func encodeUTF16(text string, bigEndian bool, bom bool) []byte {
+	var data []byte
+	if bom {
+		if bigEndian {
+			data = append(data, bomUTF16BE...)
+		} else {
+			data = append(data, bomUTF16LE...)
+		}
+	}
+	for _, unit := range utf16.Encode([]rune(text)) {
+		if bigEndian {
+			data = append(data, byte(unit>>8), byte(unit))
+		} else {
+			data = append(data, byte(unit), byte(unit>>8))
+		}
+	}
+	return data
+}
+
+func TestDecodeSource(t *testing.T) {
+	text := "<template>\n  <q-btn label=\"Größe\" />\n</template>\n"
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"plain UTF-8", []byte(text)},
+		{"UTF-8 with BOM", append(append([]byte{}, bomUTF8...), text...)},
+		{"UTF-16 LE with BOM", encodeUTF16(text, false, true)},
+		{"UTF-16 BE with BOM", encodeUTF16(text, true, true)},
+		{"UTF-16 LE without BOM", encodeUTF16(text, false, false)},
+		{"UTF-16 BE without BOM", encodeUTF16(text, true, false)},
+		{"Latin-1", []byte("<template>\n  <q-btn label=\"Gr\xf6\xdfe\" />\n</template>\n")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := decodeSource(tt.data); result != text {
+				t.Errorf("decodeSource() = %q, want %q", result, text)
+			}
+		})
+	}
+}
+
+func TestComponentScanner_Scan_UTF16File(t *testing.T) {
+	tempDir := t.TempDir()
+
+	vueFile := filepath.Join(tempDir, "Legacy.vue")
+	content := "<template>\r\n  <div>\r\n    <q-btn label=\"Save\" />\r\n  </div>\r\n</template>\r\n"
+	if err := os.WriteFile(vueFile, encodeUTF16(content, false, true), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewVueParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{vueFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 1 {
+		t.Fatalf("Expected 1 match, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	if match := result.Matches[0]; match.ComponentName != "q-btn" || match.Line != 3 {
+		t.Errorf("Expected q-btn on line 3, got %s:%d", match.ComponentName, match.Line)
+	}
+}
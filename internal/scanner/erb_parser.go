@@ -0,0 +1,68 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// ErbParser parses Rails ERB view templates (.html.erb files)
+// Detects ViewComponent renders (render(ButtonComponent.new)) and Vue/custom
+// element tags embedded in the markup, while ignoring <% %> Ruby blocks
+type ErbParser struct {
+	htmlTags htmlTagSet // Tags skipped as plain HTML, the built-in tags when nil
+}
+
+// NewErbParser creates a new ErbParser instance
+func NewErbParser() *ErbParser {
+	return &ErbParser{}
+}
+
+// setHTMLTags sets the tags skipped as plain HTML
+func (p *ErbParser) setHTMLTags(tags htmlTagSet) {
+	p.htmlTags = tags
+}
+
+var (
+	// erbTagRegex matches an ERB tag (<% %>, <%= %>, <%- -%>, <%# %>)
+	erbTagRegex = regexp.MustCompile(`(?s)<%.*?%>`)
+
+	// viewComponentRegex matches a ViewComponent being rendered, including
+	// namespaced components (e.g. render(Primer::ButtonComponent.new(...)))
+	viewComponentRegex = regexp.MustCompile(`\brender\(?\s*([A-Z][A-Za-z0-9]*(?:::[A-Z][A-Za-z0-9]*)*Component)\.(?:new|with_collection)\b`)
+)
+
+// SupportsFile checks if the file is a .html.erb file
+func (p *ErbParser) SupportsFile(filePath string) bool {
+	return strings.HasSuffix(strings.ToLower(filePath), ".html.erb")
+}
+
+// Parse extracts component matches from ERB template content
+func (p *ErbParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	var matches []types.ComponentMatch
+
+	buf := []byte(fileContent)
+	for _, loc := range erbTagRegex.FindAllStringIndex(fileContent, -1) {
+		rubyCode := fileContent[loc[0]:loc[1]]
+		line := strings.Count(fileContent[:loc[0]], "\n") + 1
+
+		// Comments (<%# %>) never render components
+		if !strings.HasPrefix(rubyCode, "<%#") {
+			for _, match := range viewComponentRegex.FindAllStringSubmatchIndex(rubyCode, -1) {
+				matches = append(matches, types.ComponentMatch{
+					FilePath:      filePath,
+					Line:          line + strings.Count(rubyCode[:match[2]], "\n"),
+					ComponentName: rubyCode[match[2]:match[3]],
+					ComponentType: "", // Will be set by scanner based on registry
+				})
+			}
+		}
+
+		// Mask the Ruby block so it isn't scanned as markup
+		blank(buf, loc[0], loc[1])
+	}
+
+	matches = append(matches, parseTemplateComponents(string(buf), filePath, 1, p.htmlTags)...)
+	return matches, nil
+}
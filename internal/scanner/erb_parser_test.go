@@ -0,0 +1,74 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestErbParser_SupportsFile(t *testing.T) {
+	parser := NewErbParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"html erb file", "app/views/users/show.html.erb", true},
+		{"uppercase extension", "app/views/users/Show.HTML.ERB", true},
+		{"plain ruby file", "app/models/user.rb", false},
+		{"text erb file", "app/views/mailer/welcome.text.erb", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestErbParser_Parse(t *testing.T) {
+	parser := NewErbParser()
+
+	content := `<h1><%= @user.name %></h1>
+<%= render(ButtonComponent.new(label: "Save")) %>
+<%= render Primer::Beta::DialogComponent.new(title: "Confirm") do |dialog| %>
+  <% dialog.with_body do %>Are you sure?<% end %>
+<% end %>
+<%# render(OldComponent.new) %>
+<% if @user.admin? && count < 3 %>
+  <admin-panel :user-id="<%= @user.id %>"></admin-panel>
+<% end %>
+<div id="app">
+  <q-btn label="Vue button" />
+</div>`
+
+	matches, err := parser.Parse(content, "show.html.erb")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"ButtonComponent", 2},
+		{"Primer::Beta::DialogComponent", 3},
+		{"admin-panel", 8},
+		{"q-btn", 11},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, exp.name)
+		}
+		if matches[i].Line != exp.line {
+			t.Errorf("Match %d (%s): got line %d, want %d", i, exp.name, matches[i].Line, exp.line)
+		}
+	}
+}
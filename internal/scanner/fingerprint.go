@@ -0,0 +1,38 @@
+package scanner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// fingerprintLength is the number of hex characters of a fingerprint
+const fingerprintLength = 16
+
+// attachFingerprints sets a deterministic Fingerprint on every match
+// The fingerprint hashes the file path relative to its scan root, the component, its usage
+// kind and the line of the match with indentation removed, so it survives line-number shifts,
+// reformatting, edits of the surrounding code and the path style of the results.
+// Identical usages within a file are told apart by their order.
+func attachFingerprints(matches []types.ComponentMatch, content string, rootPath string) {
+	lines := strings.Split(content, "\n")
+	seen := make(map[string]int)
+
+	for i := range matches {
+		var line string
+		if n := matches[i].Line; n >= 1 && n <= len(lines) {
+			line = strings.TrimSpace(lines[n-1])
+		}
+		key := fmt.Sprintf("%s\x00%s\x00%s\x00%s", rootPath, matches[i].ComponentName, matches[i].UsageKind, line)
+
+		// Number identical usages in the same file (e.g. two <Button /> lines)
+		ordinal := seen[key]
+		seen[key]++
+
+		sum := sha256.Sum256(fmt.Appendf(nil, "%s\x00%d", key, ordinal))
+		matches[i].Fingerprint = hex.EncodeToString(sum[:])[:fingerprintLength]
+	}
+}
@@ -0,0 +1,72 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestAttachFingerprints(t *testing.T) {
+	content := `<template>
+  <q-form>
+    <q-btn label="Save" />
+  </q-form>
+</template>`
+	shifted := `<!-- Settings form -->
+
+<template>
+    <q-form>
+        <q-btn label="Save" />
+    </q-form>
+</template>`
+	edited := `<template>
+  <q-form>
+    <q-btn label="Submit" />
+  </q-form>
+</template>`
+	neighbourEdited := `<template>
+  <q-form class="settings" @submit="save">
+    <q-btn label="Save" />
+  </q-form>
+</template>`
+
+	fingerprint := func(content string, path string, line int) string {
+		matches := []types.ComponentMatch{{ComponentName: "q-btn", Line: line}}
+		attachFingerprints(matches, content, path)
+		return matches[0].Fingerprint
+	}
+
+	original := fingerprint(content, "src/Form.vue", 3)
+	if len(original) != fingerprintLength {
+		t.Fatalf("Fingerprint %q has length %d, want %d", original, len(original), fingerprintLength)
+	}
+	if result := fingerprint(content, "src/Form.vue", 3); result != original {
+		t.Errorf("Fingerprint is not deterministic: %q != %q", result, original)
+	}
+	if result := fingerprint(shifted, "src/Form.vue", 5); result != original {
+		t.Errorf("Fingerprint should survive line shifts and reindentation: %q != %q", result, original)
+	}
+	if result := fingerprint(neighbourEdited, "src/Form.vue", 3); result != original {
+		t.Errorf("Fingerprint should survive edits of the neighbouring lines: %q != %q", result, original)
+	}
+	if result := fingerprint(edited, "src/Form.vue", 3); result == original {
+		t.Errorf("Fingerprint should change when the usage changes")
+	}
+	if result := fingerprint(content, "src/Other.vue", 3); result == original {
+		t.Errorf("Fingerprint should change with the file path")
+	}
+}
+
+func TestAttachFingerprints_IdenticalUsages(t *testing.T) {
+	content := "<p />\n<QBtn />\n<p />\n<QBtn />\n<p />"
+	matches := []types.ComponentMatch{
+		{ComponentName: "QBtn", Line: 2},
+		{ComponentName: "QBtn", Line: 4},
+	}
+
+	attachFingerprints(matches, content, "src/Toolbar.vue")
+
+	if matches[0].Fingerprint == "" || matches[0].Fingerprint == matches[1].Fingerprint {
+		t.Errorf("Identical usages should have distinct fingerprints, got %q and %q", matches[0].Fingerprint, matches[1].Fingerprint)
+	}
+}
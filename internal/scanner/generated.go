@@ -0,0 +1,65 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+)
+
+// GeneratedFilePatterns are the file name patterns of minified and generated files
+var GeneratedFilePatterns = []string{".min.", ".generated."}
+
+const (
+	// minifiedLineLength is the line length from which a file is considered minified,
+	// if its lines are long on average as well
+	minifiedLineLength = 1000
+	// minifiedAverageLineLength is the average line length of minified files
+	minifiedAverageLineLength = 200
+	// generatedHeaderLength is the length of the file header searched for generated-code banners
+	generatedHeaderLength = 1024
+)
+
+var (
+	// generatedBannerRegex matches the banners of generated files in the file header
+	generatedBannerRegex = regexp.MustCompile(`(?i)@generated\b|code generated .* do not edit|\b(?:file|code) (?:is|was|has been) (?:auto-?|automatically )generated|<auto-generated|^\s*/\*\s*eslint-disable\s*\*/`)
+
+	// sourceMapCommentRegex matches the source map comment of bundled output
+	sourceMapCommentRegex = regexp.MustCompile(`(?m)^\s*//[#@]\s*sourceMappingURL=`)
+)
+
+// IsGeneratedContent checks if file content looks minified, bundled or generated
+// Minified files have very long lines, bundles reference their source map and
+// generated files announce themselves in a header banner (@generated, DO NOT EDIT,
+// a file-wide /* eslint-disable */)
+func IsGeneratedContent(content string) bool {
+	header := content
+	if len(header) > generatedHeaderLength {
+		header = header[:generatedHeaderLength]
+	}
+	if generatedBannerRegex.MatchString(header) {
+		return true
+	}
+
+	if strings.Contains(content, "sourceMappingURL=") && sourceMapCommentRegex.MatchString(content) {
+		return true
+	}
+
+	return isMinified(content)
+}
+
+// isMinified checks if content has very long lines and a long average line length
+func isMinified(content string) bool {
+	if len(content) < minifiedLineLength {
+		return false
+	}
+
+	lines := strings.Count(content, "\n") + 1
+	if len(content)/lines < minifiedAverageLineLength {
+		return false
+	}
+	for _, line := range strings.Split(content, "\n") {
+		if len(line) >= minifiedLineLength {
+			return true
+		}
+	}
+	return false
+}
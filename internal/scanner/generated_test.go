@@ -0,0 +1,72 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ui-elf/internal/registry"
+	"ui-elf/internal/types"
+)
+
+func TestIsGeneratedContent(t *testing.T) {
+	minified := "!function(){" + strings.Repeat(`var a=React.createElement(Button,{onClick:b});`, 40) + "}();"
+
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"regular component", "import { Button } from '@mui/material';\n\nexport const Save = () => <Button>Save</Button>;\n", false},
+		{"minified bundle", minified, true},
+		{"long line in a regular file", "const Icon = () => (\n  <svg><path d=\"" + strings.Repeat("M0 0L1 1", 150) + "\" /></svg>\n);\n" + strings.Repeat("// padding\n", 20), false},
+		{"source map comment", "export const App = () => <Button />;\n//# sourceMappingURL=app.js.map\n", true},
+		{"generated banner", "// @generated by graphql-codegen\nexport const Query = {};\n", true},
+		{"go style banner", "// Code generated by protoc-gen-ts. DO NOT EDIT.\nexport {};\n", true},
+		{"auto-generated notice", "/**\n * This file was automatically generated by openapi-generator.\n */\n", true},
+		{"eslint-disable header", "/* eslint-disable */\nexport const routes = [];\n", true},
+		{"rule-specific eslint-disable", "/* eslint-disable no-console */\nexport const log = console.log;\n", false},
+		{"generated mentioned in code", "// Order ids are auto-generated by the API\nexport const Orders = () => <Table />;\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IsGeneratedContent(tt.content); result != tt.expected {
+				t.Errorf("IsGeneratedContent() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComponentScanner_Scan_SkipsGeneratedFiles(t *testing.T) {
+	tempDir := t.TempDir()
+
+	sourceFile := filepath.Join(tempDir, "Save.tsx")
+	if err := os.WriteFile(sourceFile, []byte("export const Save = () => <Button>Save</Button>;\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	generatedFile := filepath.Join(tempDir, "Save.compiled.jsx")
+	generatedContent := "// @generated\nexport const Save = () => <Button>Save</Button>;\n"
+	if err := os.WriteFile(generatedFile, []byte(generatedContent), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{sourceFile, generatedFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 1 || result.Matches[0].FilePath != sourceFile {
+		t.Errorf("Expected only the match in %s, got %+v", sourceFile, result.Matches)
+	}
+
+	scanner.SetOptions(types.ScanOptions{IncludeGenerated: true})
+	result, err = scanner.Scan([]string{sourceFile, generatedFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 2 {
+		t.Errorf("Expected 2 matches with IncludeGenerated, got %d", result.TotalCount)
+	}
+}
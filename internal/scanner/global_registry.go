@@ -0,0 +1,164 @@
+package scanner
+
+import (
+	"maps"
+	"regexp"
+	"strings"
+	"sync"
+
+	"ui-elf/internal/types"
+)
+
+// globalRegistrations holds Vue components registered application-wide
+// Components registered with app.component() and component libraries installed
+// as plugins with app.use() can be used in any template without an import.
+type globalRegistrations struct {
+	components map[string]string // PascalCase component name -> library (import source, may be "")
+	prefixes   map[string]string // Tag prefix of an installed plugin (e.g. "q-") -> library
+}
+
+var (
+	// globalComponentRegex matches app.component('Name', Value) and Vue.component('name', Value)
+	globalComponentRegex = regexp.MustCompile(`\b[A-Za-z_$][\w$]*\s*\.\s*component\(\s*['"]([A-Za-z][\w-]*)['"]\s*,\s*([A-Za-z_$][\w$]*)?`)
+
+	// pluginInstallRegex matches app.use(Plugin) and Vue.use(Plugin)
+	pluginInstallRegex = regexp.MustCompile(`\b[A-Za-z_$][\w$]*\s*\.\s*use\(\s*([A-Za-z_$][\w$]*)`)
+)
+
+// pluginTagPrefixes maps Vue component library packages to the tag prefix of their components
+var pluginTagPrefixes = map[string]string{
+	"quasar":         "q-",
+	"vuetify":        "v-",
+	"element-plus":   "el-",
+	"element-ui":     "el-",
+	"ant-design-vue": "a-",
+	"naive-ui":       "n-",
+	"@ionic/vue":     "ion-",
+	"bootstrap-vue":  "b-",
+}
+
+// newGlobalRegistrations creates empty global registrations
+func newGlobalRegistrations() *globalRegistrations {
+	return &globalRegistrations{components: make(map[string]string), prefixes: make(map[string]string)}
+}
+
+// collectGlobalRegistrations reads the script files among files on a pool of workers and collects
+// their global Vue registrations, merged in the order of files
+// Returns the registrations, nil if no file registers components globally, and the content of the
+// script files registering components, keyed by path, so that the scan does not read them again.
+// Other script files are not kept, and are read again by the scan.
+func collectGlobalRegistrations(files []string, workers int) (*globalRegistrations, *sync.Map) {
+	sources := &sync.Map{}
+	fileRegistrations := make([]*globalRegistrations, len(files))
+
+	indexes := make(chan int)
+	go func() {
+		for i, filePath := range files {
+			if isPlainScriptFile(strings.ToLower(filePath)) {
+				indexes <- i
+			}
+		}
+		close(indexes)
+	}()
+
+	var wg sync.WaitGroup
+	for range max(workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				content, err := readSourceFile(files[i])
+				if err != nil {
+					continue // Reported by the scan
+				}
+				if !strings.Contains(content, ".component(") && !strings.Contains(content, ".use(") {
+					continue
+				}
+				sources.Store(files[i], content)
+				fileRegistrations[i] = newGlobalRegistrations()
+				fileRegistrations[i].add(content)
+			}
+		}()
+	}
+	wg.Wait()
+
+	registrations := newGlobalRegistrations()
+	for _, file := range fileRegistrations {
+		if file != nil {
+			maps.Copy(registrations.components, file.components)
+			maps.Copy(registrations.prefixes, file.prefixes)
+		}
+	}
+	if len(registrations.components) == 0 && len(registrations.prefixes) == 0 {
+		return nil, sources
+	}
+	return registrations, sources
+}
+
+// add records the global registrations found in script content
+func (g *globalRegistrations) add(content string) {
+	imports := parseImports(content)
+
+	for _, match := range globalComponentRegex.FindAllStringSubmatch(content, -1) {
+		library := ""
+		if binding, ok := findImport(imports, match[2]); ok {
+			library = binding.source
+		}
+		g.components[kebabToPascalCase(match[1])] = library
+	}
+
+	for _, match := range pluginInstallRegex.FindAllStringSubmatch(content, -1) {
+		binding, ok := findImport(imports, match[1])
+		if !ok {
+			continue
+		}
+		if prefix, known := pluginTagPrefixes[binding.source]; known {
+			g.prefixes[prefix] = binding.source
+		}
+	}
+}
+
+// attribute marks matches of globally registered components as registered and sets their library
+// Matches already resolved through the file's own imports are left untouched
+func (g *globalRegistrations) attribute(matches []types.ComponentMatch) {
+	for i := range matches {
+		if matches[i].Registered {
+			continue
+		}
+
+		name := matches[i].ComponentName
+		if library, ok := g.components[kebabToPascalCase(name)]; ok {
+			matches[i].Registered = true
+			matches[i].Library = library
+			continue
+		}
+
+		tag := pascalToKebabCase(name)
+		for prefix, library := range g.prefixes {
+			if strings.HasPrefix(tag, prefix) {
+				matches[i].Registered = true
+				matches[i].Library = library
+				break
+			}
+		}
+	}
+}
+
+// pascalToKebabCase converts a PascalCase component name to a kebab-case tag (QBtn -> q-btn)
+func pascalToKebabCase(name string) string {
+	if strings.Contains(name, "-") {
+		return strings.ToLower(name)
+	}
+	var sb strings.Builder
+	for i, c := range name {
+		if c >= 'A' && c <= 'Z' {
+			if i > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(c + ('a' - 'A'))
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
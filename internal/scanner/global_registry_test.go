@@ -0,0 +1,149 @@
+package scanner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ui-elf/internal/registry"
+	"ui-elf/internal/types"
+)
+
+func TestCollectGlobalRegistrations(t *testing.T) {
+	tempDir := t.TempDir()
+
+	mainFile := filepath.Join(tempDir, "main.ts")
+	mainContent := `import { createApp } from 'vue'
+import { Quasar } from 'quasar'
+import BaseButton from './components/BaseButton.vue'
+import App from './App.vue'
+
+const app = createApp(App)
+app.use(Quasar, { plugins: {} })
+app.component('BaseButton', BaseButton)
+app.component('base-dialog', { template: '<div />' })
+app.mount('#app')
+`
+	if err := os.WriteFile(mainFile, []byte(mainContent), 0644); err != nil {
+		t.Fatalf("Failed to create main file: %v", err)
+	}
+	vueFile := filepath.Join(tempDir, "App.vue")
+	if err := os.WriteFile(vueFile, []byte("<template><BaseButton /></template>"), 0644); err != nil {
+		t.Fatalf("Failed to create Vue file: %v", err)
+	}
+
+	utilsFile := filepath.Join(tempDir, "utils.ts")
+	if err := os.WriteFile(utilsFile, []byte("export const sum = (a, b) => a + b\n"), 0644); err != nil {
+		t.Fatalf("Failed to create script file: %v", err)
+	}
+
+	globals, sources := collectGlobalRegistrations([]string{mainFile, vueFile, utilsFile}, 2)
+	if globals == nil {
+		t.Fatal("Expected global registrations")
+	}
+
+	// Only the files registering components are kept, their content handed to the scan
+	if source, read := sources.Load(mainFile); !read || source != mainContent {
+		t.Errorf("Content of %s = %v, want the file content", mainFile, source)
+	}
+	if _, read := sources.Load(utilsFile); read {
+		t.Errorf("Script file %s without registrations should not be kept", utilsFile)
+	}
+	if _, read := sources.Load(vueFile); read {
+		t.Errorf("Vue file %s should not be read for global registrations", vueFile)
+	}
+
+	matches := []types.ComponentMatch{
+		{ComponentName: "base-button"},
+		{ComponentName: "BaseDialog"},
+		{ComponentName: "q-btn"},
+		{ComponentName: "QDialog"},
+		{ComponentName: "Local", Registered: true, Library: "./Local.vue"},
+		{ComponentName: "v-btn"},
+	}
+	globals.attribute(matches)
+
+	expected := []struct {
+		registered bool
+		library    string
+	}{
+		{true, "./components/BaseButton.vue"},
+		{true, ""},
+		{true, "quasar"},
+		{true, "quasar"},
+		{true, "./Local.vue"},
+		{false, ""},
+	}
+
+	for i, exp := range expected {
+		if matches[i].Registered != exp.registered || matches[i].Library != exp.library {
+			t.Errorf("%s: Registered = %v, Library = %q, want %v, %q",
+				matches[i].ComponentName, matches[i].Registered, matches[i].Library, exp.registered, exp.library)
+		}
+	}
+
+	if globals, _ := collectGlobalRegistrations([]string{vueFile}, 2); globals != nil {
+		t.Error("Expected no global registrations without entry files")
+	}
+}
+
+func TestComponentScanner_parsesVueFiles(t *testing.T) {
+	files := []string{"src/main.ts", "src/App.tsx"}
+
+	react := NewComponentScanner([]ComponentParser{NewReactParser(), NewInlineTemplateParser()}, registry.NewComponentMappingRegistry())
+	if react.parsesVueFiles(append(files, "src/App.vue")) {
+		t.Error("Expected no Vue files parsed without a Vue parser")
+	}
+
+	vue := NewComponentScanner([]ComponentParser{NewVueParser(), NewReactParser()}, registry.NewComponentMappingRegistry())
+	if vue.parsesVueFiles(files) {
+		t.Error("Expected no Vue files parsed without .vue files")
+	}
+	if !vue.parsesVueFiles(append(files, "src/App.vue")) {
+		t.Error("Expected the .vue file parsed by the Vue parser")
+	}
+}
+
+func TestCollectGlobalRegistrations_FileOrder(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// The same component registered by several files: the last file in order wins, whichever
+	// worker reads it first
+	var files []string
+	for i, source := range []string{"./v1/AppCard.vue", "./v2/AppCard.vue", "./v3/AppCard.vue", "./v4/AppCard.vue"} {
+		file := filepath.Join(tempDir, fmt.Sprintf("register%d.js", i))
+		content := fmt.Sprintf("import AppCard from '%s'\napp.component('AppCard', AppCard)\n", source)
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+		files = append(files, file)
+	}
+
+	for range 10 {
+		globals, _ := collectGlobalRegistrations(files, 4)
+		if globals == nil || globals.components["AppCard"] != "./v4/AppCard.vue" {
+			t.Fatalf("Expected AppCard registered from ./v4/AppCard.vue, got %+v", globals)
+		}
+	}
+}
+
+func TestPascalToKebabCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"QBtn", "q-btn"},
+		{"BaseDialog", "base-dialog"},
+		{"q-btn", "q-btn"},
+		{"button", "button"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := pascalToKebabCase(tt.name); result != tt.expected {
+				t.Errorf("pascalToKebabCase(%q) = %q, want %q", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
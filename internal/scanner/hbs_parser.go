@@ -0,0 +1,41 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// HbsParser parses Ember Handlebars templates (.hbs files)
+// Recognizes both angle-bracket (<MyButton />) and curly ({{my-button}}) invocation
+type HbsParser struct{}
+
+// NewHbsParser creates a new HbsParser instance
+func NewHbsParser() *HbsParser {
+	return &HbsParser{}
+}
+
+var (
+	// hbsAngleBracketRegex matches angle-bracket invocation, including nested
+	// components using the :: separator (e.g. <Ui::Button>)
+	hbsAngleBracketRegex = regexp.MustCompile(`<([A-Z][A-Za-z0-9]*(?:::[A-Z][A-Za-z0-9]*)*)(?:[\s>/]|$)`)
+
+	// hbsCurlyRegex matches curly invocation of classic components, which must
+	// contain a hyphen (e.g. {{my-button}} or {{#my-dialog}})
+	hbsCurlyRegex = regexp.MustCompile(`\{\{~?#?([a-z][a-z0-9]*(?:-[a-z0-9]+)+)(?:[\s}~]|$)`)
+)
+
+// SupportsFile checks if the file is a .hbs file
+func (p *HbsParser) SupportsFile(filePath string) bool {
+	return strings.HasSuffix(strings.ToLower(filePath), ".hbs")
+}
+
+// Parse extracts component matches from Handlebars template content
+// Handlebars comments ({{!-- --}} and {{! }}) are ignored
+func (p *HbsParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	content := maskRegions(fileContent, "{{!--", "--}}")
+	content = maskRegions(content, "{{!", "}}")
+
+	return collectTagMatches(content, filePath, 1, nil, hbsAngleBracketRegex, hbsCurlyRegex), nil
+}
@@ -0,0 +1,99 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestHbsParser_SupportsFile(t *testing.T) {
+	parser := NewHbsParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"hbs file", "app/components/user-form.hbs", true},
+		{"uppercase HBS", "app/templates/Application.HBS", true},
+		{"handlebars-like js", "app/components/user-form.js", false},
+		{"vue file", "component.vue", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHbsParser_Parse(t *testing.T) {
+	parser := NewHbsParser()
+
+	tests := []struct {
+		name          string
+		content       string
+		expectedNames []string
+		expectedLines []int
+	}{
+		{
+			name: "angle bracket invocation",
+			content: `<div class="wrapper">
+  <MyButton @onClick={{this.save}} />
+  <Ui::Dialog @open={{this.isOpen}}>
+    <p>Content</p>
+  </Ui::Dialog>
+</div>`,
+			expectedNames: []string{"MyButton", "Ui::Dialog"},
+			expectedLines: []int{2, 3},
+		},
+		{
+			name: "curly invocation",
+			content: `{{my-button label="Save"}}
+{{#my-dialog open=this.isOpen}}
+  {{user-form}}
+{{/my-dialog}}`,
+			expectedNames: []string{"my-button", "my-dialog", "user-form"},
+			expectedLines: []int{1, 2, 3},
+		},
+		{
+			name: "helpers and properties are ignored",
+			content: `{{title}}
+{{#if this.isOpen}}
+  {{t "welcome"}}
+{{/if}}`,
+			expectedNames: nil,
+		},
+		{
+			name: "comments are ignored",
+			content: `{{!-- <OldButton /> --}}
+{{! {{legacy-dialog}} }}
+<NewButton />`,
+			expectedNames: []string{"NewButton"},
+			expectedLines: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, "template.hbs")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(matches) != len(tt.expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(tt.expectedNames), matches)
+			}
+
+			for i, expectedName := range tt.expectedNames {
+				if matches[i].ComponentName != expectedName {
+					t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+				}
+				if matches[i].Line != tt.expectedLines[i] {
+					t.Errorf("Match %d: got line %d, want %d", i, matches[i].Line, tt.expectedLines[i])
+				}
+			}
+		})
+	}
+}
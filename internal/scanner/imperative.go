@@ -0,0 +1,51 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// imperativeCall is a compiled registry API call creating a component programmatically
+type imperativeCall struct {
+	name  string // Call as listed in the registry (e.g. "Modal.confirm")
+	regex *regexp.Regexp
+}
+
+// compileImperativeCalls compiles the given API calls into regexes matching call expressions
+// A call must not be preceded by an identifier character or a dot (Modal.confirm, not myModal.confirm),
+// except for a "this." receiver (this.$q.dialog in the Vue Options API, this.modalService.open in Angular)
+func compileImperativeCalls(calls []string) []imperativeCall {
+	compiled := make([]imperativeCall, 0, len(calls))
+	for _, call := range calls {
+		compiled = append(compiled, imperativeCall{
+			name:  call,
+			regex: regexp.MustCompile(`(?:^|[^\w$.])(?:this\.)?` + regexp.QuoteMeta(call) + `\s*\(`),
+		})
+	}
+	return compiled
+}
+
+// findImperativeCalls reports every imperative component API call in content
+// Each call is reported at most once per line
+func findImperativeCalls(content string, filePath string, calls []imperativeCall) []types.ComponentMatch {
+	var matches []types.ComponentMatch
+
+	for lineIdx, line := range strings.Split(content, "\n") {
+		for _, call := range calls {
+			if !strings.Contains(line, call.name) || !call.regex.MatchString(line) {
+				continue
+			}
+			matches = append(matches, types.ComponentMatch{
+				FilePath:      filePath,
+				Line:          lineIdx + 1,
+				ComponentName: call.name,
+				ComponentType: "", // Will be set by scanner based on registry
+				UsageKind:     types.UsageKindImperative,
+			})
+		}
+	}
+
+	return matches
+}
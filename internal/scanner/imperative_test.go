@@ -0,0 +1,45 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestFindImperativeCalls(t *testing.T) {
+	calls := compileImperativeCalls([]string{"$q.dialog", "Modal.confirm", "useSnackbar", "$bvModal.msgBoxConfirm"})
+
+	content := `const $q = useQuasar()
+$q.dialog({ title: 'Confirm' }).onOk(save)
+Modal.confirm ({ title: 'Delete?' }); Modal.confirm({ title: 'Again?' })
+myModal.confirm({ title: 'Not antd' })
+const { enqueueSnackbar } = useSnackbar()
+const hint = 'Modal.confirm is deprecated'
+this.$bvModal.msgBoxConfirm('Delete?')
+form.$bvModal.msgBoxConfirm('Not this')`
+
+	matches := findImperativeCalls(content, "Actions.ts", calls)
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"$q.dialog", 2},
+		{"Modal.confirm", 3},
+		{"useSnackbar", 5},
+		{"$bvModal.msgBoxConfirm", 7},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("findImperativeCalls() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name || matches[i].Line != exp.line {
+			t.Errorf("Match %d: got %s at line %d, want %s at line %d",
+				i, matches[i].ComponentName, matches[i].Line, exp.name, exp.line)
+		}
+		if matches[i].UsageKind != types.UsageKindImperative {
+			t.Errorf("Match %d: UsageKind = %q, want %q", i, matches[i].UsageKind, types.UsageKindImperative)
+		}
+	}
+}
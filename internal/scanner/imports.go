@@ -0,0 +1,251 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// importBinding is a single local binding introduced by an ES import statement
+type importBinding struct {
+	local    string // Name bound in the importing module (e.g. "PrimaryBtn")
+	imported string // Exported name in the source module; "default" for default imports, "*" for namespaces
+	source   string // Module specifier (e.g. "@mui/material")
+}
+
+var (
+	// importStatementRegex matches "import <clause> from '<source>'", capturing clause and source
+	importStatementRegex = regexp.MustCompile(`(?m)^\s*import\s+(?:type\s+)?([^'";]+?)\s+from\s+['"]([^'"]+)['"]`)
+
+	// importNamespaceRegex matches a namespace import clause (* as Name)
+	importNamespaceRegex = regexp.MustCompile(`^\*\s+as\s+([A-Za-z_$][\w$]*)$`)
+)
+
+// parseImports extracts the bindings of all static import statements in script content
+func parseImports(content string) []importBinding {
+	if !strings.Contains(content, "import") {
+		return nil
+	}
+
+	var bindings []importBinding
+	for _, match := range importStatementRegex.FindAllStringSubmatch(content, -1) {
+		clause, source := strings.TrimSpace(match[1]), match[2]
+
+		// Named imports: { A, B as C }
+		if open := strings.IndexByte(clause, '{'); open >= 0 {
+			end := strings.IndexByte(clause, '}')
+			if end < open {
+				continue
+			}
+			for _, spec := range strings.Split(clause[open+1:end], ",") {
+				spec = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(spec), "type "))
+				if spec == "" {
+					continue
+				}
+				imported, local := spec, spec
+				if parts := strings.Fields(spec); len(parts) == 3 && parts[1] == "as" {
+					imported, local = parts[0], parts[2]
+				}
+				bindings = append(bindings, importBinding{local: local, imported: imported, source: source})
+			}
+			clause = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(clause[:open]), ","))
+		}
+
+		// Default and namespace imports: Name, * as Name
+		for _, spec := range strings.Split(clause, ",") {
+			spec = strings.TrimSpace(spec)
+			switch {
+			case spec == "":
+			case importNamespaceRegex.MatchString(spec):
+				local := importNamespaceRegex.FindStringSubmatch(spec)[1]
+				bindings = append(bindings, importBinding{local: local, imported: "*", source: source})
+			default:
+				bindings = append(bindings, importBinding{local: spec, imported: "default", source: source})
+			}
+		}
+	}
+
+	return bindings
+}
+
+// findImport returns the import binding for a local name
+func findImport(bindings []importBinding, local string) (importBinding, bool) {
+	for _, binding := range bindings {
+		if binding.local == local {
+			return binding, true
+		}
+	}
+	return importBinding{}, false
+}
+
+// resolveImports attaches import information to matches using the file's import table
+// Library is set to the module the component is imported from. ResolvedName is set
+// when the component is imported under another name: named imports resolve to the
+// exported name ({ Button as PrimaryBtn } -> Button), default imports of component
+// modules to the module name (import Btn from '@mui/material/Button' -> Button), and
+// namespace imports to the member (Ant.Button -> Button from import * as Ant).
+// Vue components registered under another name (components: { MyBtn: QBtn }) and
+// kebab-case template tags (<my-dialog> for MyDialog) are resolved as well.
+// Aliases of wrapped components (const FancyButton = styled(Button)) resolve to the
+// wrapped component, and through its import if it is imported under another name.
+// Lazy components (const LazyDialog = lazy(() => import('./Dialog'))) resolve to the
+// component named by their import path, which is their library.
+// Components that are imported or registered in the file are flagged as Registered.
+func resolveImports(matches []types.ComponentMatch, content string) {
+	bindings := parseImports(content)
+	options := vueComponentsOptionEntries(content)
+	aliases := wrapperAliases(content)
+	lazy := lazyAliases(content)
+	if len(bindings) == 0 && len(options) == 0 && len(aliases) == 0 && len(lazy) == 0 {
+		return
+	}
+
+	registered := make(map[string]bool)
+	for _, entry := range options {
+		registered[entry.key] = true
+		if binding, found := findImport(bindings, entry.value); found && entry.key != binding.local {
+			// Registered under a different name than imported (components: { MyBtn: QBtn })
+			binding.local = entry.key
+			bindings = append(bindings, binding)
+		}
+	}
+
+	for i := range matches {
+		root, rest, _ := strings.Cut(matches[i].ComponentName, ".")
+		binding, ok := findImport(bindings, root)
+		if !ok && strings.Contains(root, "-") {
+			root = kebabToPascalCase(root)
+			binding, ok = findImport(bindings, root)
+		}
+		if ok || registered[root] {
+			matches[i].Registered = true
+		}
+
+		if wrapped, isAlias := aliases[root]; !ok && isAlias {
+			// Wrapper alias: resolve to the wrapped component, following its import
+			resolved := joinMember(wrapped, rest)
+			wrappedRoot, wrappedRest, _ := strings.Cut(resolved, ".")
+			if binding, imported := findImport(bindings, wrappedRoot); imported {
+				if name := resolveBinding(binding, wrappedRest); name != "" {
+					resolved = name
+				}
+				matches[i].Library = binding.source
+			}
+			matches[i].ResolvedName = resolved
+			continue
+		}
+		if target, isLazy := lazy[root]; !ok && isLazy {
+			matches[i].ResolvedName = joinMember(target.ResolvedName, rest)
+			matches[i].Library = target.ImportPath
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		resolved := resolveBinding(binding, rest)
+		if resolved != "" && resolved != matches[i].ComponentName && resolved != kebabToPascalCase(matches[i].ComponentName) {
+			matches[i].ResolvedName = resolved
+		}
+		matches[i].Library = binding.source
+	}
+}
+
+// resolveBinding returns the component name an imported binding refers to, or ""
+// rest is the member path used on the binding (Item for Form.Item)
+func resolveBinding(binding importBinding, rest string) string {
+	switch binding.imported {
+	case "*":
+		return rest // Namespace import: only the member names a component
+	case "default":
+		if name := componentNameFromPath(binding.source); pascalCaseRegex.MatchString(name) {
+			return joinMember(name, rest)
+		}
+		return ""
+	default:
+		return joinMember(binding.imported, rest)
+	}
+}
+
+// vueComponentsOptionRegex matches the start of a Vue components: { ... } option
+var vueComponentsOptionRegex = regexp.MustCompile(`\bcomponents\s*:\s*\{`)
+
+// vueComponentEntry is a single entry of a Vue components option
+type vueComponentEntry struct {
+	key   string // Registered component name
+	value string // Registered value expression; equal to key for shorthand entries
+}
+
+// vueComponentsOptionEntries parses the entries of all Vue components: { ... } options
+// Both shorthand (MyDialog) and keyed entries ('my-btn': QBtn, Lazy: defineAsyncComponent(...)) are returned
+func vueComponentsOptionEntries(content string) []vueComponentEntry {
+	var entries []vueComponentEntry
+	for _, loc := range vueComponentsOptionRegex.FindAllStringIndex(content, -1) {
+		bodyStart := loc[1] - 1
+		bodyEnd := findBalancedBraceEnd(content, bodyStart)
+
+		for _, entry := range splitTopLevel(content[bodyStart+1:bodyEnd-1], ',') {
+			key, value, keyed := strings.Cut(entry, ":")
+			key = strings.Trim(strings.TrimSpace(key), `'"`)
+			if !identifierRegex.MatchString(strings.ReplaceAll(key, "-", "_")) {
+				continue
+			}
+			value = strings.TrimSpace(value)
+			if !keyed {
+				value = key
+			}
+			entries = append(entries, vueComponentEntry{key: kebabToPascalCase(key), value: value})
+		}
+	}
+	return entries
+}
+
+// splitTopLevel splits s on sep, ignoring separators nested in brackets or quotes
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case '\'', '"', '`':
+			if end := strings.IndexByte(s[i+1:], c); end >= 0 {
+				i += end + 1
+			}
+		case sep:
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// kebabToPascalCase converts a kebab-case tag name to PascalCase (my-dialog -> MyDialog)
+func kebabToPascalCase(name string) string {
+	if !strings.Contains(name, "-") {
+		return name
+	}
+	var sb strings.Builder
+	for _, part := range strings.Split(name, "-") {
+		if part != "" {
+			sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return sb.String()
+}
+
+// pascalCaseRegex matches a PascalCase identifier
+var pascalCaseRegex = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// joinMember appends a dotted member path to a name, if any
+func joinMember(name string, member string) string {
+	if member == "" {
+		return name
+	}
+	return name + "." + member
+}
@@ -0,0 +1,217 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestParseImports(t *testing.T) {
+	content := `import React, { useState } from 'react';
+import { Button as PrimaryBtn, type ButtonProps, Dialog } from '@mui/material';
+import * as Radix from '@radix-ui/react-dialog';
+import type { Props } from './types';
+import MyDialog from "./components/MyDialog.vue";
+import './styles.css';
+const lazy = import('./Lazy');`
+
+	expected := []importBinding{
+		{local: "useState", imported: "useState", source: "react"},
+		{local: "React", imported: "default", source: "react"},
+		{local: "PrimaryBtn", imported: "Button", source: "@mui/material"},
+		{local: "ButtonProps", imported: "ButtonProps", source: "@mui/material"},
+		{local: "Dialog", imported: "Dialog", source: "@mui/material"},
+		{local: "Radix", imported: "*", source: "@radix-ui/react-dialog"},
+		{local: "Props", imported: "Props", source: "./types"},
+		{local: "MyDialog", imported: "default", source: "./components/MyDialog.vue"},
+	}
+
+	bindings := parseImports(content)
+	if len(bindings) != len(expected) {
+		t.Fatalf("parseImports() returned %d bindings, want %d: %+v", len(bindings), len(expected), bindings)
+	}
+	for i := range expected {
+		if bindings[i] != expected[i] {
+			t.Errorf("Binding %d: got %+v, want %+v", i, bindings[i], expected[i])
+		}
+	}
+
+	if binding, ok := findImport(bindings, "PrimaryBtn"); !ok || binding.imported != "Button" {
+		t.Errorf("findImport(PrimaryBtn) = %+v, %v", binding, ok)
+	}
+	if _, ok := findImport(bindings, "Unknown"); ok {
+		t.Errorf("findImport(Unknown) should not find a binding")
+	}
+}
+
+func TestResolveImports_Aliases(t *testing.T) {
+	content := `import { Button as PrimaryBtn, Dialog } from '@mui/material';
+import SaveBtn from '@mui/material/Button';
+import Layout from './layout';
+import * as Ant from 'antd';
+import { Form as AntForm } from 'antd';`
+
+	tests := []struct {
+		componentName string
+		expected      string
+	}{
+		{"PrimaryBtn", "Button"},
+		{"Dialog", ""},
+		{"SaveBtn", "Button"},
+		{"Layout", ""},
+		{"Ant.Modal", "Modal"},
+		{"AntForm.Item", "Form.Item"},
+		{"Unknown", ""},
+	}
+
+	var matches []types.ComponentMatch
+	for _, tt := range tests {
+		matches = append(matches, types.ComponentMatch{ComponentName: tt.componentName})
+	}
+	resolveImports(matches, content)
+
+	for i, tt := range tests {
+		if matches[i].ResolvedName != tt.expected {
+			t.Errorf("%s: ResolvedName = %q, want %q", tt.componentName, matches[i].ResolvedName, tt.expected)
+		}
+	}
+}
+
+func TestResolveImports_WrapperAliases(t *testing.T) {
+	content := `import styled from 'styled-components';
+import { Button as MuiButton, Dialog } from '@mui/material';
+const FancyButton = styled(MuiButton)` + "`color: red;`" + `;
+const ThemedDialog = withTheme(Dialog);
+const LocalCard = React.memo(Card);`
+
+	tests := []struct {
+		componentName string
+		resolvedName  string
+		library       string
+	}{
+		{"FancyButton", "Button", "@mui/material"},
+		{"ThemedDialog", "Dialog", "@mui/material"},
+		{"LocalCard", "Card", ""},
+		{"MuiButton", "Button", "@mui/material"},
+	}
+
+	var matches []types.ComponentMatch
+	for _, tt := range tests {
+		matches = append(matches, types.ComponentMatch{ComponentName: tt.componentName})
+	}
+	resolveImports(matches, content)
+
+	for i, tt := range tests {
+		if matches[i].ResolvedName != tt.resolvedName {
+			t.Errorf("%s: ResolvedName = %q, want %q", tt.componentName, matches[i].ResolvedName, tt.resolvedName)
+		}
+		if matches[i].Library != tt.library {
+			t.Errorf("%s: Library = %q, want %q", tt.componentName, matches[i].Library, tt.library)
+		}
+	}
+}
+
+func TestResolveImports_Library(t *testing.T) {
+	content := `<template>
+  <q-btn />
+  <my-dialog />
+  <save-button />
+  <BaseCard />
+</template>
+
+<script>
+import { QBtn } from 'quasar'
+import MyDialog from './components/MyDialog.vue'
+import PrimaryButton from './components/PrimaryButton.vue'
+
+export default {
+  components: { QBtn, MyDialog, SaveButton: PrimaryButton },
+}
+</script>`
+
+	tests := []struct {
+		componentName string
+		library       string
+		resolvedName  string
+	}{
+		{"q-btn", "quasar", ""},
+		{"my-dialog", "./components/MyDialog.vue", ""},
+		{"save-button", "./components/PrimaryButton.vue", "PrimaryButton"},
+		{"BaseCard", "", ""},
+	}
+
+	var matches []types.ComponentMatch
+	for _, tt := range tests {
+		matches = append(matches, types.ComponentMatch{ComponentName: tt.componentName})
+	}
+	resolveImports(matches, content)
+
+	for i, tt := range tests {
+		if matches[i].Library != tt.library || matches[i].ResolvedName != tt.resolvedName {
+			t.Errorf("%s: Library = %q, ResolvedName = %q, want %q, %q",
+				tt.componentName, matches[i].Library, matches[i].ResolvedName, tt.library, tt.resolvedName)
+		}
+	}
+}
+
+func TestResolveImports_Registered(t *testing.T) {
+	content := `<template>
+  <my-dialog />
+  <AsyncChart />
+  <UserCard />
+  <router-link to="/" />
+  <unknown-widget />
+</template>
+
+<script>
+import MyDialog from './MyDialog.vue'
+
+export default {
+  components: {
+    MyDialog,
+    AsyncChart: defineAsyncComponent(() => import('./Chart.vue')),
+  },
+}
+</script>
+
+<script setup>
+import UserCard from './UserCard.vue'
+</script>`
+
+	tests := []struct {
+		componentName string
+		registered    bool
+	}{
+		{"my-dialog", true},
+		{"AsyncChart", true},
+		{"UserCard", true},
+		{"router-link", false},
+		{"unknown-widget", false},
+	}
+
+	var matches []types.ComponentMatch
+	for _, tt := range tests {
+		matches = append(matches, types.ComponentMatch{ComponentName: tt.componentName})
+	}
+	resolveImports(matches, content)
+
+	for i, tt := range tests {
+		if matches[i].Registered != tt.registered {
+			t.Errorf("%s: Registered = %v, want %v", tt.componentName, matches[i].Registered, tt.registered)
+		}
+	}
+}
+
+func TestSplitTopLevel(t *testing.T) {
+	result := splitTopLevel(`A, B: fn(x, y), 'c,d': { e, f }`, ',')
+	expected := []string{"A", " B: fn(x, y)", " 'c,d': { e, f }"}
+
+	if len(result) != len(expected) {
+		t.Fatalf("splitTopLevel() = %q, want %q", result, expected)
+	}
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("Part %d = %q, want %q", i, result[i], expected[i])
+		}
+	}
+}
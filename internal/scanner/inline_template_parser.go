@@ -0,0 +1,144 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// InlineTemplateParser parses inline template strings in .js and .ts files
+// Vue components can be defined with a template option string
+// (e.g. template: '<q-btn label="Save" />'), which is scanned like an SFC template.
+// Angular components declare theirs in the @Component({ template: `...` }) decorator.
+type InlineTemplateParser struct {
+	htmlTags htmlTagSet // Tags skipped as plain HTML, the built-in tags when nil
+}
+
+// NewInlineTemplateParser creates a new InlineTemplateParser instance
+func NewInlineTemplateParser() *InlineTemplateParser {
+	return &InlineTemplateParser{}
+}
+
+// setHTMLTags sets the tags skipped as plain HTML
+func (p *InlineTemplateParser) setHTMLTags(tags htmlTagSet) {
+	p.htmlTags = tags
+}
+
+var (
+	// templateOptionRegex matches a template option up to and including its opening quote
+	templateOptionRegex = regexp.MustCompile("\\btemplate\\s*:\\s*(['\"`])")
+
+	// angularComponentRegex matches an Angular @Component decorator up to its metadata object
+	angularComponentRegex = regexp.MustCompile(`@Component\s*\(\s*\{`)
+)
+
+// angularBuiltins lists elements provided by Angular itself, which are not UI components
+var angularBuiltins = map[string]bool{
+	"ng-container": true, "ng-template": true, "ng-content": true, "router-outlet": true,
+}
+
+// SupportsFile checks if the file is a .js or .ts file
+func (p *InlineTemplateParser) SupportsFile(filePath string) bool {
+	return isPlainScriptFile(strings.ToLower(filePath))
+}
+
+// Parse extracts component matches from template option strings
+func (p *InlineTemplateParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	if !strings.Contains(fileContent, "template") {
+		return nil, nil
+	}
+
+	decorators := findAngularDecorators(fileContent)
+
+	var matches []types.ComponentMatch
+	for _, literal := range extractTemplateOptions(fileContent) {
+		if !isInsideRange(literal.offset, decorators) {
+			matches = append(matches, parseTemplateComponents(literal.content, filePath, literal.startLine, p.htmlTags)...)
+			continue
+		}
+
+		// Angular template: ignore {{ }} interpolations and framework elements
+		content := maskRegions(literal.content, "{{", "}}")
+		matches = append(matches, collectTagMatches(content, filePath, literal.startLine, func(name string) bool {
+			return p.htmlTags.contains(name) || angularBuiltins[name]
+		}, templateTagRegex)...)
+	}
+
+	return matches, nil
+}
+
+// findAngularDecorators returns the [start, end) offsets of all @Component decorator metadata objects
+func findAngularDecorators(content string) [][2]int {
+	if !strings.Contains(content, "@Component") {
+		return nil
+	}
+
+	var ranges [][2]int
+	for _, loc := range angularComponentRegex.FindAllStringIndex(content, -1) {
+		openBrace := loc[1] - 1
+		end := findClosingBrace(content, openBrace)
+		if end < 0 {
+			end = len(content)
+		}
+		ranges = append(ranges, [2]int{openBrace, end})
+	}
+	return ranges
+}
+
+// isInsideRange checks if offset falls within one of the given [start, end) ranges
+func isInsideRange(offset int, ranges [][2]int) bool {
+	for _, r := range ranges {
+		if offset >= r[0] && offset < r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// extractTemplateOptions returns the string values of all template options in content
+// Single and double quoted strings end on the same line, template literals may
+// span several lines and have their interpolations blanked out
+func extractTemplateOptions(content string) []templateLiteral {
+	var literals []templateLiteral
+
+	for _, loc := range templateOptionRegex.FindAllStringSubmatchIndex(content, -1) {
+		start := loc[1]
+		quote := content[loc[2]]
+
+		var end int
+		if quote == '`' {
+			end = findTemplateLiteralEnd(content, start)
+		} else {
+			end = findStringEnd(content, start, quote)
+		}
+		if end < 0 {
+			continue
+		}
+
+		literals = append(literals, templateLiteral{
+			content:   blankInterpolations(content[start:end]),
+			raw:       content[start:end],
+			startLine: strings.Count(content[:start], "\n") + 1,
+			offset:    start,
+		})
+	}
+
+	return literals
+}
+
+// findStringEnd returns the index of the quote closing a single-line string literal
+// whose content starts at start, or -1 if the string isn't closed on that line
+func findStringEnd(content string, start int, quote byte) int {
+	for i := start; i < len(content); i++ {
+		switch content[i] {
+		case '\\':
+			i++
+		case quote:
+			return i
+		case '\n':
+			return -1
+		}
+	}
+	return -1
+}
@@ -0,0 +1,150 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestInlineTemplateParser_SupportsFile(t *testing.T) {
+	parser := NewInlineTemplateParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"js file", "src/components/SaveButton.js", true},
+		{"ts file", "src/components/SaveButton.ts", true},
+		{"vue file", "src/components/SaveButton.vue", false},
+		{"jsx file", "src/components/SaveButton.jsx", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInlineTemplateParser_Parse(t *testing.T) {
+	parser := NewInlineTemplateParser()
+
+	tests := []struct {
+		name          string
+		content       string
+		expectedNames []string
+		expectedLines []int
+	}{
+		{
+			name: "single quoted template option",
+			content: `export default {
+  name: 'SaveButton',
+  template: '<q-btn label="Save" @click="save" />',
+}`,
+			expectedNames: []string{"q-btn"},
+			expectedLines: []int{3},
+		},
+		{
+			name: "double quoted template with escaped quotes",
+			content: `Vue.component('confirm', {
+  template: "<q-dialog v-model=\"open\"><q-card>{{ msg }}</q-card></q-dialog>"
+})`,
+			expectedNames: []string{"q-dialog", "q-card"},
+			expectedLines: []int{2, 2},
+		},
+		{
+			name: "multi-line template literal",
+			content: "const UserForm = defineComponent({\n" +
+				"  template: `\n" +
+				"    <q-form @submit=\"submit\">\n" +
+				"      <div>${'<q-fake>'}</div>\n" +
+				"      <q-btn type=\"submit\" />\n" +
+				"    </q-form>\n" +
+				"  `,\n" +
+				"});",
+			expectedNames: []string{"q-form", "q-btn"},
+			expectedLines: []int{3, 5},
+		},
+		{
+			name:          "template selector",
+			content:       `new Vue({ el: '#app', template: '#app-template' })`,
+			expectedNames: nil,
+		},
+		{
+			name:          "no template option",
+			content:       `const template = load('<q-btn />');`,
+			expectedNames: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, "component.js")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(matches) != len(tt.expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(tt.expectedNames), matches)
+			}
+
+			for i, expectedName := range tt.expectedNames {
+				if matches[i].ComponentName != expectedName {
+					t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+				}
+				if matches[i].Line != tt.expectedLines[i] {
+					t.Errorf("Match %d: got line %d, want %d", i, matches[i].Line, tt.expectedLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestInlineTemplateParser_Parse_Angular(t *testing.T) {
+	parser := NewInlineTemplateParser()
+
+	content := "import { Component } from '@angular/core';\n" +
+		"\n" +
+		"@Component({\n" +
+		"  selector: 'app-user-form',\n" +
+		"  standalone: true,\n" +
+		"  template: `\n" +
+		"    <ng-container *ngIf=\"user\">\n" +
+		"      <mat-form-field>{{ '<fake-tag>' }}</mat-form-field>\n" +
+		"      @if (items.length < 3) {\n" +
+		"        <app-item-list [items]=\"items\"></app-item-list>\n" +
+		"      }\n" +
+		"      <router-outlet />\n" +
+		"    </ng-container>\n" +
+		"  `,\n" +
+		"})\n" +
+		"export class UserFormComponent {}\n"
+
+	matches, err := parser.Parse(content, "user-form.component.ts")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"mat-form-field", 8},
+		{"app-item-list", 10},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, exp.name)
+		}
+		if matches[i].Line != exp.line {
+			t.Errorf("Match %d (%s): got line %d, want %d", i, exp.name, matches[i].Line, exp.line)
+		}
+	}
+}
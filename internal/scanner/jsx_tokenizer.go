@@ -0,0 +1,382 @@
+package scanner
+
+import (
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// jsxWalker walks JavaScript/TypeScript source code and reports JSX elements
+// It tokenizes just enough of the language to know whether a "<" starts a JSX
+// element or is an operator/type argument, and skips comments, string, template
+// and regular expression literals, so commented or quoted markup is never reported.
+type jsxWalker struct {
+	src       string
+	pos       int
+	onElement func(name string, offset int)
+}
+
+// jsxExpressionKeywords lists keywords after which an expression (and thus JSX) may follow
+var jsxExpressionKeywords = map[string]bool{
+	"return": true, "yield": true, "await": true, "case": true, "default": true,
+	"else": true, "do": true, "in": true, "of": true, "new": true, "delete": true,
+	"void": true, "typeof": true, "throw": true, "instanceof": true,
+}
+
+// parseReactJSXTokens extracts component usage by scanning the tokens of the source for JSX elements
+// Only element names accepted by accept are reported, once per name and line;
+// repeated elements on the same line are counted in Occurrences.
+func parseReactJSXTokens(content string, filePath string, baseLineNumber int, accept func(string) bool) []types.ComponentMatch {
+	var matches []types.ComponentMatch
+	seenComponents := make(map[string]map[int]int) // Index of the match reported for component:line
+
+	line, lineOffset := 0, 0
+	walker := &jsxWalker{src: content}
+	walker.onElement = func(name string, offset int) {
+		if !accept(name) {
+			return
+		}
+
+		// Elements are reported in source order, so line counting is incremental
+		line += strings.Count(content[lineOffset:offset], "\n")
+		lineOffset = offset
+
+		if seenComponents[name] == nil {
+			seenComponents[name] = make(map[int]int)
+		}
+		if idx, seen := seenComponents[name][line]; seen {
+			matches[idx].Occurrences++
+			return
+		}
+		seenComponents[name][line] = len(matches)
+
+		matches = append(matches, types.ComponentMatch{
+			FilePath:      filePath,
+			Line:          baseLineNumber + line,
+			ComponentName: name,
+			ComponentType: "", // Will be set by scanner based on registry
+			Occurrences:   1,
+		})
+	}
+
+	walker.walkCode(false)
+	return matches
+}
+
+// isJSXComponentName checks if a JSX element name refers to a component rather than an HTML element
+// Member expressions (Form.Item) are components when their root object is capitalized
+func isJSXComponentName(name string) bool {
+	return name != "" && name[0] >= 'A' && name[0] <= 'Z' && !strings.ContainsAny(name, ":-") &&
+		!strings.HasSuffix(name, ".") && !strings.Contains(name, "..")
+}
+
+// walkCode scans JavaScript code until the end of input or, when inBraces is set,
+// until the unmatched closing brace of an expression container or interpolation
+func (w *jsxWalker) walkCode(inBraces bool) {
+	depth := 0
+	expectExpr := true // Whether the next token may start an expression
+
+	for w.pos < len(w.src) {
+		c := w.src[w.pos]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			w.pos++
+
+		case c == '/':
+			switch {
+			case strings.HasPrefix(w.src[w.pos:], "//"):
+				w.skipLineComment()
+			case strings.HasPrefix(w.src[w.pos:], "/*"):
+				w.skipBlockComment()
+			case expectExpr:
+				w.skipRegexLiteral()
+				expectExpr = false
+			default:
+				w.pos++
+				expectExpr = true
+			}
+
+		case c == '\'' || c == '"':
+			w.skipString(c)
+			expectExpr = false
+
+		case c == '`':
+			w.skipTemplateLiteral()
+			expectExpr = false
+
+		case c == '{':
+			depth++
+			w.pos++
+			expectExpr = true
+
+		case c == '}':
+			if depth == 0 && inBraces {
+				return
+			}
+			depth--
+			w.pos++
+			expectExpr = true
+
+		case c == ')' || c == ']':
+			w.pos++
+			expectExpr = false
+
+		case c == '<':
+			if expectExpr && w.atJSXElement() {
+				w.parseElement()
+				expectExpr = false
+			} else {
+				w.pos++
+				expectExpr = true
+			}
+
+		case isIdentStart(c):
+			word := w.readIdentifier()
+			expectExpr = jsxExpressionKeywords[word]
+
+		case c >= '0' && c <= '9':
+			for w.pos < len(w.src) && (isIdentPart(w.src[w.pos]) || w.src[w.pos] == '.') {
+				w.pos++
+			}
+			expectExpr = false
+
+		case c == '.':
+			w.pos++
+			expectExpr = false
+
+		default:
+			// Operators and punctuation: ( [ , ; : ? = ! & | + - * % ^ ~ >
+			w.pos++
+			expectExpr = true
+		}
+	}
+}
+
+// atJSXElement checks if the "<" at the current position starts a JSX element
+// Generic arrow functions (<T,>(x) => x, <T extends U>(x) => x) are rejected
+func (w *jsxWalker) atJSXElement() bool {
+	i := w.pos + 1
+	if i >= len(w.src) {
+		return false
+	}
+	if w.src[i] == '>' {
+		return true // Fragment
+	}
+	if !isIdentStart(w.src[i]) {
+		return false
+	}
+
+	for i < len(w.src) && isIdentPart(w.src[i]) {
+		i++
+	}
+	for i < len(w.src) && (w.src[i] == ' ' || w.src[i] == '\t') {
+		i++
+	}
+	rest := w.src[i:]
+	return !strings.HasPrefix(rest, ",") && !strings.HasPrefix(rest, "extends ")
+}
+
+// parseElement parses a JSX element starting at "<", including its children
+func (w *jsxWalker) parseElement() {
+	w.pos++ // <
+
+	// Fragment
+	if w.pos < len(w.src) && w.src[w.pos] == '>' {
+		w.pos++
+		w.parseChildren()
+		return
+	}
+
+	nameStart := w.pos
+	for w.pos < len(w.src) && (isIdentPart(w.src[w.pos]) || strings.IndexByte(".:-", w.src[w.pos]) >= 0) {
+		w.pos++
+	}
+	w.onElement(w.src[nameStart:w.pos], nameStart)
+
+	// Attributes
+	for w.pos < len(w.src) {
+		c := w.src[w.pos]
+		switch {
+		case c == '/' && strings.HasPrefix(w.src[w.pos:], "/>"):
+			w.pos += 2
+			return
+
+		case c == '>':
+			w.pos++
+			w.parseChildren()
+			return
+
+		case c == '<':
+			// TypeScript type arguments of a generic component: <List<Item> ...>
+			w.skipTypeArguments()
+
+		case c == '{':
+			w.pos++
+			w.walkCode(true)
+			w.pos++ // }
+
+		case c == '"' || c == '\'':
+			// JSX attribute strings have no escapes and may span lines
+			end := strings.IndexByte(w.src[w.pos+1:], c)
+			if end < 0 {
+				w.pos = len(w.src)
+				return
+			}
+			w.pos += end + 2
+
+		default:
+			w.pos++
+		}
+	}
+}
+
+// parseChildren parses JSX children up to and including the closing tag
+func (w *jsxWalker) parseChildren() {
+	for w.pos < len(w.src) {
+		switch w.src[w.pos] {
+		case '<':
+			if strings.HasPrefix(w.src[w.pos:], "</") {
+				end := strings.IndexByte(w.src[w.pos:], '>')
+				if end < 0 {
+					w.pos = len(w.src)
+				} else {
+					w.pos += end + 1
+				}
+				return
+			}
+			w.parseElement()
+
+		case '{':
+			w.pos++
+			w.walkCode(true)
+			w.pos++ // }
+
+		default:
+			w.pos++
+		}
+	}
+}
+
+// skipTypeArguments skips a balanced <...> type argument list
+func (w *jsxWalker) skipTypeArguments() {
+	depth := 0
+	for w.pos < len(w.src) {
+		switch w.src[w.pos] {
+		case '<':
+			depth++
+		case '>':
+			depth--
+			if depth == 0 {
+				w.pos++
+				return
+			}
+		}
+		w.pos++
+	}
+}
+
+// skipLineComment skips a // comment up to the end of the line
+func (w *jsxWalker) skipLineComment() {
+	end := strings.IndexByte(w.src[w.pos:], '\n')
+	if end < 0 {
+		w.pos = len(w.src)
+		return
+	}
+	w.pos += end
+}
+
+// skipBlockComment skips a /* */ comment
+func (w *jsxWalker) skipBlockComment() {
+	end := strings.Index(w.src[w.pos+2:], "*/")
+	if end < 0 {
+		w.pos = len(w.src)
+		return
+	}
+	w.pos += end + 4
+}
+
+// skipString skips a single or double quoted string literal
+func (w *jsxWalker) skipString(quote byte) {
+	w.pos++
+	for w.pos < len(w.src) {
+		switch w.src[w.pos] {
+		case '\\':
+			w.pos += 2
+			continue
+		case quote, '\n':
+			w.pos++
+			return
+		}
+		w.pos++
+	}
+}
+
+// skipTemplateLiteral skips a template literal, walking its ${} interpolations as code
+func (w *jsxWalker) skipTemplateLiteral() {
+	w.pos++
+	for w.pos < len(w.src) {
+		switch w.src[w.pos] {
+		case '\\':
+			w.pos += 2
+			continue
+		case '`':
+			w.pos++
+			return
+		case '$':
+			if strings.HasPrefix(w.src[w.pos:], "${") {
+				w.pos += 2
+				w.walkCode(true)
+			}
+		}
+		w.pos++
+	}
+}
+
+// skipRegexLiteral skips a regular expression literal, including its flags
+func (w *jsxWalker) skipRegexLiteral() {
+	w.pos++
+	inClass := false
+	for w.pos < len(w.src) {
+		switch w.src[w.pos] {
+		case '\\':
+			w.pos += 2
+			continue
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '\n':
+			w.pos++
+			return
+		case '/':
+			if !inClass {
+				w.pos++
+				for w.pos < len(w.src) && isIdentPart(w.src[w.pos]) {
+					w.pos++
+				}
+				return
+			}
+		}
+		w.pos++
+	}
+}
+
+// readIdentifier reads an identifier or keyword at the current position
+func (w *jsxWalker) readIdentifier() string {
+	start := w.pos
+	for w.pos < len(w.src) && isIdentPart(w.src[w.pos]) {
+		w.pos++
+	}
+	return w.src[start:w.pos]
+}
+
+// isIdentStart checks if c can start a JavaScript identifier
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || c == '#' || c == '@' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// isIdentPart checks if c can be part of a JavaScript identifier
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
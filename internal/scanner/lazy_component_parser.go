@@ -0,0 +1,147 @@
+package scanner
+
+import (
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// LazyComponentParser detects lazily loaded components in script files and Vue SFCs
+// React.lazy(() => import('./Dialog')), Vue's defineAsyncComponent(() => import(...)),
+// Next.js dynamic() and Vue 2 style async components (Name: () => import(...)) are
+// reported under the name they are bound to, along with their import path
+type LazyComponentParser struct{}
+
+// NewLazyComponentParser creates a new LazyComponentParser instance
+func NewLazyComponentParser() *LazyComponentParser {
+	return &LazyComponentParser{}
+}
+
+var (
+	// lazyComponentRegex matches a lazy loader wrapping a dynamic import, capturing
+	// the variable or object key it is bound to, the loader function and the import path
+	lazyComponentRegex = regexp.MustCompile(`(?:(?:const|let|var)\s+([A-Za-z_$][\w$]*)\s*=\s*|([A-Za-z_$][\w$]*)\s*:\s*)?` +
+		`\b(React\.lazy|lazy|defineAsyncComponent|dynamic)\s*\(\s*(?:\{\s*loader\s*:\s*)?(?:async\s*)?\(\s*\)\s*=>\s*` +
+		`(?:\{\s*return\s+)?import\s*\(\s*['"]([^'"]+)['"]`)
+
+	// asyncComponentOptionRegex matches a Vue 2 async component or lazy route component
+	// (MyDialog: () => import('./MyDialog.vue')), capturing the key and the import path
+	asyncComponentOptionRegex = regexp.MustCompile(`([A-Za-z_$][\w$]*)\s*:\s*(?:async\s*)?\(\s*\)\s*=>\s*import\s*\(\s*['"]([^'"]+)['"]`)
+)
+
+// SupportsFile checks if the file is a script file or a Vue SFC
+func (p *LazyComponentParser) SupportsFile(filePath string) bool {
+	lowerPath := strings.ToLower(filePath)
+	return isPlainScriptFile(lowerPath) || strings.HasSuffix(lowerPath, ".jsx") ||
+		strings.HasSuffix(lowerPath, ".tsx") || strings.HasSuffix(lowerPath, ".vue")
+}
+
+// Parse extracts lazily loaded components from file content
+func (p *LazyComponentParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	if !strings.Contains(fileContent, "import(") && !strings.Contains(fileContent, "import (") {
+		return nil, nil
+	}
+
+	var matches []types.ComponentMatch
+	matched := make(map[int]bool) // Offsets of import() calls already handled
+
+	for _, loc := range lazyComponentRegex.FindAllStringSubmatchIndex(fileContent, -1) {
+		loader := fileContent[loc[6]:loc[7]]
+		if loader == "dynamic" && !importsFrom(fileContent, "next/dynamic") {
+			continue
+		}
+
+		name := submatch(fileContent, loc, 1)
+		if name == "" {
+			name = submatch(fileContent, loc, 2)
+		}
+		importPath := fileContent[loc[8]:loc[9]]
+
+		matched[loc[9]] = true
+		matches = append(matches, newLazyMatch(fileContent, filePath, loc[0], name, importPath))
+	}
+
+	for _, loc := range asyncComponentOptionRegex.FindAllStringSubmatchIndex(fileContent, -1) {
+		if matched[loc[5]] {
+			continue
+		}
+		name, importPath := fileContent[loc[2]:loc[3]], fileContent[loc[4]:loc[5]]
+		if name == "component" {
+			name = "" // Lazy route: { path: '/about', component: () => import('./About.vue') }
+		}
+		matches = append(matches, newLazyMatch(fileContent, filePath, loc[0], name, importPath))
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Line < matches[j].Line
+	})
+	return matches, nil
+}
+
+// newLazyMatch creates a lazy component match at the given offset
+// Without a binding name, the component is named after its import path; bound under
+// another name (const LazyDialog = lazy(() => import('./Dialog'))), it resolves to it
+func newLazyMatch(content string, filePath string, offset int, name string, importPath string) types.ComponentMatch {
+	target := componentNameFromPath(importPath)
+	if name == "" {
+		name = target
+	}
+	match := types.ComponentMatch{
+		FilePath:      filePath,
+		Line:          strings.Count(content[:offset], "\n") + 1,
+		ComponentName: name,
+		ComponentType: "", // Will be set by scanner based on registry
+		UsageKind:     types.UsageKindLazy,
+		ImportPath:    importPath,
+		Library:       importPath,
+	}
+	if target != name && pascalCaseRegex.MatchString(target) {
+		match.ResolvedName = target
+	}
+	return match
+}
+
+// lazyAliases maps the names lazy components are bound to to their lazy match,
+// which holds the component they load and its import path
+func lazyAliases(content string) map[string]types.ComponentMatch {
+	lazyMatches, _ := NewLazyComponentParser().Parse(content, "")
+	aliases := make(map[string]types.ComponentMatch)
+	for _, match := range lazyMatches {
+		if match.ResolvedName != "" {
+			aliases[match.ComponentName] = match
+		}
+	}
+	return aliases
+}
+
+// componentNameFromPath derives a component name from a module path
+// e.g. "./components/Dialog.vue" -> "Dialog", "./Dialog/index" -> "Dialog"
+func componentNameFromPath(importPath string) string {
+	base := path.Base(importPath)
+	base = strings.TrimSuffix(base, path.Ext(base))
+	if base == "index" {
+		base = path.Base(path.Dir(importPath))
+	}
+	return base
+}
+
+// importsFrom checks if the content has an import statement for the given module
+func importsFrom(content string, source string) bool {
+	for _, binding := range parseImports(content) {
+		if binding.source == source {
+			return true
+		}
+	}
+	return false
+}
+
+// submatch returns the text of capture group n from a FindStringSubmatchIndex result, or ""
+func submatch(content string, loc []int, n int) string {
+	if loc[2*n] < 0 {
+		return ""
+	}
+	return content[loc[2*n]:loc[2*n+1]]
+}
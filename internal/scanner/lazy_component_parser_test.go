@@ -0,0 +1,137 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestLazyComponentParser_SupportsFile(t *testing.T) {
+	parser := NewLazyComponentParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"tsx file", "src/App.tsx", true},
+		{"jsx file", "src/App.jsx", true},
+		{"ts file", "src/router/index.ts", true},
+		{"js file", "src/main.js", true},
+		{"vue file", "src/App.vue", true},
+		{"html file", "index.html", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := parser.SupportsFile(tt.filePath); result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLazyComponentParser_Parse(t *testing.T) {
+	parser := NewLazyComponentParser()
+
+	type lazyMatch struct {
+		name       string
+		line       int
+		importPath string
+		resolved   string
+	}
+
+	tests := []struct {
+		name     string
+		content  string
+		expected []lazyMatch
+	}{
+		{
+			name: "React.lazy and lazy",
+			content: `import React, { lazy } from 'react';
+const SettingsDialog = React.lazy(() => import('./SettingsDialog'));
+const Chart = lazy(async () => import("../charts/Chart.tsx"));
+const LazyDialog = React.lazy(() => import('./Dialog'));`,
+			expected: []lazyMatch{
+				{"SettingsDialog", 2, "./SettingsDialog", ""},
+				{"Chart", 3, "../charts/Chart.tsx", ""},
+				{"LazyDialog", 4, "./Dialog", "Dialog"},
+			},
+		},
+		{
+			name: "Vue defineAsyncComponent",
+			content: `import { defineAsyncComponent } from 'vue'
+const AsyncDialog = defineAsyncComponent(() => import('./AsyncDialog.vue'))
+export default {
+  components: {
+    UserForm: defineAsyncComponent({
+      loader: () => import('./UserForm.vue'),
+      delay: 200,
+    }),
+    LegacyModal: () => import('./LegacyModal.vue'),
+  },
+}`,
+			expected: []lazyMatch{
+				{"AsyncDialog", 2, "./AsyncDialog.vue", ""},
+				{"UserForm", 5, "./UserForm.vue", ""},
+				{"LegacyModal", 9, "./LegacyModal.vue", ""},
+			},
+		},
+		{
+			name: "lazy routes are named after their module",
+			content: `const routes = [
+  { path: '/about', component: () => import('./views/About.vue') },
+  { path: '/users', component: () => import('./views/users/index.js') },
+]`,
+			expected: []lazyMatch{
+				{"About", 2, "./views/About.vue", ""},
+				{"users", 3, "./views/users/index.js", ""},
+			},
+		},
+		{
+			name: "Next.js dynamic requires next/dynamic",
+			content: `import dynamic from 'next/dynamic'
+const Editor = dynamic(() => import('../components/Editor'), { ssr: false })`,
+			expected: []lazyMatch{
+				{"Editor", 2, "../components/Editor", ""},
+			},
+		},
+		{
+			name:     "dynamic without next/dynamic import",
+			content:  `const mod = dynamic(() => import('./plugin'))`,
+			expected: nil,
+		},
+		{
+			name:     "plain dynamic import",
+			content:  `const { format } = await import('./format')`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, "App.tsx")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(matches) != len(tt.expected) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(tt.expected), matches)
+			}
+
+			for i, exp := range tt.expected {
+				match := matches[i]
+				if match.ComponentName != exp.name || match.Line != exp.line || match.ImportPath != exp.importPath {
+					t.Errorf("Match %d: got %s (line %d, %s), want %s (line %d, %s)",
+						i, match.ComponentName, match.Line, match.ImportPath, exp.name, exp.line, exp.importPath)
+				}
+				if match.ResolvedName != exp.resolved {
+					t.Errorf("Match %d: ResolvedName = %q, want %q", i, match.ResolvedName, exp.resolved)
+				}
+				if match.UsageKind != types.UsageKindLazy {
+					t.Errorf("Match %d: UsageKind = %q, want %q", i, match.UsageKind, types.UsageKindLazy)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// LitParser parses html`...` tagged template literals in .js and .ts files
+// Extracts custom element usage from Lit-style templates and component
+// interpolations (<${Button} />) from Preact's htm templates
+type LitParser struct{}
+
+// NewLitParser creates a new LitParser instance
+func NewLitParser() *LitParser {
+	return &LitParser{}
+}
+
+var (
+	// htmlTagRegex matches the start of an html`...` tagged template literal
+	htmlTagRegex = regexp.MustCompile("\\bhtml\\s*`")
+
+	// htmComponentRegex matches an htm component interpolation such as <${Button}
+	htmComponentRegex = regexp.MustCompile(`<\$\{\s*([A-Za-z_$][\w$]*(?:\.[A-Za-z_$][\w$]*)*)\s*\}`)
+)
+
+// SupportsFile checks if the file is a .js or .ts file
+func (p *LitParser) SupportsFile(filePath string) bool {
+	return isPlainScriptFile(strings.ToLower(filePath))
+}
+
+// Parse extracts component matches from html tagged template literals
+// Only hyphenated tag names are reported, as required for custom elements,
+// along with components interpolated into htm templates
+func (p *LitParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	if !strings.Contains(fileContent, "html") {
+		return nil, nil
+	}
+
+	var matches []types.ComponentMatch
+
+	for _, literal := range extractTaggedTemplates(fileContent, htmlTagRegex) {
+		for _, match := range parseTemplateComponents(literal.content, filePath, literal.startLine, nil) {
+			if strings.Contains(match.ComponentName, "-") {
+				matches = append(matches, match)
+			}
+		}
+		matches = append(matches, collectTagMatches(literal.raw, filePath, literal.startLine, nil, htmComponentRegex)...)
+	}
+
+	// Nested templates are also contained in the raw content of their parent
+	return dedupeMatches(matches), nil
+}
+
+// templateLiteral holds the content of a template literal and the line where it starts
+// content has interpolations blanked out, raw is the literal as written
+type templateLiteral struct {
+	content   string
+	raw       string
+	startLine int
+	offset    int // Byte offset of the literal content in the file
+}
+
+// extractTaggedTemplates finds all template literals introduced by the given tag regex
+// The regex must match up to and including the opening backtick. Interpolations
+// (${...}) are blanked out so they don't produce spurious tag matches.
+func extractTaggedTemplates(content string, tagRegex *regexp.Regexp) []templateLiteral {
+	var literals []templateLiteral
+
+	for _, loc := range tagRegex.FindAllStringIndex(content, -1) {
+		start := loc[1]
+		end := findTemplateLiteralEnd(content, start)
+		if end < 0 {
+			continue
+		}
+
+		literals = append(literals, templateLiteral{
+			content:   blankInterpolations(content[start:end]),
+			raw:       content[start:end],
+			startLine: strings.Count(content[:start], "\n") + 1,
+			offset:    start,
+		})
+	}
+
+	return literals
+}
+
+// findTemplateLiteralEnd returns the index of the backtick closing the template
+// literal whose content starts at start, or -1 if the literal is unterminated
+func findTemplateLiteralEnd(content string, start int) int {
+	for i := start; i < len(content); i++ {
+		switch content[i] {
+		case '\\':
+			i++
+		case '`':
+			return i
+		case '$':
+			if i+1 < len(content) && content[i+1] == '{' {
+				end := findInterpolationEnd(content, i+2)
+				if end < 0 {
+					return -1
+				}
+				i = end
+			}
+		}
+	}
+	return -1
+}
+
+// findInterpolationEnd returns the index of the brace closing a ${...} expression
+// whose body starts at start. Strings and nested template literals are skipped.
+func findInterpolationEnd(content string, start int) int {
+	depth := 0
+	for i := start; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case '\'', '"':
+			quote := content[i]
+			for i++; i < len(content) && content[i] != quote && content[i] != '\n'; i++ {
+				if content[i] == '\\' {
+					i++
+				}
+			}
+		case '`':
+			end := findTemplateLiteralEnd(content, i+1)
+			if end < 0 {
+				return -1
+			}
+			i = end
+		}
+	}
+	return -1
+}
+
+// blankInterpolations replaces ${...} expressions with spaces, preserving newlines
+// so that line numbers computed on the result still match the original content
+func blankInterpolations(literal string) string {
+	if !strings.Contains(literal, "${") {
+		return literal
+	}
+
+	buf := []byte(literal)
+	for i := 0; i+1 < len(buf); i++ {
+		if buf[i] == '\\' {
+			i++
+			continue
+		}
+		if buf[i] != '$' || buf[i+1] != '{' {
+			continue
+		}
+		end := findInterpolationEnd(literal, i+2)
+		if end < 0 {
+			end = len(buf) - 1
+		}
+		for j := i; j <= end; j++ {
+			if buf[j] != '\n' {
+				buf[j] = ' '
+			}
+		}
+		i = end
+	}
+
+	return string(buf)
+}
@@ -0,0 +1,118 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestLitParser_SupportsFile(t *testing.T) {
+	parser := NewLitParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"ts file", "src/my-element.ts", true},
+		{"js file", "src/my-element.js", true},
+		{"tsx file", "src/App.tsx", false},
+		{"vue file", "src/App.vue", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLitParser_Parse(t *testing.T) {
+	parser := NewLitParser()
+
+	tests := []struct {
+		name          string
+		content       string
+		expectedNames []string
+		expectedLines []int
+	}{
+		{
+			name: "custom elements in render",
+			content: `import { LitElement, html } from 'lit';
+
+class MyApp extends LitElement {
+  render() {
+    return html` + "`" + `
+      <div>
+        <sl-button variant="primary">Save</sl-button>
+        <my-dialog .open=${this.open}></my-dialog>
+      </div>
+    ` + "`" + `;
+  }
+}`,
+			expectedNames: []string{"sl-button", "my-dialog"},
+			expectedLines: []int{7, 8},
+		},
+		{
+			name: "nested templates in interpolations",
+			content: "const list = html`<ul>${items.map((i) => html`<todo-item .item=${i}></todo-item>`)}</ul>`;\n" +
+				"const other = html`<md-filled-button>Go</md-filled-button>`;",
+			expectedNames: []string{"todo-item", "md-filled-button"},
+			expectedLines: []int{1, 2},
+		},
+		{
+			name: "htm component interpolations",
+			content: `import { html } from 'htm/preact';
+
+export function App() {
+  return html` + "`" + `
+    <${Layout} title="Home">
+      <${Button} onClick=${save}>Save<//>
+      <${Dialog.Title}>Confirm<//>
+    <//>
+  ` + "`" + `;
+}`,
+			expectedNames: []string{"Layout", "Button", "Dialog.Title"},
+			expectedLines: []int{5, 6, 7},
+		},
+		{
+			name:          "nested htm templates are reported once",
+			content:       "const list = html`<${List}>${items.map((i) => html`<${Item} item=${i} />`)}<//>`;",
+			expectedNames: []string{"List", "Item"},
+			expectedLines: []int{1, 1},
+		},
+		{
+			name:          "plain html elements are ignored",
+			content:       "const tpl = html`<section><p>Hello</p></section>`;",
+			expectedNames: nil,
+		},
+		{
+			name:          "no tagged templates",
+			content:       "const greeting = `<my-element>`;",
+			expectedNames: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, "my-app.ts")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(matches) != len(tt.expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(tt.expectedNames), matches)
+			}
+
+			for i, expectedName := range tt.expectedNames {
+				if matches[i].ComponentName != expectedName {
+					t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+				}
+				if matches[i].Line != tt.expectedLines[i] {
+					t.Errorf("Match %d: got line %d, want %d", i, matches[i].Line, tt.expectedLines[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// MarkdownParser scans fenced code blocks inside Markdown documentation (.md files)
+// Blocks tagged jsx/tsx/js/ts, vue or html are parsed with the matching parser,
+// and every match is flagged as documentation usage
+type MarkdownParser struct {
+	vue      *VueParser
+	react    *ReactParser
+	htmlTags htmlTagSet // Tags skipped as plain HTML, the built-in tags when nil
+}
+
+// NewMarkdownParser creates a new MarkdownParser instance
+func NewMarkdownParser() *MarkdownParser {
+	return &MarkdownParser{
+		vue:   NewVueParser(),
+		react: NewReactParser(),
+	}
+}
+
+// setHTMLTags sets the tags skipped as plain HTML, in html and vue code blocks
+func (p *MarkdownParser) setHTMLTags(tags htmlTagSet) {
+	p.htmlTags = tags
+	p.vue.setHTMLTags(tags)
+}
+
+// fenceRegex matches the opening line of a fenced code block and captures the fence and language
+var fenceRegex = regexp.MustCompile("^\\s*(`{3,}|~{3,})\\s*([A-Za-z]*)")
+
+// SupportsFile checks if the file is a .md file
+func (p *MarkdownParser) SupportsFile(filePath string) bool {
+	return strings.HasSuffix(strings.ToLower(filePath), ".md")
+}
+
+// Parse extracts component matches from the fenced code blocks of a Markdown file
+func (p *MarkdownParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	var matches []types.ComponentMatch
+
+	for _, block := range extractCodeFences(fileContent) {
+		var blockMatches []types.ComponentMatch
+
+		switch block.lang {
+		case "jsx", "tsx", "js", "ts", "javascript", "typescript":
+			parsed, err := p.react.Parse(block.content, filePath)
+			if err != nil {
+				return nil, err
+			}
+			blockMatches = parsed
+		case "vue":
+			parsed, err := p.vue.Parse(block.content, filePath)
+			if err != nil {
+				return nil, err
+			}
+			blockMatches = parsed
+		case "html":
+			blockMatches = parseTemplateComponents(block.content, filePath, 1, p.htmlTags)
+		}
+
+		for _, match := range blockMatches {
+			match.Line += block.startLine - 1
+			match.Docs = true
+			matches = append(matches, match)
+		}
+	}
+
+	return matches, nil
+}
+
+// codeFence holds the content of a fenced code block and the line its content starts on
+type codeFence struct {
+	lang      string
+	content   string
+	startLine int
+}
+
+// extractCodeFences returns all fenced code blocks of a Markdown document
+func extractCodeFences(content string) []codeFence {
+	var fences []codeFence
+
+	lines := strings.Split(content, "\n")
+	for i := 0; i < len(lines); i++ {
+		opening := fenceRegex.FindStringSubmatch(lines[i])
+		if opening == nil {
+			continue
+		}
+
+		// The block ends at a closing fence of the same kind, at least as long
+		end := i + 1
+		for end < len(lines) {
+			closing := strings.TrimSpace(lines[end])
+			if strings.HasPrefix(closing, opening[1]) && strings.Trim(closing, opening[1][:1]) == "" {
+				break
+			}
+			end++
+		}
+
+		fences = append(fences, codeFence{
+			lang:      strings.ToLower(opening[2]),
+			content:   strings.Join(lines[i+1:min(end, len(lines))], "\n"),
+			startLine: i + 2,
+		})
+		i = end
+	}
+
+	return fences
+}
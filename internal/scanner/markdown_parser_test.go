@@ -0,0 +1,84 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestMarkdownParser_SupportsFile(t *testing.T) {
+	parser := NewMarkdownParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"markdown file", "docs/button.md", true},
+		{"uppercase extension", "README.MD", true},
+		{"text file", "docs/notes.txt", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMarkdownParser_Parse(t *testing.T) {
+	parser := NewMarkdownParser()
+
+	content := "# Buttons\n" +
+		"\n" +
+		"Use <Button> for primary actions.\n" +
+		"\n" +
+		"```jsx\n" +
+		"const Example = () => <Button variant=\"primary\">Save</Button>;\n" +
+		"```\n" +
+		"\n" +
+		"```vue\n" +
+		"<template>\n" +
+		"  <q-btn label=\"Save\" />\n" +
+		"</template>\n" +
+		"```\n" +
+		"\n" +
+		"~~~html\n" +
+		"<sl-dialog></sl-dialog>\n" +
+		"~~~\n" +
+		"\n" +
+		"```bash\n" +
+		"echo \"<NotScanned />\"\n" +
+		"```\n"
+
+	matches, err := parser.Parse(content, "docs/button.md")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"Button", 6},
+		{"q-btn", 11},
+		{"sl-dialog", 16},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, exp.name)
+		}
+		if matches[i].Line != exp.line {
+			t.Errorf("Match %d (%s): got line %d, want %d", i, exp.name, matches[i].Line, exp.line)
+		}
+		if !matches[i].Docs {
+			t.Errorf("Match %d (%s): expected Docs to be set", i, exp.name)
+		}
+	}
+}
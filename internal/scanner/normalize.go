@@ -0,0 +1,41 @@
+package scanner
+
+import (
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// canonicalComponentName returns the PascalCase form of a component name
+// Template casings of the same component share one canonical name (q-btn and QBtn -> QBtn)
+// Names of dotted member expressions are converted per segment
+func canonicalComponentName(name string) string {
+	if !strings.Contains(name, "-") {
+		return name
+	}
+	segments := strings.Split(name, ".")
+	for i, segment := range segments {
+		segments[i] = kebabToPascalCase(segment)
+	}
+	return strings.Join(segments, ".")
+}
+
+// countByCanonicalName counts component usages per canonical component name
+// Aliases, wrappers and lazy bindings count under the component they resolve to
+// (PrimaryBtn for Button and FancyButton for styled(Button) count as Button)
+func countByCanonicalName(matches []types.ComponentMatch) map[string]int {
+	if len(matches) == 0 {
+		return nil
+	}
+	counts := make(map[string]int)
+	for _, match := range matches {
+		name := match.CanonicalName
+		if match.ResolvedName != "" {
+			name = canonicalComponentName(match.ResolvedName)
+		} else if name == "" {
+			name = canonicalComponentName(match.ComponentName)
+		}
+		counts[name] += max(match.Occurrences, 1)
+	}
+	return counts
+}
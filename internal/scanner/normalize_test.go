@@ -0,0 +1,49 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestCanonicalComponentName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"q-btn", "QBtn"},
+		{"QBtn", "QBtn"},
+		{"el-dialog", "ElDialog"},
+		{"v-text-field", "VTextField"},
+		{"Form.Item", "Form.Item"},
+		{"ion-button", "IonButton"},
+		{"$q.dialog", "$q.dialog"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := canonicalComponentName(tt.name); result != tt.expected {
+				t.Errorf("canonicalComponentName(%q) = %q, want %q", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCountByCanonicalName(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{ComponentName: "q-btn"},
+		{ComponentName: "QBtn"},
+		{ComponentName: "q-btn", CanonicalName: "QBtn"},
+		{ComponentName: "q-form"},
+		{ComponentName: "MyBtn", CanonicalName: "MyBtn", ResolvedName: "QBtn"},
+		{ComponentName: "my-form", CanonicalName: "MyForm", ResolvedName: "q-form"},
+	}
+
+	counts := countByCanonicalName(matches)
+	if len(counts) != 2 || counts["QBtn"] != 4 || counts["QForm"] != 2 {
+		t.Errorf("countByCanonicalName() = %v, want map[QBtn:4 QForm:2]", counts)
+	}
+	if counts := countByCanonicalName(nil); counts != nil {
+		t.Errorf("countByCanonicalName(nil) = %v, want nil", counts)
+	}
+}
@@ -0,0 +1,175 @@
+package scanner
+
+import (
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// attachProps fills the Props of every match with the attributes of its tag
+// The tag is located on the match line by its name (<Name); matches without
+// a tag on that line (definitions, lazy imports, render calls) are left untouched.
+func attachProps(matches []types.ComponentMatch, content string) {
+	lineStarts := lineStartOffsets(content)
+
+	for i := range matches {
+		line := matches[i].Line
+		if line < 1 || line > len(lineStarts) {
+			continue
+		}
+
+		lineEnd := len(content)
+		if line < len(lineStarts) {
+			lineEnd = lineStarts[line]
+		}
+		tagStart := findTagStart(content[lineStarts[line-1]:lineEnd], matches[i].ComponentName)
+		if tagStart < 0 {
+			continue
+		}
+
+		nameEnd := lineStarts[line-1] + tagStart + 1 + len(matches[i].ComponentName)
+		if nameEnd < len(content) && content[nameEnd] == '<' {
+			// Type arguments of a generic component: <List<Item> items={items}>
+			if end := findTypeArgumentsEnd(content, nameEnd); end > 0 {
+				nameEnd = end
+			}
+		}
+		if props := parseTagProps(content, nameEnd); len(props) > 0 {
+			matches[i].Props = props
+		}
+	}
+}
+
+// lineStartOffsets returns the byte offset at which each line of content starts
+func lineStartOffsets(content string) []int {
+	offsets := []int{0}
+	for i := 0; i < len(content); i++ {
+		if content[i] == '\n' {
+			offsets = append(offsets, i+1)
+		}
+	}
+	return offsets
+}
+
+// findTagStart returns the index of the opening tag "<name" in line, or -1
+// The name must not be followed by further name characters (<Button vs <ButtonGroup)
+func findTagStart(line string, name string) int {
+	tag := "<" + name
+	offset := 0
+	for {
+		idx := strings.Index(line[offset:], tag)
+		if idx < 0 {
+			return -1
+		}
+		idx += offset
+
+		end := idx + len(tag)
+		if end == len(line) || !isTagNameChar(line[end]) {
+			return idx
+		}
+		offset = end
+	}
+}
+
+// isTagNameChar checks if c can be part of a tag name
+func isTagNameChar(c byte) bool {
+	return isIdentPart(c) || c == '-' || c == '.' || c == ':'
+}
+
+// parseTagProps parses the attributes of a tag from pos (just after the tag name) up to its end
+// Quoted values are unquoted, expression values ({...}, {{...}}) are kept as written,
+// boolean attributes map to "" and JSX spreads ({...props}) are keyed "...".
+func parseTagProps(content string, pos int) map[string]string {
+	props := make(map[string]string)
+
+	for pos < len(content) {
+		c := content[pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pos++
+
+		case c == '>' || strings.HasPrefix(content[pos:], "/>"):
+			return props
+
+		case c == '{':
+			end := findBalancedBraceEnd(content, pos)
+			expr := content[pos:end]
+			if inner := strings.TrimSpace(strings.Trim(expr, "{}")); strings.HasPrefix(inner, "...") {
+				props["..."] = strings.TrimPrefix(inner, "...")
+			}
+			pos = end
+
+		default:
+			nameStart := pos
+			for pos < len(content) && !strings.ContainsRune(" \t\r\n=>", rune(content[pos])) && !strings.HasPrefix(content[pos:], "/>") {
+				pos++
+			}
+			name := content[nameStart:pos]
+
+			valueStart := pos
+			for valueStart < len(content) && (content[valueStart] == ' ' || content[valueStart] == '\t') {
+				valueStart++
+			}
+			if valueStart >= len(content) || content[valueStart] != '=' {
+				props[name] = ""
+				continue
+			}
+
+			value, end := parsePropValue(content, valueStart+1)
+			props[name] = value
+			pos = end
+		}
+	}
+
+	return props
+}
+
+// parsePropValue parses an attribute value starting at pos, returning it and the offset after it
+func parsePropValue(content string, pos int) (string, int) {
+	for pos < len(content) && (content[pos] == ' ' || content[pos] == '\t') {
+		pos++
+	}
+	if pos >= len(content) {
+		return "", pos
+	}
+
+	switch c := content[pos]; c {
+	case '"', '\'':
+		end := strings.IndexByte(content[pos+1:], c)
+		if end < 0 {
+			return content[pos+1:], len(content)
+		}
+		return content[pos+1 : pos+1+end], pos + end + 2
+	case '{':
+		end := findBalancedBraceEnd(content, pos)
+		return content[pos:end], end
+	default:
+		end := pos
+		for end < len(content) && !strings.ContainsRune(" \t\r\n>", rune(content[end])) && !strings.HasPrefix(content[end:], "/>") {
+			end++
+		}
+		return content[pos:end], end
+	}
+}
+
+// findBalancedBraceEnd returns the offset after the brace closing the one at pos
+// Quoted strings inside the expression are skipped
+func findBalancedBraceEnd(content string, pos int) int {
+	depth := 0
+	for i := pos; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case '\'', '"', '`':
+			if end := strings.IndexByte(content[i+1:], content[i]); end >= 0 {
+				i += end + 1
+			}
+		}
+	}
+	return len(content)
+}
@@ -0,0 +1,68 @@
+package scanner
+
+import (
+	"reflect"
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestAttachProps(t *testing.T) {
+	content := `<Button variant="danger" disabled onClick={() => save({ id: 1 })} {...rest}>Delete</Button>
+<q-btn :label="$t('save')" color='primary' flat />
+<ButtonGroup size=small><Button
+  type="submit"
+  size="large"
+/></ButtonGroup>
+<MyInput @value={{this.name}} />
+export default defineComponent({ name: 'Button' })
+<Select<Option, false> options={options} isMulti={false} />`
+
+	matches := []types.ComponentMatch{
+		{ComponentName: "Button", Line: 1},
+		{ComponentName: "q-btn", Line: 2},
+		{ComponentName: "Button", Line: 3},
+		{ComponentName: "MyInput", Line: 7},
+		{ComponentName: "Button", Line: 8},
+		{ComponentName: "Select", Line: 9},
+	}
+
+	attachProps(matches, content)
+
+	expected := []map[string]string{
+		{"variant": "danger", "disabled": "", "onClick": "{() => save({ id: 1 })}", "...": "rest"},
+		{":label": "$t('save')", "color": "primary", "flat": ""},
+		{"type": "submit", "size": "large"},
+		{"@value": "{{this.name}}"},
+		nil,
+		{"options": "{options}", "isMulti": "{false}"},
+	}
+
+	for i := range matches {
+		if !reflect.DeepEqual(matches[i].Props, expected[i]) {
+			t.Errorf("Match %d (%s): Props = %v, want %v", i, matches[i].ComponentName, matches[i].Props, expected[i])
+		}
+	}
+}
+
+func TestFindTagStart(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		tag      string
+		expected int
+	}{
+		{"simple tag", `  <Button />`, "Button", 2},
+		{"skips longer names", `<ButtonGroup><Button />`, "Button", 13},
+		{"member expression", `<Form.Item label="x">`, "Form.Item", 0},
+		{"no tag", `const Button = styled.button`, "Button", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := findTagStart(tt.line, tt.tag); result != tt.expected {
+				t.Errorf("findTagStart(%q, %q) = %d, want %d", tt.line, tt.tag, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// pugTagRegex matches a tag name at the start of a Pug line (after indentation)
+var pugTagRegex = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9-]*)`)
+
+// parsePugTemplateComponents extracts component usage from Pug template content
+// Pug is indentation-based, so tags appear at the start of a line
+// (e.g. q-btn(label="Save")), optionally chained with block expansion (li: q-btn).
+// Piped text, comments, code lines, mixins and text blocks are skipped.
+func parsePugTemplateComponents(templateContent string, filePath string, baseLineNumber int, htmlTags htmlTagSet) []types.ComponentMatch {
+	var matches []types.ComponentMatch
+
+	lines := strings.Split(templateContent, "\n")
+	parenDepth := 0       // Open attribute parentheses carried over from previous lines
+	textBlockIndent := -1 // Indentation of a tag introducing a text block (e.g. "script.")
+
+	for lineIdx, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+		indent := len(line) - len(trimmed)
+
+		// Continue multi-line attribute lists
+		if parenDepth > 0 {
+			parenDepth = skipPugAttributes(trimmed, 0, parenDepth)
+			continue
+		}
+
+		// Skip the content of text blocks
+		if textBlockIndent >= 0 {
+			if trimmed == "" || indent > textBlockIndent {
+				continue
+			}
+			textBlockIndent = -1
+		}
+
+		if trimmed == "" || isPugNonTagLine(trimmed) {
+			continue
+		}
+
+		// Inline HTML is allowed in Pug templates
+		if strings.HasPrefix(trimmed, "<") {
+			matches = append(matches, parseTemplateComponents(trimmed, filePath, baseLineNumber+lineIdx, htmlTags)...)
+			continue
+		}
+
+		seen := make(map[string]bool)
+		rest := trimmed
+		for {
+			tagMatch := pugTagRegex.FindString(rest)
+			if tagMatch == "" {
+				break
+			}
+
+			if !htmlTags.contains(tagMatch) && !seen[tagMatch] {
+				seen[tagMatch] = true
+				matches = append(matches, types.ComponentMatch{
+					FilePath:      filePath,
+					Line:          baseLineNumber + lineIdx,
+					ComponentName: tagMatch,
+					ComponentType: "", // Will be set by scanner based on registry
+				})
+			}
+
+			// Skip classes, ids and attributes attached to the tag
+			pos := len(tagMatch)
+			for pos < len(rest) {
+				if rest[pos] == '(' {
+					parenDepth = skipPugAttributes(rest, pos, 0)
+					if parenDepth > 0 {
+						break
+					}
+					pos = pugAttributesEnd(rest, pos)
+					continue
+				}
+				if rest[pos] == '.' || rest[pos] == '#' || rest[pos] == '-' || isWordByte(rest[pos]) {
+					pos++
+					continue
+				}
+				break
+			}
+			if parenDepth > 0 {
+				break
+			}
+
+			// A trailing dot introduces a block of plain text
+			if pos == len(rest) && strings.HasSuffix(rest, ".") {
+				textBlockIndent = indent
+				break
+			}
+
+			// Block expansion: "li: q-btn"
+			if strings.HasPrefix(rest[pos:], ": ") {
+				rest = strings.TrimLeft(rest[pos+1:], " ")
+				continue
+			}
+			break
+		}
+	}
+
+	return matches
+}
+
+// isPugNonTagLine reports whether a trimmed Pug line cannot start with a tag
+func isPugNonTagLine(trimmed string) bool {
+	for _, prefix := range []string{"|", "//", "-", "+", "=", "!=", "#[", "doctype", "include", "extends", "block "} {
+		if strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// skipPugAttributes scans line from pos counting parentheses (ignoring quoted strings)
+// and returns the parenthesis depth still open at the end of the line
+func skipPugAttributes(line string, pos int, depth int) int {
+	var quote byte
+	for i := pos; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return 0
+			}
+		}
+	}
+	return depth
+}
+
+// pugAttributesEnd returns the index just past the parenthesis closing the
+// attribute list that starts at pos (which must be an opening parenthesis)
+func pugAttributesEnd(line string, pos int) int {
+	depth := 0
+	var quote byte
+	for i := pos; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(line)
+}
+
+// isWordByte reports whether c can be part of a Pug class or id name
+func isWordByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,60 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestVueParser_Parse_PugTemplate(t *testing.T) {
+	parser := NewVueParser()
+
+	content := `<template lang="pug">
+div.page
+  q-form(@submit="onSubmit")
+    q-input(
+      v-model="name"
+      label="Name (required)"
+    )
+    q-btn.full-width(type="submit" label="Save")
+  //- q-dialog commented out
+  ul
+    li: q-btn(flat) Cancel
+  | some text mentioning q-card
+  MyDialog#confirm(v-model="open")
+  script.
+    var x = 1
+  span Done
+</template>
+
+<script>
+export default {}
+</script>`
+
+	matches, err := parser.Parse(content, "Page.vue")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"q-form", 3},
+		{"q-input", 4},
+		{"q-btn", 8},
+		{"q-btn", 11},
+		{"MyDialog", 13},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, exp.name)
+		}
+		if matches[i].Line != exp.line {
+			t.Errorf("Match %d (%s): got line %d, want %d", i, exp.name, matches[i].Line, exp.line)
+		}
+	}
+}
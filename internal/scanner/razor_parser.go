@@ -0,0 +1,99 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// RazorParser parses Blazor/Razor files (.razor and .cshtml files)
+// Extracts PascalCase component tags (e.g. <MudButton>) while skipping
+// @code/@functions/@{ } code blocks and @* *@ comments
+type RazorParser struct{}
+
+// NewRazorParser creates a new RazorParser instance
+func NewRazorParser() *RazorParser {
+	return &RazorParser{}
+}
+
+// razorCodeBlockRegex matches the start of a Razor code block up to its opening brace
+var razorCodeBlockRegex = regexp.MustCompile(`@(?:code|functions)\s*\{|@\{`)
+
+// SupportsFile checks if the file is a .razor or .cshtml file
+func (p *RazorParser) SupportsFile(filePath string) bool {
+	lowerPath := strings.ToLower(filePath)
+	return strings.HasSuffix(lowerPath, ".razor") || strings.HasSuffix(lowerPath, ".cshtml")
+}
+
+// Parse extracts component matches from Razor file content
+func (p *RazorParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	content := maskRegions(fileContent, "@*", "*@")
+	content = maskRazorCodeBlocks(content)
+
+	return collectTagMatches(content, filePath, 1, nil, jsxTagRegex), nil
+}
+
+// maskRazorCodeBlocks blanks out @code { }, @functions { } and @{ } blocks
+func maskRazorCodeBlocks(content string) string {
+	buf := []byte(content)
+	offset := 0
+	for {
+		loc := razorCodeBlockRegex.FindStringIndex(content[offset:])
+		if loc == nil {
+			break
+		}
+		start := offset + loc[0]
+		openBrace := offset + loc[1] - 1
+
+		end := findClosingBrace(content, openBrace)
+		if end < 0 {
+			end = len(content)
+		} else {
+			end++
+		}
+
+		blank(buf, start, end)
+		offset = end
+	}
+	return string(buf)
+}
+
+// findClosingBrace returns the index of the brace matching the one at openIdx,
+// skipping C#/JS string literals and comments, or -1 if it is never closed
+func findClosingBrace(content string, openIdx int) int {
+	depth := 0
+	for i := openIdx; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		case '"', '\'':
+			quote := content[i]
+			for i++; i < len(content) && content[i] != quote && content[i] != '\n'; i++ {
+				if content[i] == '\\' {
+					i++
+				}
+			}
+		case '/':
+			if strings.HasPrefix(content[i:], "//") {
+				if newline := strings.IndexByte(content[i:], '\n'); newline >= 0 {
+					i += newline
+				} else {
+					i = len(content)
+				}
+			} else if strings.HasPrefix(content[i:], "/*") {
+				if end := strings.Index(content[i+2:], "*/"); end >= 0 {
+					i += end + 3
+				} else {
+					i = len(content)
+				}
+			}
+		}
+	}
+	return -1
+}
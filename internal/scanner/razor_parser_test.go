@@ -0,0 +1,85 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestRazorParser_SupportsFile(t *testing.T) {
+	parser := NewRazorParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"razor component", "Pages/Counter.razor", true},
+		{"razor page", "Pages/Index.cshtml", true},
+		{"uppercase extension", "Pages/Index.CSHTML", true},
+		{"csharp file", "Services/UserService.cs", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRazorParser_Parse(t *testing.T) {
+	parser := NewRazorParser()
+
+	content := `@page "/users"
+@inject IDialogService DialogService
+
+@* <MudDialog>commented out</MudDialog> *@
+<MudForm @ref="form">
+    <MudTextField T="string" Label="Name" @bind-Value="name" />
+    @if (canSave)
+    {
+        <MudButton OnClick="Save">Save</MudButton>
+    }
+</MudForm>
+
+@code {
+    private string name = "<NotAComponent>";
+    private MudForm form;
+
+    private RenderFragment Fragment => builder => { };
+
+    private async Task Save()
+    {
+        var options = new DialogOptions { CloseButton = true };
+        await DialogService.ShowAsync<ConfirmDialog>("Confirm", options);
+    }
+}`
+
+	matches, err := parser.Parse(content, "Users.razor")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"MudForm", 5},
+		{"MudTextField", 6},
+		{"MudButton", 9},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, exp.name)
+		}
+		if matches[i].Line != exp.line {
+			t.Errorf("Match %d (%s): got line %d, want %d", i, exp.name, matches[i].Line, exp.line)
+		}
+	}
+}
@@ -8,64 +8,185 @@ import (
 )
 
 // ReactParser parses React component files (.jsx and .tsx files)
-// Extracts component usage from JSX elements
-type ReactParser struct{}
+// Extracts component usage from JSX elements. Plain .js and .ts files are
+// supported as well, but only parsed when a quick content sniff finds JSX.
+type ReactParser struct {
+	ignored map[string]bool // Component names never reported (e.g. platform primitives)
+	engine  string          // Parser engine, EngineTokenizer or EngineRegex
+}
+
+// Parser engines available for JSX parsing
+const (
+	// EngineTokenizer scans the JavaScript/TypeScript tokens with a heuristic lexer, not a full parser,
+	// skipping comments, strings and type arguments
+	EngineTokenizer = "tokenizer"
+	// EngineRegex matches JSX tags with regular expressions (legacy fallback)
+	EngineRegex = "regex"
+	// EngineLegacyAST is the former name of EngineTokenizer, still accepted
+	EngineLegacyAST = "ast"
+)
+
+// ReactNativePrimitives lists the React Native core layout and text primitives
+// With the react-native profile they are treated like HTML elements and not reported
+var ReactNativePrimitives = []string{
+	"View", "Text", "Image", "ImageBackground", "ScrollView", "FlatList", "SectionList",
+	"VirtualizedList", "SafeAreaView", "KeyboardAvoidingView", "StatusBar",
+}
 
 // NewReactParser creates a new ReactParser instance
 func NewReactParser() *ReactParser {
-	return &ReactParser{}
+	return &ReactParser{
+		ignored: make(map[string]bool),
+		engine:  EngineTokenizer,
+	}
 }
 
-// SupportsFile checks if the file is a .jsx or .tsx file
+// SetEngine selects the parser engine used for JSX (EngineTokenizer or EngineRegex)
+func (p *ReactParser) SetEngine(engine string) {
+	p.engine = engine
+}
+
+// IgnoreComponents excludes the given component names from the parse results
+func (p *ReactParser) IgnoreComponents(names ...string) {
+	for _, name := range names {
+		p.ignored[name] = true
+	}
+}
+
+// customElementTagRegex matches hyphenated custom element tags (e.g. <ion-button>)
+var customElementTagRegex = regexp.MustCompile(`<([a-z][a-z0-9]*(?:-[a-z0-9]+)+)(?:[\s>/]|$)`)
+
+// customElementNameRegex matches a complete hyphenated custom element name
+var customElementNameRegex = regexp.MustCompile(`^[a-z][a-z0-9]*(?:-[a-z0-9]+)+$`)
+
+// jsxSniffRegex matches the start of a capitalized JSX element or a closing tag
+var jsxSniffRegex = regexp.MustCompile(`<[A-Z][A-Za-z0-9]*[\s>/]|</[A-Za-z]`)
+
+// SupportsFile checks if the file is a .jsx, .tsx, .js or .ts file
 func (p *ReactParser) SupportsFile(filePath string) bool {
 	lowerPath := strings.ToLower(filePath)
-	return strings.HasSuffix(lowerPath, ".jsx") || strings.HasSuffix(lowerPath, ".tsx")
+	return strings.HasSuffix(lowerPath, ".jsx") || strings.HasSuffix(lowerPath, ".tsx") ||
+		isPlainScriptFile(lowerPath)
 }
 
 // Parse extracts component matches from React file content
-// Handles JSX syntax in both .jsx and .tsx files
+// Handles JSX syntax in .jsx and .tsx files, and in .js/.ts files that contain JSX
 func (p *ReactParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
-	return parseReactJSXComponents(fileContent, filePath, 1), nil
+	if isPlainScriptFile(strings.ToLower(filePath)) && !looksLikeJSX(fileContent) {
+		return nil, nil
+	}
+
+	return p.parseJSX(fileContent, filePath, 1), nil
 }
 
-// parseReactJSXComponents extracts component usage from JSX syntax
-// Handles JSX elements like <Component /> or <Component>
-func parseReactJSXComponents(content string, filePath string, baseLineNumber int) []types.ComponentMatch {
+// parseJSX extracts component matches from JSX source code with the configured engine
+// baseLineNumber is the line of the first line of content (e.g. of a Vue script block)
+func (p *ReactParser) parseJSX(fileContent string, filePath string, baseLineNumber int) []types.ComponentMatch {
+	if p.engine != EngineRegex {
+		return p.removeIgnored(parseReactJSXTokens(fileContent, filePath, baseLineNumber, jsxAcceptFunc(fileContent)))
+	}
+
+	// Tags inside string and template literals and TypeScript type arguments are not JSX
+	content := maskTypeArguments(maskStringLiterals(fileContent))
+
 	var matches []types.ComponentMatch
+	switch {
+	case isStencilComponent(fileContent):
+		matches = parseStencilComponents(content, filePath, baseLineNumber)
+	case isQwikComponent(fileContent):
+		matches = parseQwikComponents(content, filePath, baseLineNumber)
+	default:
+		matches = parseReactJSXComponents(content, filePath, baseLineNumber)
+	}
 
-	// Regex to match JSX component tags
-	// JSX components must start with uppercase letter
-	// Matches: <ComponentName followed by whitespace, >, /, or end of line
-	componentRegex := regexp.MustCompile(`<([A-Z][A-Za-z0-9]*)(?:[\s>/]|$)`)
-
-	lines := strings.Split(content, "\n")
-	seenComponents := make(map[string]map[int]bool) // Track component:line to avoid duplicates
-
-	for lineIdx, line := range lines {
-		componentMatches := componentRegex.FindAllStringSubmatch(line, -1)
-
-		for _, match := range componentMatches {
-			if len(match) >= 2 {
-				componentName := match[1]
-
-				// Skip if we've already seen this component on this line
-				if seenComponents[componentName] == nil {
-					seenComponents[componentName] = make(map[int]bool)
-				}
-				if seenComponents[componentName][lineIdx] {
-					continue
-				}
-				seenComponents[componentName][lineIdx] = true
-
-				matches = append(matches, types.ComponentMatch{
-					FilePath:      filePath,
-					Line:          baseLineNumber + lineIdx,
-					ComponentName: componentName,
-					ComponentType: "", // Will be set by scanner based on registry
-				})
-			}
+	return p.removeIgnored(matches)
+}
+
+// jsxAcceptFunc returns the element name filter used by the tokenizer engine
+// It mirrors the framework-specific rules of the regex engine: Stencil also
+// renders hyphenated custom elements, Qwik runtime built-ins are skipped
+func jsxAcceptFunc(content string) func(string) bool {
+	switch {
+	case isStencilComponent(content):
+		return func(name string) bool {
+			return isJSXComponentName(name) || customElementNameRegex.MatchString(name)
+		}
+	case isQwikComponent(content):
+		return func(name string) bool {
+			return isJSXComponentName(name) && !qwikBuiltins[name]
 		}
+	default:
+		return isJSXComponentName
 	}
+}
+
+// removeIgnored drops matches for components configured to be ignored
+func (p *ReactParser) removeIgnored(matches []types.ComponentMatch) []types.ComponentMatch {
+	if len(p.ignored) == 0 {
+		return matches
+	}
+
+	var kept []types.ComponentMatch
+	for _, match := range matches {
+		if !p.ignored[match.ComponentName] {
+			kept = append(kept, match)
+		}
+	}
+	return kept
+}
+
+// isStencilComponent checks if the content is a Stencil component
+// Stencil components import from @stencil/core and use the @Component decorator
+func isStencilComponent(content string) bool {
+	return strings.Contains(content, "@stencil/core") && strings.Contains(content, "@Component(")
+}
+
+// parseStencilComponents extracts component usage from Stencil render() JSX
+// In addition to PascalCase functional components, Stencil renders custom
+// elements by their hyphenated tag name (e.g. <ion-button>)
+func parseStencilComponents(content string, filePath string, baseLineNumber int) []types.ComponentMatch {
+	return collectTagMatches(content, filePath, baseLineNumber, nil, jsxTagRegex, customElementTagRegex)
+}
+
+// isPlainScriptFile checks if the (lowercased) path is a .js or .ts file
+func isPlainScriptFile(lowerPath string) bool {
+	return strings.HasSuffix(lowerPath, ".js") || strings.HasSuffix(lowerPath, ".ts")
+}
 
-	return matches
+// looksLikeJSX performs a cheap check for JSX syntax in script content
+// JSX requires either a self-closing element or a closing tag, so files
+// without "/>" or "</" are rejected before running the regex
+func looksLikeJSX(content string) bool {
+	if !strings.Contains(content, "/>") && !strings.Contains(content, "</") {
+		return false
+	}
+	return jsxSniffRegex.MatchString(content)
+}
+
+// qwikBuiltins lists components provided by the Qwik runtime itself
+// They are rendering primitives rather than UI components and are not reported
+var qwikBuiltins = map[string]bool{
+	"Slot": true, "Resource": true, "RenderOnce": true,
+	"SSRStream": true, "SSRStreamBlock": true, "SSRRaw": true, "SSRComment": true, "SSRHint": true,
+}
+
+// isQwikComponent checks if the content is a Qwik component file
+// Qwik components import from the Qwik packages and wrap components in component$()
+func isQwikComponent(content string) bool {
+	return strings.Contains(content, "component$(") &&
+		(strings.Contains(content, "@builder.io/qwik") || strings.Contains(content, "@qwik.dev/"))
+}
+
+// parseQwikComponents extracts component usage from Qwik JSX
+// Qwik runtime built-ins such as <Slot /> and <Resource /> are skipped
+func parseQwikComponents(content string, filePath string, baseLineNumber int) []types.ComponentMatch {
+	return collectTagMatches(content, filePath, baseLineNumber, func(name string) bool {
+		return qwikBuiltins[name]
+	}, jsxTagRegex)
+}
+
+// parseReactJSXComponents extracts component usage from JSX syntax
+// Handles JSX elements like <Component /> or <Component>
+func parseReactJSXComponents(content string, filePath string, baseLineNumber int) []types.ComponentMatch {
+	return collectTagMatches(content, filePath, baseLineNumber, nil, jsxTagRegex)
 }
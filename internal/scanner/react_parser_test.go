@@ -19,8 +19,10 @@ func TestReactParser_SupportsFile(t *testing.T) {
 		{"uppercase JSX", "Component.JSX", true},
 		{"uppercase TSX", "Component.TSX", true},
 		{"vue file", "component.vue", false},
-		{"js file", "component.js", false},
-		{"ts file", "component.ts", false},
+		{"js file", "component.js", true},
+		{"ts file", "component.ts", true},
+		{"uppercase JS", "Component.JS", true},
+		{"json file", "package.json", false},
 		{"no extension", "component", false},
 	}
 
@@ -200,6 +202,69 @@ export default myFunction;`,
 	}
 }
 
+func TestReactParser_Parse_PlainScriptFiles(t *testing.T) {
+	parser := NewReactParser()
+
+	tests := []struct {
+		name          string
+		filePath      string
+		content       string
+		expectedNames []string
+	}{
+		{
+			name:     "jsx in js file",
+			filePath: "src/App.js",
+			content: `export default function App() {
+  return <Button onClick={save}>Save</Button>;
+}`,
+			expectedNames: []string{"Button"},
+		},
+		{
+			name:     "jsx in ts file",
+			filePath: "src/App.ts",
+			content: `export const App = () => (
+  <Dialog open />
+);`,
+			expectedNames: []string{"Dialog"},
+		},
+		{
+			name:     "plain js without jsx",
+			filePath: "src/utils.js",
+			content: `export function compare(a, b) {
+  return a < b ? -1 : 1;
+}`,
+			expectedNames: nil,
+		},
+		{
+			name:     "ts generics without jsx",
+			filePath: "src/store.ts",
+			content: `const cache = new Map<String, Number>();
+export const items: Array<Item> = [];`,
+			expectedNames: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, tt.filePath)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(matches) != len(tt.expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d", len(matches), len(tt.expectedNames))
+			}
+
+			for i, expectedName := range tt.expectedNames {
+				if matches[i].ComponentName != expectedName {
+					t.Errorf("Match %d: got component name %q, want %q",
+						i, matches[i].ComponentName, expectedName)
+				}
+			}
+		})
+	}
+}
+
 func TestReactParser_Parse_TypeScript(t *testing.T) {
 	parser := NewReactParser()
 
@@ -227,9 +292,9 @@ const MyForm: React.FC<Props> = ({ title, onSubmit }) => {
     </Form>
   );
 };`,
-			// Note: Regex parser may match "Props" from React.FC<Props> - this is a known limitation
-			expectedCount: 5,
-			expectedNames: []string{"Props", "Form", "FormTitle", "Input", "Button"},
+			// Type arguments such as React.FC<Props> are not JSX elements
+			expectedCount: 4,
+			expectedNames: []string{"Form", "FormTitle", "Input", "Button"},
 		},
 		{
 			name: "generic components",
@@ -562,7 +627,7 @@ func TestReactParser_Parse_DuplicatesOnSameLine(t *testing.T) {
 	parser := NewReactParser()
 
 	content := `function App() {
-  return <Button>Click</Button><Button>Another</Button>;
+  return <><Button>Click</Button><Button>Another</Button></>;
 }`
 
 	matches, err := parser.Parse(content, "test.jsx")
@@ -573,7 +638,10 @@ func TestReactParser_Parse_DuplicatesOnSameLine(t *testing.T) {
 
 	// Should only count Button once per line (deduplication)
 	if len(matches) != 1 {
-		t.Errorf("Expected 1 match (deduplicated), got %d", len(matches))
+		t.Fatalf("Expected 1 match (deduplicated), got %d", len(matches))
+	}
+	if matches[0].Occurrences != 2 {
+		t.Errorf("Expected 2 occurrences on the line, got %d", matches[0].Occurrences)
 	}
 }
 
@@ -595,10 +663,350 @@ func TestReactParser_Parse_ComponentsInComments(t *testing.T) {
 		t.Fatalf("Parse() error = %v", err)
 	}
 
-	// Note: Our regex-based parser will find both (commented and active)
-	// This is a known limitation of regex parsing vs AST parsing
-	// For MVP, this is acceptable
-	if len(matches) < 1 {
-		t.Errorf("Expected at least 1 match, got %d", len(matches))
+	// The tokenizer engine skips the commented out element
+	if len(matches) != 1 || matches[0].Line != 5 {
+		t.Errorf("Expected 1 match on line 5, got %+v", matches)
+	}
+
+	// The regex engine finds both (commented and active), a known limitation
+	parser.SetEngine(EngineRegex)
+	matches, err = parser.Parse(content, "test.jsx")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(matches) != 2 {
+		t.Errorf("Expected 2 matches with the regex engine, got %d", len(matches))
+	}
+}
+
+func TestReactParser_Parse_TokenizerEngine(t *testing.T) {
+	parser := NewReactParser()
+
+	tests := []struct {
+		name          string
+		content       string
+		expectedNames []string
+		expectedLines []int
+	}{
+		{
+			name: "ignores comments and strings",
+			content: `// <Dialog /> is rendered lazily
+/* <Modal>
+</Modal> */
+const label = "<Tooltip />";
+const App = () => <Button title='<Icon />' />;`,
+			expectedNames: []string{"Button"},
+			expectedLines: []int{5},
+		},
+		{
+			name: "ignores TypeScript generics and comparisons",
+			content: `const ref = useRef<HTMLInputElement>(null);
+const map = new Map<string, Item>();
+const identity = <T,>(value: T) => value;
+if (count < Limit && Max > count) {}
+const App = () => <Form><Input /></Form>;`,
+			expectedNames: []string{"Form", "Input"},
+			expectedLines: []int{5, 5},
+		},
+		{
+			name: "elements inside expressions and template interpolations",
+			content: `const App = () => (
+  <Layout header={<Header />}>
+    {items.map((item) => <Card key={item.id} />)}
+    {` + "`${open ? <Dialog /> : ''}`" + `}
+  </Layout>
+);`,
+			expectedNames: []string{"Layout", "Header", "Card", "Dialog"},
+			expectedLines: []int{2, 2, 3, 4},
+		},
+		{
+			name: "regex literals containing tags",
+			content: `const tagRegex = /<Button[^>]*>/g;
+const App = () => <Dialog />;`,
+			expectedNames: []string{"Dialog"},
+			expectedLines: []int{2},
+		},
+		{
+			name: "generic component type arguments",
+			content: `const App = () => (
+  <Select<Option> options={options}>
+    <Option />
+  </Select>
+);`,
+			expectedNames: []string{"Select", "Option"},
+			expectedLines: []int{2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, "test.tsx")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(matches) != len(tt.expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(tt.expectedNames), matches)
+			}
+
+			for i := range tt.expectedNames {
+				if matches[i].ComponentName != tt.expectedNames[i] || matches[i].Line != tt.expectedLines[i] {
+					t.Errorf("Match %d: got %s at line %d, want %s at line %d",
+						i, matches[i].ComponentName, matches[i].Line, tt.expectedNames[i], tt.expectedLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReactParser_Parse_Stencil(t *testing.T) {
+	parser := NewReactParser()
+
+	content := `import { Component, h } from '@stencil/core';
+
+@Component({
+  tag: 'app-profile',
+  styleUrl: 'app-profile.css',
+})
+export class AppProfile {
+  render() {
+    return (
+      <ion-content>
+        <ion-button onClick={() => this.save()}>Save</ion-button>
+        <ProfileCard />
+        <div class="footer"></div>
+      </ion-content>
+    );
+  }
+}`
+
+	matches, err := parser.Parse(content, "app-profile.tsx")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expectedNames := []string{"ion-content", "ion-button", "ProfileCard"}
+	if len(matches) != len(expectedNames) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expectedNames), matches)
+	}
+
+	for i, expectedName := range expectedNames {
+		if matches[i].ComponentName != expectedName {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+		}
+	}
+
+	// Outside of Stencil components, hyphenated tags are not JSX components
+	matches, err = parser.Parse(`const App = () => <ion-button>Save</ion-button>;`, "App.tsx")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(matches) != 0 {
+		t.Errorf("Expected no matches outside Stencil components, got %+v", matches)
+	}
+}
+
+func TestReactParser_Parse_Qwik(t *testing.T) {
+	parser := NewReactParser()
+
+	content := `import { component$, Slot, Resource, useResource$ } from '@builder.io/qwik';
+import { Form } from '@builder.io/qwik-city';
+
+export default component$(() => {
+  const user = useResource$(() => fetchUser());
+  return (
+    <Card>
+      <Form action={save} onSubmitCompleted$={() => reset()}>
+        <Button onClick$={() => submit()}>Save</Button>
+      </Form>
+      <Resource value={user} onResolved={(u) => <UserName user={u} />} />
+      <Slot />
+    </Card>
+  );
+});`
+
+	matches, err := parser.Parse(content, "profile.tsx")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expectedNames := []string{"Card", "Form", "Button", "UserName"}
+	if len(matches) != len(expectedNames) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expectedNames), matches)
+	}
+
+	for i, expectedName := range expectedNames {
+		if matches[i].ComponentName != expectedName {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+		}
+	}
+}
+
+func TestReactParser_IgnoreComponents(t *testing.T) {
+	content := `import { View, Text, Pressable } from 'react-native';
+
+export function Card() {
+  return (
+    <View style={styles.card}>
+      <Text>Title</Text>
+      <Pressable onPress={open}>
+        <Text>Open</Text>
+      </Pressable>
+    </View>
+  );
+}`
+
+	parser := NewReactParser()
+	matches, err := parser.Parse(content, "Card.tsx")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(matches) != 4 {
+		t.Fatalf("Expected 4 matches without ignored components, got %d", len(matches))
+	}
+
+	parser.IgnoreComponents(ReactNativePrimitives...)
+	matches, err = parser.Parse(content, "Card.tsx")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(matches) != 1 || matches[0].ComponentName != "Pressable" {
+		t.Errorf("Expected only Pressable with react-native primitives ignored, got %+v", matches)
+	}
+}
+
+func TestReactParser_Parse_StringLiterals(t *testing.T) {
+	content := `const warning = "<Button> is deprecated";
+const hint = '<Dialog /> opens on click';
+const html = ` + "`<Modal>${open ? <Spinner /> : ''}</Modal>`" + `;
+const App = () => <p>Don't forget the <Form /></p>;`
+
+	for _, engine := range []string{EngineTokenizer, EngineRegex} {
+		t.Run(engine, func(t *testing.T) {
+			parser := NewReactParser()
+			parser.SetEngine(engine)
+
+			matches, err := parser.Parse(content, "test.tsx")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			expectedNames := []string{"Spinner", "Form"}
+			if len(matches) != len(expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expectedNames), matches)
+			}
+			for i, expectedName := range expectedNames {
+				if matches[i].ComponentName != expectedName {
+					t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+				}
+			}
+		})
+	}
+}
+
+func TestReactParser_Parse_MemberExpressions(t *testing.T) {
+	content := `const Settings = () => (
+  <Form layout="vertical">
+    <Form.Item label="Name"><Input /></Form.Item>
+    <Menu.Item.Icon />
+    <motion.div animate={{ x: 100 }} />
+  </Form>
+);`
+
+	for _, engine := range []string{EngineTokenizer, EngineRegex} {
+		t.Run(engine, func(t *testing.T) {
+			parser := NewReactParser()
+			parser.SetEngine(engine)
+
+			matches, err := parser.Parse(content, "Settings.tsx")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			expectedNames := []string{"Form", "Form.Item", "Input", "Menu.Item.Icon"}
+			if len(matches) != len(expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expectedNames), matches)
+			}
+			for i, expectedName := range expectedNames {
+				if matches[i].ComponentName != expectedName {
+					t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+				}
+			}
+		})
+	}
+}
+
+func TestReactParser_Parse_TypeScriptGenerics(t *testing.T) {
+	content := `import { useRef, useState } from 'react';
+const ref = useRef<Map<string, number>>();
+const [items, setItems] = useState<Array<Item>>([]);
+const identity = <T,>(x: T) => x;
+const first = <T extends Item>(list: T[]) => list[0];
+function wrap<Value>(value: Value): Box<Value> { return { value } }
+const Page = () => <Layout><Sidebar /></Layout>;`
+
+	for _, engine := range []string{EngineTokenizer, EngineRegex} {
+		t.Run(engine, func(t *testing.T) {
+			parser := NewReactParser()
+			parser.SetEngine(engine)
+
+			matches, err := parser.Parse(content, "Page.tsx")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			expectedNames := []string{"Layout", "Sidebar"}
+			if len(matches) != len(expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expectedNames), matches)
+			}
+			for i, expectedName := range expectedNames {
+				if matches[i].ComponentName != expectedName || matches[i].Line != 7 {
+					t.Errorf("Match %d: got %s:%d, want %s:7", i, matches[i].ComponentName, matches[i].Line, expectedName)
+				}
+			}
+		})
+	}
+}
+
+func TestReactParser_Parse_GenericComponents(t *testing.T) {
+	content := `const Users = () => (
+  <List<User> items={users} render={(user) => <Avatar user={user} />} />
+);
+const Picker = () => (
+  <Select<Option, false>
+    options={options}
+  >
+    <Table.Body<Row> rows={rows}></Table.Body>
+  </Select>
+);`
+
+	for _, engine := range []string{EngineTokenizer, EngineRegex} {
+		t.Run(engine, func(t *testing.T) {
+			parser := NewReactParser()
+			parser.SetEngine(engine)
+
+			matches, err := parser.Parse(content, "Users.tsx")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			expected := []struct {
+				name string
+				line int
+			}{
+				{"List", 2},
+				{"Avatar", 2},
+				{"Select", 5},
+				{"Table.Body", 8},
+			}
+			if len(matches) != len(expected) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+			}
+			for i, exp := range expected {
+				if matches[i].ComponentName != exp.name || matches[i].Line != exp.line {
+					t.Errorf("Match %d: got %s:%d, want %s:%d", i, matches[i].ComponentName, matches[i].Line, exp.name, exp.line)
+				}
+			}
+		})
 	}
 }
@@ -2,10 +2,20 @@
 package scanner
 
 import (
+	"fmt"
+	"log/slog"
+	"maps"
 	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"ui-elf/internal/project"
 	"ui-elf/internal/registry"
 	"ui-elf/internal/types"
 )
@@ -15,72 +25,218 @@ import (
 type ComponentScanner struct {
 	parsers  []ComponentParser
 	registry *registry.ComponentMappingRegistry
+	options  types.ScanOptions
+	logger   *slog.Logger
 }
 
-// NewComponentScanner creates a new scanner with the given parsers
+// NewComponentScanner creates a new scanner with the given parsers, logging to the default logger
 func NewComponentScanner(parsers []ComponentParser, reg *registry.ComponentMappingRegistry) *ComponentScanner {
 	return &ComponentScanner{
 		parsers:  parsers,
 		registry: reg,
+		logger:   slog.Default(),
 	}
 }
 
+// SetOptions configures optional scanner behavior
+// The HTML tags of the tag policy are skipped by the template parsers
+func (s *ComponentScanner) SetOptions(options types.ScanOptions) {
+	s.options = options
+
+	htmlTags := newHTMLTagSet(options.TagPolicy)
+	for _, parser := range s.parsers {
+		if p, ok := parser.(htmlTagParser); ok {
+			p.setHTMLTags(htmlTags)
+		}
+	}
+}
+
+// SetLogger sets the logger receiving per-file progress and the files skipped
+func (s *ComponentScanner) SetLogger(logger *slog.Logger) {
+	s.logger = logger
+}
+
 // Scan processes all files concurrently and returns aggregated results
-// Filters matches by component type using the registry
+// Filters matches by component type using the registry; a type group matches its member types,
+// and the all type keeps every component found
 func (s *ComponentScanner) Scan(files []string, componentType string) (*types.ScanResult, error) {
 	startTime := time.Now()
 
-	// Channel to collect matches from all goroutines
-	matchChan := make(chan []types.ComponentMatch, len(files))
+	// Files are processed by a pool of workers, NumCPU unless configured
+	workers := s.options.Concurrency
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
 
-	// WaitGroup to track completion of all goroutines
-	var wg sync.WaitGroup
+	// Components registered application-wide can be used without an import in Vue templates
+	var globals *globalRegistrations
+	sources := &sync.Map{}
+	if s.parsesVueFiles(files) {
+		globals, sources = collectGlobalRegistrations(files, workers)
+	}
 
-	// Process files concurrently
-	for _, filePath := range files {
-		wg.Add(1)
-		go func(path string) {
-			defer wg.Done()
+	// Types matched: every registry type for an inventory, the members of a group, or the requested type
+	inventory := componentType == types.ComponentTypeAll
+	memberTypes, isGroup := s.registry.GroupMembers(componentType)
+	if inventory {
+		memberTypes = s.registry.Types()
+	} else if !isGroup {
+		memberTypes = []string{componentType}
+	}
 
-			// Find appropriate parser for this file
-			var parser ComponentParser
-			for _, p := range s.parsers {
-				if p.SupportsFile(path) {
-					parser = p
-					break
-				}
-			}
+	// API calls creating components of the matched types programmatically
+	imperativeCalls := make(map[string][]imperativeCall)
+	for _, memberType := range memberTypes {
+		if calls := s.registry.ImperativeCalls(memberType); len(calls) > 0 {
+			imperativeCalls[memberType] = compileImperativeCalls(calls)
+		}
+	}
+
+	// scanFile parses a file and returns its matches of the requested types
+	scanFile := func(path string) []types.ComponentMatch {
+		// Path reported for the matches, in the configured style
+		filePath := FormatPath(path, s.options.PathStyle, s.options.RepoRoot)
 
-			if parser == nil {
-				// No parser supports this file, skip it
-				matchChan <- nil
-				return
+		// Find all parsers that support this file, or the file type it is parsed as
+		parserPath := s.parserPath(path)
+		var fileParsers []ComponentParser
+		for _, p := range s.parsers {
+			if p.SupportsFile(parserPath) {
+				fileParsers = append(fileParsers, p)
 			}
+		}
 
-			// Read file content
-			content, err := os.ReadFile(path)
-			if err != nil {
+		if len(fileParsers) == 0 {
+			// No parser supports this file, skip it
+			s.logger.Debug("skipped file without parser", "path", path)
+			return nil
+		}
+
+		// Read file content, converted to UTF-8, unless script files were read for the global registrations
+		var content string
+		if source, read := sources.LoadAndDelete(path); read {
+			content = source.(string)
+		} else {
+			var err error
+			if content, err = readSourceFile(path); err != nil {
 				// Log error but continue with other files
-				// In production, we'd use a proper logger
-				matchChan <- nil
-				return
+				s.logger.Warn("skipped unreadable file", "path", path, "error", err)
+				return nil
 			}
+		}
+
+		// Skip minified and generated files unless requested
+		if !s.options.IncludeGenerated && IsGeneratedContent(content) {
+			s.logger.Debug("skipped generated file", "path", path)
+			return nil
+		}
 
-			// Parse the file
-			matches, err := parser.Parse(string(content), path)
+		// Parse the file with every supporting parser
+		var matches []types.ComponentMatch
+		for _, parser := range fileParsers {
+			parserMatches, err := parser.Parse(content, parserPath)
 			if err != nil {
-				// Log error but continue with other files
-				matchChan <- nil
-				return
+				// Log error but continue with other parsers
+				s.logger.Warn("failed to parse file", "path", path, "parser", fmt.Sprintf("%T", parser), "error", err)
+				continue
+			}
+			for i := range parserMatches {
+				parserMatches[i].FilePath = filePath
+			}
+			matches = append(matches, parserMatches...)
+		}
+		if len(fileParsers) > 1 {
+			matches = dedupeMatches(matches)
+		}
+		matches = applyTagPolicy(matches, s.options.TagPolicy)
+
+		// Flag usage inside Storybook stories and tests
+		if IsStoryFile(path) {
+			for i := range matches {
+				matches[i].Story = true
+			}
+		}
+		if IsTestFile(path) {
+			for i := range matches {
+				matches[i].Test = true
 			}
+		}
+
+		// Record the namespace of member-expression components (Form.Item)
+		// and the canonical name shared by all casings (q-btn, QBtn)
+		for i := range matches {
+			matches[i].Namespace = componentNamespace(matches[i].ComponentName)
+			matches[i].CanonicalName = canonicalComponentName(matches[i].ComponentName)
+		}
+
+		// Attach import information (source library, original name of aliases)
+		resolveImports(matches, content)
+		if globals != nil {
+			globals.attribute(matches)
+		}
 
-			// Filter matches by component type
-			filteredMatches := s.filterByComponentType(matches, componentType)
-			matchChan <- filteredMatches
-		}(filePath)
+		// Exclude or classify SVG primitives, which are known once imports are resolved
+		matches = applySVGPolicy(matches, s.options.TagPolicy.SVG)
+
+		// Filter matches by component type
+		var filteredMatches []types.ComponentMatch
+		if inventory {
+			filteredMatches = s.inventoryMatches(matches, memberTypes)
+		} else {
+			filteredMatches = s.filterByComponentType(matches, memberTypes...)
+		}
+		for _, memberType := range memberTypes {
+			for _, match := range findImperativeCalls(content, filePath, imperativeCalls[memberType]) {
+				match.ComponentType = memberType
+				match.CanonicalName = canonicalComponentName(match.ComponentName)
+				match.RegistryLibrary = s.registryLibrary(match, memberType)
+				if s.options.Explain {
+					match.Rule = s.matchRule(match, memberType)
+				}
+				filteredMatches = append(filteredMatches, match)
+			}
+		}
+		if s.options.WithProps {
+			attachProps(filteredMatches, content)
+		}
+		if s.options.Snippet {
+			attachSnippets(filteredMatches, content, s.options.ContextLines)
+		}
+		attachFingerprints(filteredMatches, content, s.fingerprintPath(path))
+		if !s.options.CountDuplicates {
+			for i := range filteredMatches {
+				filteredMatches[i].Occurrences = 0
+			}
+		}
+		s.logger.Debug("scanned file", "path", path, "matches", len(filteredMatches))
+		return filteredMatches
+	}
+
+	// Channel to collect matches from all workers
+	matchChan := make(chan []types.ComponentMatch, len(files))
+
+	// Feed the files to the pool of workers
+	paths := make(chan string)
+	go func() {
+		for _, filePath := range files {
+			paths <- filePath
+		}
+		close(paths)
+	}()
+
+	// WaitGroup to track completion of all workers
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range paths {
+				matchChan <- scanFile(path)
+			}
+		}()
 	}
 
-	// Close channel when all goroutines complete
+	// Close channel when all workers complete
 	go func() {
 		wg.Wait()
 		close(matchChan)
@@ -94,33 +250,401 @@ func (s *ComponentScanner) Scan(files []string, componentType string) (*types.Sc
 		}
 	}
 
+	// Order matches deterministically, whichever worker finished first
+	sortMatches(allMatches)
+
 	// Calculate scan time
 	scanTime := time.Since(startTime)
 
 	// Build result
 	result := &types.ScanResult{
-		Matches:       allMatches,
-		TotalCount:    len(allMatches),
-		ScanTimeMs:    scanTime.Milliseconds(),
-		ComponentType: componentType,
-		ScannedFiles:  len(files),
+		SchemaVersion:   types.SchemaVersion,
+		Matches:         allMatches,
+		TotalCount:      countOccurrences(allMatches),
+		ScanTimeMs:      scanTime.Milliseconds(),
+		ComponentType:   componentType,
+		ScannedFiles:    len(files),
+		ComponentCounts: countByCanonicalName(allMatches),
+		MatchMode:       s.registry.MatchMode(),
+	}
+	if isGroup {
+		result.TypeCounts = countByType(allMatches, memberTypes)
+	} else if inventory {
+		result.TypeCounts = countByType(allMatches, nil)
+	}
+	if s.options.Explain {
+		result.RuleCounts = countByRule(allMatches)
 	}
 
 	return result, nil
 }
 
-// filterByComponentType filters matches to only include those matching the component type
-// Sets the ComponentType field on matching components
-func (s *ComponentScanner) filterByComponentType(matches []types.ComponentMatch, componentType string) []types.ComponentMatch {
+// filterByComponentType filters matches to only include those matching one of the component types
+// Sets the ComponentType field to the first type matched, and the Replacement field on deprecated ones
+func (s *ComponentScanner) filterByComponentType(matches []types.ComponentMatch, componentTypes ...string) []types.ComponentMatch {
 	var filtered []types.ComponentMatch
 
 	for _, match := range matches {
-		if s.registry.MatchesComponentType(match.ComponentName, componentType) {
-			// Set the component type on the match
-			match.ComponentType = componentType
-			filtered = append(filtered, match)
+		replacement, deprecated := s.replacement(match)
+		for _, componentType := range componentTypes {
+			if (componentType == types.ComponentTypeCustom && s.matchesCustom(match)) ||
+				(componentType == types.ComponentTypeDeprecated && deprecated) ||
+				s.registry.MatchesComponentType(match.ComponentName, componentType) ||
+				(match.ResolvedName != "" && s.registry.MatchesComponentType(match.ResolvedName, componentType)) {
+				// Set the component type on the match
+				match.ComponentType = componentType
+				match.Replacement = replacement
+				match.RegistryLibrary = s.registryLibrary(match, componentType)
+				if s.options.Explain {
+					match.Rule = s.matchRule(match, componentType)
+				}
+				filtered = append(filtered, match)
+				break
+			}
 		}
 	}
 
 	return filtered
 }
+
+// matchesCustom checks if a match is of the custom type: a component named with --component-name
+// if any, whether imported or not, or else any component imported or registered in the file
+// Names match case-insensitively, and kebab-case names match their PascalCase form
+func (s *ComponentScanner) matchesCustom(match types.ComponentMatch) bool {
+	if len(s.options.ComponentNames) == 0 {
+		return match.Registered
+	}
+	for _, name := range s.options.ComponentNames {
+		name = canonicalComponentName(strings.TrimSpace(name))
+		if strings.EqualFold(match.CanonicalName, name) ||
+			(match.ResolvedName != "" && strings.EqualFold(canonicalComponentName(match.ResolvedName), name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// replacement returns the suggested replacement if the component of a match is deprecated
+// The original name of an aliased import is checked as well
+func (s *ComponentScanner) replacement(match types.ComponentMatch) (string, bool) {
+	if replacement, deprecated := s.registry.Replacement(match.ComponentName); deprecated {
+		return replacement, true
+	}
+	if match.ResolvedName != "" {
+		return s.registry.Replacement(match.ResolvedName)
+	}
+	return "", false
+}
+
+// inventoryMatches keeps every match except HTML element tags, for the all type; directive,
+// definition and other usages are components whatever their name (x-data="dropdown()")
+// Matches of a registry type are set to that type, the first in name order; others are custom
+func (s *ComponentScanner) inventoryMatches(matches []types.ComponentMatch, registryTypes []string) []types.ComponentMatch {
+	var kept []types.ComponentMatch
+	for _, match := range matches {
+		if match.UsageKind == "" && isIntrinsicElement(match.ComponentName) {
+			continue
+		}
+		if typed := s.filterByComponentType([]types.ComponentMatch{match}, registryTypes...); len(typed) == 1 {
+			kept = append(kept, typed[0])
+			continue
+		}
+		match.ComponentType = types.ComponentTypeCustom
+		match.Replacement, _ = s.replacement(match)
+		if s.options.Explain {
+			match.Rule = &types.MatchRule{Type: types.ComponentTypeCustom}
+		}
+		kept = append(kept, match)
+	}
+	return kept
+}
+
+// isIntrinsicElement checks if a tag name is an HTML element rather than a component:
+// a known HTML tag, or a lowercase name without hyphen, dot or colon (JSX intrinsic elements);
+// namespaced tags (<livewire:form>) are components
+func isIntrinsicElement(name string) bool {
+	return isHTMLTag(name) || (name == strings.ToLower(name) && !strings.ContainsAny(name, "-.$:"))
+}
+
+// registryLibrary returns the registry library whose pattern matched the component of a match
+// When several libraries list the name (Button), the one named in the import module is chosen,
+// the longest first ("react-native" over "native"); lowercase tags without import are native HTML.
+// Returns "" if the library cannot be told
+func (s *ComponentScanner) registryLibrary(match types.ComponentMatch, componentType string) string {
+	libraries := s.registry.MatchingLibraries(match.ComponentName, componentType)
+	if len(libraries) == 0 && match.ResolvedName != "" {
+		libraries = s.registry.MatchingLibraries(match.ResolvedName, componentType)
+	}
+	if len(libraries) <= 1 {
+		return strings.Join(libraries, "")
+	}
+
+	if match.Library != "" {
+		found := ""
+		for _, library := range libraries {
+			if strings.Contains(match.Library, library) && len(library) > len(found) {
+				found = library
+			}
+		}
+		return found
+	}
+	if match.ComponentName == strings.ToLower(match.ComponentName) && slices.Contains(libraries, registry.NativeLibrary) {
+		return registry.NativeLibrary
+	}
+	return ""
+}
+
+// matchRule returns the registry rule matching the component of a match for a component type
+// The pattern of the attributed registry library is preferred; otherwise the first library in
+// name order. The original name of an aliased import is checked if the name itself does not match
+func (s *ComponentScanner) matchRule(match types.ComponentMatch, componentType string) *types.MatchRule {
+	rule := &types.MatchRule{Type: componentType}
+	switch componentType {
+	case types.ComponentTypeCustom:
+		return rule
+	case types.ComponentTypeDeprecated:
+		rule.Pattern = match.ComponentName
+		if _, deprecated := s.registry.Replacement(match.ComponentName); !deprecated && match.ResolvedName != "" {
+			rule.Pattern = match.ResolvedName
+		}
+		return rule
+	}
+
+	patterns := s.registry.MatchingPatterns(match.ComponentName, componentType)
+	if len(patterns) == 0 && match.ResolvedName != "" {
+		patterns = s.registry.MatchingPatterns(match.ResolvedName, componentType)
+	}
+	if len(patterns) == 0 {
+		// Component types unknown to the registry match the component name itself
+		rule.Pattern = componentType
+		return rule
+	}
+
+	libraries := slices.Sorted(maps.Keys(patterns))
+	rule.Library = libraries[0]
+	for _, library := range libraries {
+		if name, _, _ := strings.Cut(library, "@"); name == match.RegistryLibrary {
+			rule.Library = library
+			break
+		}
+	}
+	rule.Pattern = patterns[rule.Library]
+	return rule
+}
+
+// countByRule counts component usages per registry rule, most used first, then by type, library and pattern
+func countByRule(matches []types.ComponentMatch) []types.RuleCount {
+	counts := make(map[types.MatchRule]int)
+	for _, match := range matches {
+		if match.Rule != nil {
+			counts[*match.Rule] += max(match.Occurrences, 1)
+		}
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+
+	ruleCounts := make([]types.RuleCount, 0, len(counts))
+	for rule, count := range counts {
+		ruleCounts = append(ruleCounts, types.RuleCount{MatchRule: rule, Count: count})
+	}
+	sort.Slice(ruleCounts, func(i, j int) bool {
+		a, b := ruleCounts[i], ruleCounts[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Library != b.Library {
+			return a.Library < b.Library
+		}
+		return a.Pattern < b.Pattern
+	})
+	return ruleCounts
+}
+
+// storyFileRegex matches Storybook stories files (e.g. Button.stories.tsx)
+var storyFileRegex = regexp.MustCompile(`\.stories\.(?:tsx|jsx|ts|js|vue)$`)
+
+// IsStoryFile checks if the file is a Storybook stories file
+func IsStoryFile(filePath string) bool {
+	return storyFileRegex.MatchString(strings.ToLower(filePath))
+}
+
+// testFileRegex matches test files: *.test.* and *.spec.* files, and the files of test, tests
+// and __tests__ directories
+var testFileRegex = regexp.MustCompile(`(?:^|/)(?:tests?|__tests__)/|\.(?:test|spec)\.[^/]+$`)
+
+// IsTestFile checks if the file is a test file
+func IsTestFile(filePath string) bool {
+	return testFileRegex.MatchString(filepath.ToSlash(strings.ToLower(filePath)))
+}
+
+// componentNamespace returns the object part of a dotted component name, or ""
+// e.g. "Dialog" for "Dialog.Trigger" and "Menu.Item" for "Menu.Item.Icon"
+func componentNamespace(componentName string) string {
+	if idx := strings.LastIndex(componentName, "."); idx > 0 {
+		return componentName[:idx]
+	}
+	return ""
+}
+
+// Styles of the file paths of matches
+const (
+	PathStyleRelative = "relative"  // Relative to the working directory
+	PathStyleAbsolute = "absolute"  // Absolute
+	PathStyleRepoRoot = "repo-root" // Relative to the root of the repository, ScanOptions.RepoRoot
+)
+
+// FormatPath returns path in the given style; relative styles use forward slashes, so that
+// results compare across machines and operating systems
+// The path is returned unchanged for an empty style, or when it cannot be resolved
+func FormatPath(path string, style string, repoRoot string) string {
+	if style == "" {
+		return path
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+
+	base := repoRoot
+	switch style {
+	case PathStyleAbsolute:
+		return absPath
+	case PathStyleRelative:
+		if base, err = os.Getwd(); err != nil {
+			return path
+		}
+	}
+	relPath, err := filepath.Rel(base, absPath)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(relPath)
+}
+
+// fingerprintPath returns the path of a file hashed into the fingerprints of its matches: relative
+// to the repository root, with forward slashes, whichever directory of the repository was scanned
+// The root is ScanOptions.RepoRoot, or else the git repository containing the file
+func (s *ComponentScanner) fingerprintPath(path string) string {
+	root := s.options.RepoRoot
+	if root == "" {
+		root = project.FindRepoRoot(filepath.Dir(path))
+	}
+	if root == "" {
+		return filepath.ToSlash(path)
+	}
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return FormatPath(path, PathStyleRepoRoot, absRoot)
+}
+
+// parsesVueFiles checks if a Vue parser supports any of the files, whose templates can use the
+// components registered globally
+func (s *ComponentScanner) parsesVueFiles(files []string) bool {
+	for _, parser := range s.parsers {
+		if _, isVue := parser.(*VueParser); !isVue {
+			continue
+		}
+		for _, file := range files {
+			if parser.SupportsFile(s.parserPath(file)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parserPath returns the path matched against the parsers of the file: path itself, or path with
+// the extension it is parsed as when its extension has an alias (Page.svelte as Page.vue)
+func (s *ComponentScanner) parserPath(path string) string {
+	ext := filepath.Ext(path)
+	if parsedAs, ok := s.options.ExtensionAliases[ext]; ok {
+		return strings.TrimSuffix(path, ext) + parsedAs
+	}
+	return path
+}
+
+// dedupeMatches removes matches reported more than once for the same component and line
+// This happens when several parsers support the same file (e.g. React and Lit for .js files),
+// possibly in different casings (<q-btn> and <QBtn>); the first spelling is kept
+func dedupeMatches(matches []types.ComponentMatch) []types.ComponentMatch {
+	seen := make(map[string]int) // Index of the kept match per key
+	var deduped []types.ComponentMatch
+
+	for _, match := range matches {
+		key := fmt.Sprintf("%s:%d:%s", canonicalComponentName(match.ComponentName), match.Line, match.UsageKind)
+		if idx, ok := seen[key]; ok {
+			// Parsers may count repeated tags on the line differently; keep the highest count
+			deduped[idx].Occurrences = max(deduped[idx].Occurrences, match.Occurrences)
+			continue
+		}
+		seen[key] = len(deduped)
+		deduped = append(deduped, match)
+	}
+
+	return deduped
+}
+
+// countByType counts component usages per component type, listing every given type
+func countByType(matches []types.ComponentMatch, componentTypes []string) map[string]int {
+	counts := make(map[string]int, len(componentTypes))
+	for _, componentType := range componentTypes {
+		counts[componentType] = 0
+	}
+	for _, match := range matches {
+		counts[match.ComponentType] += max(match.Occurrences, 1)
+	}
+	return counts
+}
+
+// CountByRoot counts the component usages and scanned files per scan root, in the order of roots
+// fileRoots maps every scanned file to the root it was discovered in
+func CountByRoot(matches []types.ComponentMatch, fileRoots map[string]string, roots []string) []types.RootCount {
+	counts := make([]types.RootCount, len(roots))
+	index := make(map[string]int, len(roots))
+	for i, root := range roots {
+		counts[i].Root = root
+		index[root] = i
+	}
+	for _, root := range fileRoots {
+		if i, ok := index[root]; ok {
+			counts[i].ScannedFiles++
+		}
+	}
+	for _, match := range matches {
+		if i, ok := index[fileRoots[match.FilePath]]; ok {
+			counts[i].Count += max(match.Occurrences, 1)
+		}
+	}
+	return counts
+}
+
+// sortMatches orders matches by file path, line and component name
+// Matches of the same component on a line keep the order their file was parsed in
+func sortMatches(matches []types.ComponentMatch) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.FilePath != b.FilePath {
+			return a.FilePath < b.FilePath
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.ComponentName < b.ComponentName
+	})
+}
+
+// countOccurrences counts the component usages of matches
+// Every match counts at least once; repeated components on a line count by their Occurrences
+func countOccurrences(matches []types.ComponentMatch) int {
+	total := 0
+	for _, match := range matches {
+		total += max(match.Occurrences, 1)
+	}
+	return total
+}
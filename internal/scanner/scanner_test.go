@@ -1,8 +1,13 @@
 package scanner
 
 import (
+	"bytes"
+	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"ui-elf/internal/registry"
@@ -243,4 +248,918 @@ func TestComponentScanner_filterByComponentType(t *testing.T) {
 			t.Errorf("Expected 'CustomWidget', got '%s'", filtered[0].ComponentName)
 		}
 	})
+
+	t.Run("reports deprecated components with their replacement", func(t *testing.T) {
+		reg := registry.NewComponentMappingRegistry()
+		reg.Deprecate("OldDialog", "AppModal")
+		reg.Deprecate("q-btn", "AppButton")
+		scanner := NewComponentScanner(nil, reg)
+
+		matches := []types.ComponentMatch{
+			{ComponentName: "OldDialog", FilePath: "test.vue", Line: 1},
+			{ComponentName: "LegacyModal", ResolvedName: "OldDialog", FilePath: "test.vue", Line: 2},
+			{ComponentName: "q-form", FilePath: "test.vue", Line: 3},
+			{ComponentName: "QBtn", FilePath: "test.vue", Line: 4},
+		}
+
+		filtered := scanner.filterByComponentType(matches, types.ComponentTypeDeprecated)
+		if len(filtered) != 2 {
+			t.Fatalf("Expected 2 deprecated matches, got %d: %+v", len(filtered), filtered)
+		}
+		for _, match := range filtered {
+			if match.Replacement != "AppModal" || match.ComponentType != types.ComponentTypeDeprecated {
+				t.Errorf("Match %s: Replacement = %q, ComponentType = %q, want AppModal, deprecated",
+					match.ComponentName, match.Replacement, match.ComponentType)
+			}
+		}
+
+		// Deprecated components are annotated in regular scans as well
+		filtered = scanner.filterByComponentType([]types.ComponentMatch{{ComponentName: "q-btn", Line: 1}}, "button")
+		if len(filtered) != 1 || filtered[0].Replacement != "AppButton" {
+			t.Errorf("Expected q-btn to be a button replaced by AppButton, got %+v", filtered)
+		}
+	})
+}
+
+func TestComponentScanner_registryLibrary(t *testing.T) {
+	scanner := NewComponentScanner(nil, registry.NewComponentMappingRegistry())
+
+	tests := []struct {
+		name     string
+		match    types.ComponentMatch
+		expected string
+	}{
+		{"single library", types.ComponentMatch{ComponentName: "QBtn"}, "quasar"},
+		{"imported shared name", types.ComponentMatch{ComponentName: "Button", Library: "@mui/material"}, "mui"},
+		{"longest library in module", types.ComponentMatch{ComponentName: "Button", Library: "react-native"}, "react-native"},
+		{"aliased import", types.ComponentMatch{ComponentName: "PrimaryBtn", ResolvedName: "Button", Library: "antd"}, "antd"},
+		{"html element", types.ComponentMatch{ComponentName: "button"}, "native"},
+		{"ambiguous without import", types.ComponentMatch{ComponentName: "Button"}, ""},
+		{"module of no library", types.ComponentMatch{ComponentName: "Button", Library: "./Button"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := scanner.filterByComponentType([]types.ComponentMatch{tt.match}, "button")
+			if len(filtered) != 1 {
+				t.Fatalf("Expected %s to be a button, got %+v", tt.match.ComponentName, filtered)
+			}
+			if filtered[0].RegistryLibrary != tt.expected {
+				t.Errorf("RegistryLibrary = %q, want %q", filtered[0].RegistryLibrary, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComponentScanner_matchRule(t *testing.T) {
+	reg := registry.NewComponentMappingRegistry()
+	reg.Deprecate("OldDialog", "AppModal")
+	scanner := NewComponentScanner(nil, reg)
+	scanner.SetOptions(types.ScanOptions{Explain: true})
+
+	tests := []struct {
+		name          string
+		match         types.ComponentMatch
+		componentType string
+		expected      types.MatchRule
+	}{
+		{"library pattern", types.ComponentMatch{ComponentName: "QBtn"}, "button", types.MatchRule{Type: "button", Library: "quasar", Pattern: "QBtn"}},
+		{"attributed library", types.ComponentMatch{ComponentName: "Button", Library: "antd"}, "button", types.MatchRule{Type: "button", Library: "antd", Pattern: "Button"}},
+		{"first library when ambiguous", types.ComponentMatch{ComponentName: "Button"}, "button", types.MatchRule{Type: "button", Library: "antd", Pattern: "Button"}},
+		{"version-scoped library", types.ComponentMatch{ComponentName: "v-simple-table"}, "table", types.MatchRule{Type: "table", Library: "vuetify@2", Pattern: "v-simple-table"}},
+		{"aliased import", types.ComponentMatch{ComponentName: "PrimaryBtn", ResolvedName: "QBtn"}, "button", types.MatchRule{Type: "button", Library: "quasar", Pattern: "QBtn"}},
+		{"deprecated", types.ComponentMatch{ComponentName: "OldDialog"}, types.ComponentTypeDeprecated, types.MatchRule{Type: "deprecated", Pattern: "OldDialog"}},
+		{"custom", types.ComponentMatch{ComponentName: "AppCard", Registered: true}, types.ComponentTypeCustom, types.MatchRule{Type: "custom"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := scanner.filterByComponentType([]types.ComponentMatch{tt.match}, tt.componentType)
+			if len(filtered) != 1 || filtered[0].Rule == nil {
+				t.Fatalf("Expected %s to match %s with a rule, got %+v", tt.match.ComponentName, tt.componentType, filtered)
+			}
+			if *filtered[0].Rule != tt.expected {
+				t.Errorf("Rule = %+v, want %+v", *filtered[0].Rule, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCountByRule(t *testing.T) {
+	quasar := &types.MatchRule{Type: "button", Library: "quasar", Pattern: "q-btn"}
+	antd := &types.MatchRule{Type: "button", Library: "antd", Pattern: "Button"}
+	matches := []types.ComponentMatch{
+		{ComponentName: "Button", Rule: antd},
+		{ComponentName: "q-btn", Rule: quasar},
+		{ComponentName: "q-btn", Rule: quasar, Occurrences: 2},
+		{ComponentName: "QBtn"},
+	}
+
+	expected := []types.RuleCount{{MatchRule: *quasar, Count: 3}, {MatchRule: *antd, Count: 1}}
+	if counts := countByRule(matches); !reflect.DeepEqual(counts, expected) {
+		t.Errorf("countByRule() = %+v, want %+v", counts, expected)
+	}
+	if counts := countByRule(nil); counts != nil {
+		t.Errorf("countByRule(nil) = %+v, want nil", counts)
+	}
+}
+
+func TestCountByRoot(t *testing.T) {
+	fileRoots := map[string]string{
+		"src/App.vue":             "src",
+		"src/Form.vue":            "src",
+		"packages/ui/Button.tsx":  "packages/ui",
+		"packages/ui/Dialog.tsx":  "packages/ui",
+		"packages/ui/Tooltip.tsx": "packages/ui",
+	}
+	matches := []types.ComponentMatch{
+		{FilePath: "src/App.vue", ComponentName: "q-btn"},
+		{FilePath: "packages/ui/Button.tsx", ComponentName: "Button", Occurrences: 2},
+		{FilePath: "packages/ui/Dialog.tsx", ComponentName: "Button"},
+	}
+
+	expected := []types.RootCount{
+		{Root: "src", ScannedFiles: 2, Count: 1},
+		{Root: "packages/ui", ScannedFiles: 3, Count: 3},
+		{Root: "apps/admin", ScannedFiles: 0, Count: 0},
+	}
+	if counts := CountByRoot(matches, fileRoots, []string{"src", "packages/ui", "apps/admin"}); !reflect.DeepEqual(counts, expected) {
+		t.Errorf("CountByRoot() = %+v, want %+v", counts, expected)
+	}
+}
+
+func TestFormatPath(t *testing.T) {
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd failed: %v", err)
+	}
+	repoRoot := filepath.Dir(workingDir)
+	absPath := filepath.Join(workingDir, "src", "App.vue")
+
+	tests := []struct {
+		name     string
+		path     string
+		style    string
+		expected string
+	}{
+		{name: "unchanged without a style", path: absPath, style: "", expected: absPath},
+		{name: "absolute from relative", path: filepath.Join("src", "App.vue"), style: PathStyleAbsolute, expected: absPath},
+		{name: "relative to the working directory", path: absPath, style: PathStyleRelative, expected: "src/App.vue"},
+		{name: "relative to the repository root", path: filepath.Join("src", "App.vue"), style: PathStyleRepoRoot, expected: filepath.Base(workingDir) + "/src/App.vue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := FormatPath(tt.path, tt.style, repoRoot); result != tt.expected {
+				t.Errorf("FormatPath(%s, %s) = %s, want %s", tt.path, tt.style, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComponentScanner_Scan_MultipleParsers(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// A .ts file is supported by both the React and the Lit parser
+	litFile := filepath.Join(tempDir, "my-app.ts")
+	litContent := "import { html } from 'lit';\n\nexport const tpl = html`<my-dialog></my-dialog>`;\n"
+	if err := os.WriteFile(litFile, []byte(litContent), 0644); err != nil {
+		t.Fatalf("Failed to create test Lit file: %v", err)
+	}
+
+	parsers := []ComponentParser{
+		NewReactParser(),
+		NewLitParser(),
+	}
+	scanner := NewComponentScanner(parsers, registry.NewComponentMappingRegistry())
+
+	result, err := scanner.Scan([]string{litFile}, "my-dialog")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 1 {
+		t.Fatalf("Expected 1 match, got %d", result.TotalCount)
+	}
+
+	if result.Matches[0].Line != 3 {
+		t.Errorf("Expected match on line 3, got %d", result.Matches[0].Line)
+	}
+}
+
+func TestComponentScanner_Scan_TypeGroup(t *testing.T) {
+	tempDir := t.TempDir()
+	vueFile := filepath.Join(tempDir, "Toolbar.vue")
+	vueContent := `<template>
+  <q-btn label="Save" />
+  <q-btn label="Cancel" />
+  <q-menu />
+  <q-form />
+</template>
+<script setup>
+const $q = useQuasar()
+$q.dialog({ title: 'Confirm' })
+</script>
+`
+	if err := os.WriteFile(vueFile, []byte(vueContent), 0644); err != nil {
+		t.Fatalf("Failed to create test Vue file: %v", err)
+	}
+
+	reg := registry.NewComponentMappingRegistry()
+	if err := reg.DefineGroup("interactive", "button", "menu", "tabs", "dialog"); err != nil {
+		t.Fatalf("DefineGroup() error = %v", err)
+	}
+	scanner := NewComponentScanner([]ComponentParser{NewVueParser()}, reg)
+
+	result, err := scanner.Scan([]string{vueFile}, "interactive")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 4 {
+		t.Errorf("Expected 4 matches, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	expected := map[string]int{"button": 2, "menu": 1, "tabs": 0, "dialog": 1}
+	if !reflect.DeepEqual(result.TypeCounts, expected) {
+		t.Errorf("TypeCounts = %v, want %v", result.TypeCounts, expected)
+	}
+	for _, match := range result.Matches {
+		if match.ComponentType == "interactive" {
+			t.Errorf("Match %s: ComponentType = interactive, want its member type", match.ComponentName)
+		}
+	}
+}
+
+func TestComponentScanner_Scan_Inventory(t *testing.T) {
+	tempDir := t.TempDir()
+	vueFile := filepath.Join(tempDir, "Page.vue")
+	vueContent := `<template>
+  <div class="page">
+    <app-header />
+    <q-btn label="Save" />
+    <AppCard><span>Text</span></AppCard>
+    <q-menu />
+  </div>
+</template>
+`
+	if err := os.WriteFile(vueFile, []byte(vueContent), 0644); err != nil {
+		t.Fatalf("Failed to create test Vue file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewVueParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{vueFile}, types.ComponentTypeAll)
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	expected := map[string]string{"app-header": "custom", "q-btn": "button", "AppCard": "custom", "q-menu": "menu"}
+	if len(result.Matches) != len(expected) {
+		t.Fatalf("Expected %d matches, got %d: %+v", len(expected), len(result.Matches), result.Matches)
+	}
+	for _, match := range result.Matches {
+		if componentType, ok := expected[match.ComponentName]; !ok || match.ComponentType != componentType {
+			t.Errorf("Match %s: ComponentType = %q, want %q", match.ComponentName, match.ComponentType, componentType)
+		}
+	}
+	if !reflect.DeepEqual(result.TypeCounts, map[string]int{"custom": 2, "button": 1, "menu": 1}) {
+		t.Errorf("TypeCounts = %v, want map[button:1 custom:2 menu:1]", result.TypeCounts)
+	}
+}
+
+func TestComponentScanner_Scan_InventoryServerRendered(t *testing.T) {
+	tempDir := t.TempDir()
+	htmlFile := filepath.Join(tempDir, "menu.html")
+	if err := os.WriteFile(htmlFile, []byte(`<div x-data="dropdown()"><span>Menu</span></div>`+"\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test HTML file: %v", err)
+	}
+	bladeFile := filepath.Join(tempDir, "page.blade.php")
+	if err := os.WriteFile(bladeFile, []byte("<div>\n  <livewire:form />\n</div>\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test Blade file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewAlpineParser(), NewBladeParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{htmlFile, bladeFile}, types.ComponentTypeAll)
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	found := make(map[string]bool)
+	for _, match := range result.Matches {
+		found[match.ComponentName] = true
+	}
+	for _, name := range []string{"dropdown", "livewire:form"} {
+		if !found[name] {
+			t.Errorf("Inventory should report %s, got %+v", name, result.Matches)
+		}
+	}
+	for _, name := range []string{"div", "span"} {
+		if found[name] {
+			t.Errorf("Inventory should not report the HTML element %s", name)
+		}
+	}
+}
+
+func TestIsIntrinsicElement(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{"div", true},
+		{"button", true},
+		{"foreignObject", false},
+		{"AppCard", false},
+		{"q-btn", false},
+		{"motion.div", false},
+		{"livewire:form", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := isIntrinsicElement(tt.name); result != tt.expected {
+				t.Errorf("isIntrinsicElement(%q) = %v, want %v", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsStoryFile(t *testing.T) {
+	tests := []struct {
+		filePath string
+		expected bool
+	}{
+		{"src/Button.stories.tsx", true},
+		{"src/Button.stories.jsx", true},
+		{"src/Button.stories.vue", true},
+		{"src/Button.Stories.TS", true},
+		{"src/Button.tsx", false},
+		{"src/stories/Button.tsx", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			if result := IsStoryFile(tt.filePath); result != tt.expected {
+				t.Errorf("IsStoryFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsTestFile(t *testing.T) {
+	tests := []struct {
+		filePath string
+		expected bool
+	}{
+		{"src/Button.test.tsx", true},
+		{"src/Button.spec.ts", true},
+		{"src/__tests__/Button.tsx", true},
+		{"tests/unit/Dialog.vue", true},
+		{"/home/dev/app/test/Form.vue", true},
+		{"src/Button.tsx", false},
+		{"src/latest/Button.tsx", false},
+		{"src/contest.vue", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			if result := IsTestFile(tt.filePath); result != tt.expected {
+				t.Errorf("IsTestFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComponentScanner_Scan_MarksStories(t *testing.T) {
+	tempDir := t.TempDir()
+
+	storyFile := filepath.Join(tempDir, "Button.stories.jsx")
+	if err := os.WriteFile(storyFile, []byte("export const Primary = () => <Button>Save</Button>;\n"), 0644); err != nil {
+		t.Fatalf("Failed to create story file: %v", err)
+	}
+	appFile := filepath.Join(tempDir, "App.jsx")
+	if err := os.WriteFile(appFile, []byte("export const App = () => <Button>Save</Button>;\n"), 0644); err != nil {
+		t.Fatalf("Failed to create app file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{storyFile, appFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 2 {
+		t.Fatalf("Expected 2 matches, got %d", result.TotalCount)
+	}
+
+	for _, match := range result.Matches {
+		if match.Story != (match.FilePath == storyFile) {
+			t.Errorf("Match in %s has Story = %v", match.FilePath, match.Story)
+		}
+	}
+}
+
+func TestComponentScanner_Scan_ExtensionAliases(t *testing.T) {
+	tempDir := t.TempDir()
+
+	svelteFile := filepath.Join(tempDir, "Page.svelte")
+	if err := os.WriteFile(svelteFile, []byte("<template>\n  <q-btn label=\"Save\" />\n</template>\n"), 0644); err != nil {
+		t.Fatalf("Failed to create svelte file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewVueParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{svelteFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 0 {
+		t.Fatalf("Expected no matches without an alias, got %d", result.TotalCount)
+	}
+
+	scanner.SetOptions(types.ScanOptions{ExtensionAliases: map[string]string{".svelte": ".vue"}})
+	result, err = scanner.Scan([]string{svelteFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 1 {
+		t.Fatalf("Expected 1 match, got %d", result.TotalCount)
+	}
+	if result.Matches[0].FilePath != svelteFile {
+		t.Errorf("FilePath = %s, want %s", result.Matches[0].FilePath, svelteFile)
+	}
+}
+
+func TestComponentScanner_Scan_LogsSkippedFiles(t *testing.T) {
+	tempDir := t.TempDir()
+
+	appFile := filepath.Join(tempDir, "App.jsx")
+	if err := os.WriteFile(appFile, []byte("export const App = () => <Button>Save</Button>;\n"), 0644); err != nil {
+		t.Fatalf("Failed to create app file: %v", err)
+	}
+	missingFile := filepath.Join(tempDir, "Missing.jsx")
+	styleFile := filepath.Join(tempDir, "App.css")
+
+	var logs bytes.Buffer
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+	scanner.SetLogger(slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	if _, err := scanner.Scan([]string{appFile, missingFile, styleFile}, "button"); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	for _, expected := range []string{
+		`level=DEBUG msg="scanned file" path=` + appFile + " matches=1",
+		`level=WARN msg="skipped unreadable file" path=` + missingFile,
+		`level=DEBUG msg="skipped file without parser" path=` + styleFile,
+	} {
+		if !strings.Contains(logs.String(), expected) {
+			t.Errorf("Expected log containing %q, got:\n%s", expected, logs.String())
+		}
+	}
+}
+
+func TestComponentScanner_Scan_Concurrency(t *testing.T) {
+	tempDir := t.TempDir()
+
+	var files []string
+	for i := range 20 {
+		file := filepath.Join(tempDir, fmt.Sprintf("Page%d.jsx", i))
+		if err := os.WriteFile(file, []byte("export const Page = () => <Button>Save</Button>;\n"), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+		files = append(files, file)
+	}
+
+	for _, concurrency := range []int{0, 1, 3, 50} {
+		scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+		scanner.SetOptions(types.ScanOptions{Concurrency: concurrency})
+		result, err := scanner.Scan(files, "button")
+		if err != nil {
+			t.Fatalf("Scan with concurrency %d failed: %v", concurrency, err)
+		}
+		if result.TotalCount != len(files) {
+			t.Errorf("Scan with concurrency %d found %d matches, want %d", concurrency, result.TotalCount, len(files))
+		}
+	}
+}
+
+func TestComponentScanner_Scan_MemberExpressions(t *testing.T) {
+	tempDir := t.TempDir()
+
+	appFile := filepath.Join(tempDir, "Settings.tsx")
+	content := `export const Settings = () => (
+  <Dialog.Root>
+    <Dialog.Trigger>Open</Dialog.Trigger>
+    <Dialog.Content />
+  </Dialog.Root>
+);
+`
+	if err := os.WriteFile(appFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+
+	result, err := scanner.Scan([]string{appFile}, "dialog")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 1 {
+		t.Fatalf("Expected 1 dialog match, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	if match := result.Matches[0]; match.ComponentName != "Dialog.Root" || match.Namespace != "Dialog" || match.Line != 2 {
+		t.Errorf("Unexpected match: %+v", match)
+	}
+
+	result, err = scanner.Scan([]string{appFile}, "Dialog.Trigger")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 1 || result.Matches[0].Line != 3 {
+		t.Errorf("Expected Dialog.Trigger on line 3, got %+v", result.Matches)
+	}
+}
+
+func TestComponentScanner_Scan_ImportAliases(t *testing.T) {
+	tempDir := t.TempDir()
+
+	appFile := filepath.Join(tempDir, "Toolbar.tsx")
+	content := `import { Button as PrimaryBtn } from '@mui/material';
+
+export const Toolbar = () => <PrimaryBtn variant="contained">Save</PrimaryBtn>;
+`
+	if err := os.WriteFile(appFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{appFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 1 {
+		t.Fatalf("Expected 1 match, got %d", result.TotalCount)
+	}
+	if match := result.Matches[0]; match.ComponentName != "PrimaryBtn" || match.ResolvedName != "Button" {
+		t.Errorf("Unexpected match: %+v", match)
+	}
+}
+
+func TestComponentScanner_Scan_WithProps(t *testing.T) {
+	tempDir := t.TempDir()
+
+	appFile := filepath.Join(tempDir, "Actions.jsx")
+	content := `export const Actions = () => (
+  <>
+    <Button variant="danger">Delete</Button>
+    <Button>Cancel</Button>
+  </>
+);
+`
+	if err := os.WriteFile(appFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+
+	result, err := scanner.Scan([]string{appFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 2 || result.Matches[0].Props != nil {
+		t.Fatalf("Props should only be captured when enabled, got %+v", result.Matches)
+	}
+
+	scanner.SetOptions(types.ScanOptions{WithProps: true})
+	result, err = scanner.Scan([]string{appFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 2 {
+		t.Fatalf("Expected 2 matches, got %d", result.TotalCount)
+	}
+	if result.Matches[0].Props["variant"] != "danger" {
+		t.Errorf("Expected variant=danger on first match, got %v", result.Matches[0].Props)
+	}
+	if result.Matches[1].Props != nil {
+		t.Errorf("Expected no props on second match, got %v", result.Matches[1].Props)
+	}
+}
+
+func TestComponentScanner_Scan_CustomType(t *testing.T) {
+	tempDir := t.TempDir()
+
+	vueFile := filepath.Join(tempDir, "Profile.vue")
+	content := `<template>
+  <div>
+    <user-avatar />
+    <q-btn />
+    <unknown-tag />
+  </div>
+</template>
+
+<script setup>
+import UserAvatar from './UserAvatar.vue'
+</script>
+`
+	if err := os.WriteFile(vueFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewVueParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{vueFile}, types.ComponentTypeCustom)
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 1 {
+		t.Fatalf("Expected 1 registered component, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	if match := result.Matches[0]; match.ComponentName != "user-avatar" || match.ComponentType != types.ComponentTypeCustom {
+		t.Errorf("Unexpected match: %+v", match)
+	}
+
+	// Named components match whether imported or not, in any casing
+	scanner.SetOptions(types.ScanOptions{ComponentNames: []string{"UnknownTag", "q-btn"}})
+	result, err = scanner.Scan([]string{vueFile}, types.ComponentTypeCustom)
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	var names []string
+	for _, match := range result.Matches {
+		names = append(names, match.ComponentName)
+	}
+	if !reflect.DeepEqual(names, []string{"q-btn", "unknown-tag"}) {
+		t.Errorf("Scan() with component names matched %v, want [q-btn unknown-tag]", names)
+	}
+}
+
+func TestComponentScanner_Scan_ImperativeCalls(t *testing.T) {
+	tempDir := t.TempDir()
+
+	vueFile := filepath.Join(tempDir, "Delete.vue")
+	content := `<template>
+  <q-btn @click="confirm" />
+</template>
+
+<script setup>
+const $q = useQuasar()
+const confirm = () => $q.dialog({ title: 'Delete?' })
+</script>
+`
+	if err := os.WriteFile(vueFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewVueParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{vueFile}, "dialog")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 1 {
+		t.Fatalf("Expected 1 match, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	match := result.Matches[0]
+	if match.ComponentName != "$q.dialog" || match.Line != 7 || match.UsageKind != types.UsageKindImperative || match.ComponentType != "dialog" {
+		t.Errorf("Unexpected match: %+v", match)
+	}
+}
+
+func TestComponentScanner_Scan_Wrappers(t *testing.T) {
+	tempDir := t.TempDir()
+
+	reactFile := filepath.Join(tempDir, "Toolbar.tsx")
+	content := `import styled from 'styled-components';
+import { Button } from '@mui/material';
+
+const FancyButton = styled(Button)` + "`color: red;`" + `;
+
+export const Toolbar = () => <FancyButton>Save</FancyButton>;
+`
+	if err := os.WriteFile(reactFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser(), NewWrapperParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{reactFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 2 {
+		t.Fatalf("Expected 2 matches, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	for _, match := range result.Matches {
+		switch match.Line {
+		case 4:
+			if match.ComponentName != "Button" || match.UsageKind != types.UsageKindWrapper {
+				t.Errorf("Unexpected wrapper match: %+v", match)
+			}
+		case 6:
+			if match.ComponentName != "FancyButton" || match.ResolvedName != "Button" || match.Library != "@mui/material" {
+				t.Errorf("Unexpected alias match: %+v", match)
+			}
+		default:
+			t.Errorf("Unexpected match: %+v", match)
+		}
+	}
+}
+
+func TestComponentScanner_Scan_FingerprintPathStyles(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(tempDir, ".git"), 0755); err != nil {
+		t.Fatalf("Failed to create .git: %v", err)
+	}
+
+	vueFile := filepath.Join(tempDir, "src", "Form.vue")
+	if err := os.MkdirAll(filepath.Dir(vueFile), 0755); err != nil {
+		t.Fatalf("Failed to create test directory: %v", err)
+	}
+	if err := os.WriteFile(vueFile, []byte("<template>\n  <q-btn label=\"Save\" />\n</template>\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	// Path styles, and the repository root given or found from the file
+	var fingerprints []string
+	for _, options := range []types.ScanOptions{
+		{PathStyle: PathStyleAbsolute, RepoRoot: tempDir},
+		{PathStyle: PathStyleRelative, RepoRoot: tempDir},
+		{PathStyle: PathStyleRepoRoot, RepoRoot: tempDir},
+		{PathStyle: PathStyleRelative},
+	} {
+		scanner := NewComponentScanner([]ComponentParser{NewVueParser()}, registry.NewComponentMappingRegistry())
+		scanner.SetOptions(options)
+		result, err := scanner.Scan([]string{vueFile}, "button")
+		if err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		if result.TotalCount != 1 {
+			t.Fatalf("Expected 1 match with %+v, got %d", options, result.TotalCount)
+		}
+		fingerprints = append(fingerprints, result.Matches[0].Fingerprint)
+	}
+
+	for i, fingerprint := range fingerprints[1:] {
+		if fingerprint != fingerprints[0] {
+			t.Errorf("Fingerprint %d = %q, want %q for every path style and root", i+1, fingerprint, fingerprints[0])
+		}
+	}
+}
+
+func TestComponentScanner_Scan_AliasCounts(t *testing.T) {
+	tempDir := t.TempDir()
+
+	reactFile := filepath.Join(tempDir, "Toolbar.tsx")
+	content := `import styled from 'styled-components';
+import { Button, Button as PrimaryBtn } from '@mui/material';
+
+const FancyButton = styled(Button)` + "`color: red;`" + `;
+
+export const Toolbar = () => (
+  <div>
+    <PrimaryBtn>Save</PrimaryBtn>
+    <FancyButton>Cancel</FancyButton>
+    <Button>Reset</Button>
+  </div>
+);
+`
+	if err := os.WriteFile(reactFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser(), NewWrapperParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{reactFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	// The wrapper, its alias, the import alias and the plain usage count as one component
+	expected := map[string]int{"Button": 4}
+	if !reflect.DeepEqual(result.ComponentCounts, expected) {
+		t.Errorf("ComponentCounts = %v, want %v", result.ComponentCounts, expected)
+	}
+}
+
+func TestComponentScanner_Scan_LazyAliases(t *testing.T) {
+	tempDir := t.TempDir()
+
+	reactFile := filepath.Join(tempDir, "Settings.tsx")
+	content := `import React from 'react';
+
+const LazyDialog = React.lazy(() => import('./Dialog'));
+
+export const Settings = () => <LazyDialog open />;
+`
+	if err := os.WriteFile(reactFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser(), NewLazyComponentParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{reactFile}, "dialog")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 2 {
+		t.Fatalf("Expected 2 matches, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	for _, match := range result.Matches {
+		if match.ComponentName != "LazyDialog" || match.ResolvedName != "Dialog" || match.Library != "./Dialog" {
+			t.Errorf("Unexpected match: %+v", match)
+		}
+		switch match.Line {
+		case 3:
+			if match.UsageKind != types.UsageKindLazy {
+				t.Errorf("Unexpected lazy match: %+v", match)
+			}
+		case 5:
+			if match.UsageKind != "" {
+				t.Errorf("Unexpected usage match: %+v", match)
+			}
+		default:
+			t.Errorf("Unexpected match: %+v", match)
+		}
+	}
+}
+
+func TestComponentScanner_Scan_CanonicalNames(t *testing.T) {
+	tempDir := t.TempDir()
+
+	vueFile := filepath.Join(tempDir, "Actions.vue")
+	content := `<template>
+  <q-btn label="Save" />
+  <QBtn label="Cancel" />
+</template>
+`
+	if err := os.WriteFile(vueFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewVueParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{vueFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+
+	if result.TotalCount != 2 {
+		t.Fatalf("Expected 2 matches, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	if result.Matches[0].ComponentName != "q-btn" || result.Matches[1].ComponentName != "QBtn" {
+		t.Errorf("Original spellings should be preserved, got %q and %q", result.Matches[0].ComponentName, result.Matches[1].ComponentName)
+	}
+	for _, match := range result.Matches {
+		if match.CanonicalName != "QBtn" {
+			t.Errorf("Expected canonical name QBtn, got %q", match.CanonicalName)
+		}
+	}
+	if len(result.ComponentCounts) != 1 || result.ComponentCounts["QBtn"] != 2 {
+		t.Errorf("Expected ComponentCounts map[QBtn:2], got %v", result.ComponentCounts)
+	}
+}
+
+func TestDedupeMatches_Casings(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{ComponentName: "q-btn", Line: 2},
+		{ComponentName: "QBtn", Line: 2},
+		{ComponentName: "QBtn", Line: 3},
+	}
+
+	deduped := dedupeMatches(matches)
+	if len(deduped) != 2 || deduped[0].ComponentName != "q-btn" || deduped[1].Line != 3 {
+		t.Errorf("dedupeMatches() = %+v, want q-btn:2 and QBtn:3", deduped)
+	}
+}
+
+func TestComponentScanner_Scan_CountDuplicates(t *testing.T) {
+	tempDir := t.TempDir()
+
+	reactFile := filepath.Join(tempDir, "Toolbar.tsx")
+	content := `export const Toolbar = () => (
+  <div><Button>Save</Button><Button>Undo</Button><Button>Redo</Button></div>
+);
+export const Footer = () => <Button>Close</Button>;
+`
+	if err := os.WriteFile(reactFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{reactFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 2 || result.Matches[0].Occurrences != 0 {
+		t.Errorf("Expected 2 matches without occurrences by default, got %d: %+v", result.TotalCount, result.Matches)
+	}
+
+	scanner.SetOptions(types.ScanOptions{CountDuplicates: true})
+	result, err = scanner.Scan([]string{reactFile}, "button")
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if len(result.Matches) != 2 || result.Matches[0].Occurrences != 3 || result.Matches[1].Occurrences != 1 {
+		t.Fatalf("Expected occurrences 3 and 1, got %+v", result.Matches)
+	}
+	if result.TotalCount != 4 || result.ComponentCounts["Button"] != 4 {
+		t.Errorf("Expected 4 usages, got TotalCount %d and ComponentCounts %v", result.TotalCount, result.ComponentCounts)
+	}
 }
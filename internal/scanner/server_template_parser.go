@@ -0,0 +1,60 @@
+package scanner
+
+import (
+	"path/filepath"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// delimiterPair describes the opening and closing delimiters of a template expression
+type delimiterPair struct {
+	open  string
+	close string
+}
+
+// serverTemplateDelimiters maps supported extensions to their expression delimiters
+// Comment delimiters come first so commented-out expressions are masked as a whole
+var serverTemplateDelimiters = map[string][]delimiterPair{
+	".ejs": {
+		{"<%#", "%>"},
+		{"<%", "%>"},
+	},
+	".njk": {
+		{"{#", "#}"},
+		{"{%", "%}"},
+		{"{{", "}}"},
+	},
+}
+
+// ServerTemplateParser parses generic server-side templates (.ejs and .njk files)
+// Template expression delimiters are stripped before running component tag extraction
+type ServerTemplateParser struct {
+	htmlTags htmlTagSet // Tags skipped as plain HTML, the built-in tags when nil
+}
+
+// NewServerTemplateParser creates a new ServerTemplateParser instance
+func NewServerTemplateParser() *ServerTemplateParser {
+	return &ServerTemplateParser{}
+}
+
+// setHTMLTags sets the tags skipped as plain HTML
+func (p *ServerTemplateParser) setHTMLTags(tags htmlTagSet) {
+	p.htmlTags = tags
+}
+
+// SupportsFile checks if the file is a .ejs or .njk file
+func (p *ServerTemplateParser) SupportsFile(filePath string) bool {
+	_, ok := serverTemplateDelimiters[strings.ToLower(filepath.Ext(filePath))]
+	return ok
+}
+
+// Parse extracts component matches from server template content
+func (p *ServerTemplateParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	content := fileContent
+	for _, delimiters := range serverTemplateDelimiters[strings.ToLower(filepath.Ext(filePath))] {
+		content = maskRegions(content, delimiters.open, delimiters.close)
+	}
+
+	return parseTemplateComponents(content, filePath, 1, p.htmlTags), nil
+}
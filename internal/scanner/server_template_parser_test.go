@@ -0,0 +1,87 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestServerTemplateParser_SupportsFile(t *testing.T) {
+	parser := NewServerTemplateParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"ejs file", "views/index.ejs", true},
+		{"nunjucks file", "views/layout.njk", true},
+		{"uppercase extension", "views/Index.EJS", true},
+		{"html file", "public/index.html", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestServerTemplateParser_Parse(t *testing.T) {
+	parser := NewServerTemplateParser()
+
+	tests := []struct {
+		name          string
+		filePath      string
+		content       string
+		expectedNames []string
+		expectedLines []int
+	}{
+		{
+			name:     "ejs template",
+			filePath: "views/index.ejs",
+			content: `<%# <old-dialog></old-dialog> %>
+<% if (items.length < 3) { %>
+  <sl-button><%= '<x-fake>' %></sl-button>
+<% } %>
+<%- include('partials/footer') %>
+<app-footer></app-footer>`,
+			expectedNames: []string{"sl-button", "app-footer"},
+			expectedLines: []int{3, 6},
+		},
+		{
+			name:     "nunjucks template",
+			filePath: "views/layout.njk",
+			content: `{# <old-dialog></old-dialog> #}
+{% if items | length < 3 %}
+  <q-dialog>{{ "<x-fake>" }}</q-dialog>
+{% endif %}
+<MyWidget />`,
+			expectedNames: []string{"q-dialog", "MyWidget"},
+			expectedLines: []int{3, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, tt.filePath)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(matches) != len(tt.expectedNames) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(tt.expectedNames), matches)
+			}
+
+			for i, expectedName := range tt.expectedNames {
+				if matches[i].ComponentName != expectedName {
+					t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+				}
+				if matches[i].Line != tt.expectedLines[i] {
+					t.Errorf("Match %d: got line %d, want %d", i, matches[i].Line, tt.expectedLines[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+)
+
+// sfcBlock is a top-level block of a Vue single-file component
+// (<template>, <script>, <style> or a custom block such as <i18n>)
+type sfcBlock struct {
+	tag       string            // Lowercased block tag name
+	attrs     map[string]string // Attributes of the opening tag; boolean attributes map to ""
+	content   string            // Content between the opening and closing tags
+	startLine int               // Line of the first content character (1-based)
+	offset    int               // Byte offset of the content in the file
+}
+
+// lang returns the lowercased lang attribute of the block, or ""
+func (b sfcBlock) lang() string {
+	return strings.ToLower(b.attrs["lang"])
+}
+
+var (
+	// sfcOpenTagRegex matches a top-level opening tag, capturing its name and attributes
+	sfcOpenTagRegex = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9-]*)((?:\s+[^>]*?)?)\s*(/?)>`)
+
+	// sfcAttrRegex matches a single attribute with an optional quoted or unquoted value
+	sfcAttrRegex = regexp.MustCompile(`([^\s=/]+)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+)))?`)
+
+	// templateOpenTagRegex matches an opening <template> tag at any depth
+	templateOpenTagRegex = regexp.MustCompile(`<template(?:\s[^>]*)?>`)
+
+	// templateCloseTagRegex matches a closing </template> tag
+	templateCloseTagRegex = regexp.MustCompile(`</template\s*>`)
+)
+
+// parseSFCBlocks splits a Vue single-file component into its top-level blocks
+// Nested <template> tags (v-slot, v-if groups) are balanced so the template
+// block ends at its own closing tag. Script, style and custom blocks are raw
+// text and end at the first matching closing tag. Top-level HTML comments are skipped.
+func parseSFCBlocks(content string) []sfcBlock {
+	var blocks []sfcBlock
+
+	pos := 0
+	for pos < len(content) {
+		next := strings.IndexByte(content[pos:], '<')
+		if next < 0 {
+			break
+		}
+		pos += next
+
+		if strings.HasPrefix(content[pos:], "<!--") {
+			end := strings.Index(content[pos:], "-->")
+			if end < 0 {
+				break
+			}
+			pos += end + 3
+			continue
+		}
+
+		open := sfcOpenTagRegex.FindStringSubmatchIndex(content[pos:])
+		if open == nil {
+			pos++
+			continue
+		}
+
+		tag := strings.ToLower(content[pos+open[2] : pos+open[3]])
+		attrs := parseSFCAttrs(content[pos+open[4] : pos+open[5]])
+		contentStart := pos + open[1]
+
+		// Self-closing blocks (e.g. <script src="..." />) have no content
+		if open[6] != open[7] {
+			pos = contentStart
+			continue
+		}
+
+		var contentEnd, blockEnd int
+		if tag == "template" {
+			contentEnd, blockEnd = findTemplateBlockEnd(content, contentStart)
+		} else {
+			contentEnd, blockEnd = findRawBlockEnd(content, contentStart, tag)
+		}
+		if contentEnd < 0 {
+			break
+		}
+
+		blocks = append(blocks, sfcBlock{
+			tag:       tag,
+			attrs:     attrs,
+			content:   content[contentStart:contentEnd],
+			startLine: strings.Count(content[:contentStart], "\n") + 1,
+			offset:    contentStart,
+		})
+		pos = blockEnd
+	}
+
+	return blocks
+}
+
+// parseSFCAttrs parses the attributes of a block opening tag
+func parseSFCAttrs(attrText string) map[string]string {
+	attrs := make(map[string]string)
+	for _, match := range sfcAttrRegex.FindAllStringSubmatch(attrText, -1) {
+		attrs[strings.ToLower(match[1])] = match[2] + match[3] + match[4]
+	}
+	return attrs
+}
+
+// findTemplateBlockEnd finds the closing tag of a <template> block whose content starts at start
+// Returns the content end and the offset after the closing tag, or -1 if unterminated
+func findTemplateBlockEnd(content string, start int) (int, int) {
+	depth := 0
+	pos := start
+	for {
+		closeLoc := templateCloseTagRegex.FindStringIndex(content[pos:])
+		if closeLoc == nil {
+			return -1, -1
+		}
+
+		// Count nested <template> openings before this closing tag
+		for _, openLoc := range templateOpenTagRegex.FindAllStringIndex(content[pos:pos+closeLoc[0]], -1) {
+			if !strings.HasSuffix(content[pos+openLoc[0]:pos+openLoc[1]], "/>") {
+				depth++
+			}
+		}
+
+		if depth == 0 {
+			return pos + closeLoc[0], pos + closeLoc[1]
+		}
+		depth--
+		pos += closeLoc[1]
+	}
+}
+
+// findRawBlockEnd finds the closing tag of a raw text block (script, style, custom blocks)
+// Returns the content end and the offset after the closing tag, or -1 if unterminated
+func findRawBlockEnd(content string, start int, tag string) (int, int) {
+	closeTag := "</" + tag
+	lowerRest := strings.ToLower(content[start:])
+
+	searchFrom := 0
+	for {
+		idx := strings.Index(lowerRest[searchFrom:], closeTag)
+		if idx < 0 {
+			return -1, -1
+		}
+		idx += searchFrom
+
+		after := idx + len(closeTag)
+		end := strings.IndexByte(lowerRest[after:], '>')
+		if end >= 0 && strings.TrimSpace(lowerRest[after:after+end]) == "" {
+			return start + idx, start + after + end + 1
+		}
+		searchFrom = after
+	}
+}
+
+// findSFCBlock returns the first top-level block with the given tag
+func findSFCBlock(blocks []sfcBlock, tag string) (sfcBlock, bool) {
+	for _, block := range blocks {
+		if block.tag == tag {
+			return block, true
+		}
+	}
+	return sfcBlock{}, false
+}
@@ -0,0 +1,92 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestParseSFCBlocks(t *testing.T) {
+	content := `<!-- <template>commented</template> -->
+<template>
+  <q-table>
+    <template v-slot:body="props">
+      <q-btn />
+    </template>
+    <template v-if="ok"></template>
+  </q-table>
+</template>
+
+<script>
+export default {}
+</script>
+
+<script setup lang="ts">
+const label = '</template>'
+</script>
+
+<i18n lang="json">
+{ "en": { "save": "Save" } }
+</i18n>
+
+<style scoped>
+.btn { color: red; }
+</style>`
+
+	blocks := parseSFCBlocks(content)
+
+	expected := []struct {
+		tag       string
+		startLine int
+		lang      string
+	}{
+		{"template", 2, ""},
+		{"script", 11, ""},
+		{"script", 15, "ts"},
+		{"i18n", 19, "json"},
+		{"style", 23, ""},
+	}
+
+	if len(blocks) != len(expected) {
+		t.Fatalf("parseSFCBlocks() returned %d blocks, want %d: %+v", len(blocks), len(expected), blocks)
+	}
+
+	for i, exp := range expected {
+		if blocks[i].tag != exp.tag || blocks[i].startLine != exp.startLine || blocks[i].lang() != exp.lang {
+			t.Errorf("Block %d: got <%s> at line %d (lang %q), want <%s> at line %d (lang %q)",
+				i, blocks[i].tag, blocks[i].startLine, blocks[i].lang(), exp.tag, exp.startLine, exp.lang)
+		}
+	}
+
+	if _, ok := blocks[2].attrs["setup"]; !ok {
+		t.Errorf("Expected setup attribute on second script block, got %v", blocks[2].attrs)
+	}
+
+	// The template block includes its nested template tags
+	expectedTemplateEnd := "  </q-table>\n"
+	if got := blocks[0].content; len(got) < len(expectedTemplateEnd) || got[len(got)-len(expectedTemplateEnd):] != expectedTemplateEnd {
+		t.Errorf("Template block ends early: %q", got)
+	}
+}
+
+func TestSFCBlock_Lang(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"pug template", "<template lang=\"pug\">\ndiv\n</template>", "pug"},
+		{"single quotes", "<template lang='Pug'>\ndiv\n</template>", "pug"},
+		{"html template", "<template>\n<div></div>\n</template>", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, ok := findSFCBlock(parseSFCBlocks(tt.content), "template")
+			if !ok {
+				t.Fatalf("Expected a template block")
+			}
+			if result := template.lang(); result != tt.expected {
+				t.Errorf("lang() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
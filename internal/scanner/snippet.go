@@ -0,0 +1,24 @@
+package scanner
+
+import (
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// attachSnippets fills the Snippet of every match with its source line
+// and up to contextLines lines before and after it
+func attachSnippets(matches []types.ComponentMatch, content string, contextLines int) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	for i := range matches {
+		line := matches[i].Line
+		if line < 1 || line > len(lines) {
+			continue
+		}
+
+		start := max(line-1-contextLines, 0)
+		end := min(line+contextLines, len(lines))
+		matches[i].Snippet = strings.Join(lines[start:end], "\n")
+	}
+}
@@ -0,0 +1,35 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestAttachSnippets(t *testing.T) {
+	content := "<template>\r\n  <q-form>\r\n    <q-btn />\r\n  </q-form>\r\n</template>"
+
+	tests := []struct {
+		name         string
+		line         int
+		contextLines int
+		expected     string
+	}{
+		{"matched line only", 3, 0, "    <q-btn />"},
+		{"with context", 3, 1, "  <q-form>\n    <q-btn />\n  </q-form>"},
+		{"context clipped at file start", 1, 2, "<template>\n  <q-form>\n    <q-btn />"},
+		{"context clipped at file end", 5, 1, "  </q-form>\n</template>"},
+		{"line out of range", 9, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := []types.ComponentMatch{{ComponentName: "q-btn", Line: tt.line}}
+			attachSnippets(matches, content, tt.contextLines)
+
+			if matches[0].Snippet != tt.expected {
+				t.Errorf("Snippet = %q, want %q", matches[0].Snippet, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package scanner
+
+import (
+	"ui-elf/internal/types"
+)
+
+// SVG handling modes of the tag policy
+const (
+	// SVGModeExclude drops SVG primitives from the results (default)
+	SVGModeExclude = "exclude"
+	// SVGModeClassify reports SVG primitives with usage kind "svg"
+	SVGModeClassify = "classify"
+)
+
+// svgLibraries lists packages whose components are SVG primitives
+var svgLibraries = map[string]bool{
+	"react-native-svg": true,
+}
+
+// svgCamelCaseElements lists the SVG elements with camelCase names
+// Unlike lowercase SVG tags they are not recognized as plain HTML
+var svgCamelCaseElements = map[string]bool{
+	"altGlyph": true, "altGlyphDef": true, "altGlyphItem": true, "animateColor": true,
+	"animateMotion": true, "animateTransform": true, "clipPath": true, "foreignObject": true,
+	"glyphRef": true, "linearGradient": true, "radialGradient": true, "textPath": true,
+	"feBlend": true, "feColorMatrix": true, "feComponentTransfer": true, "feComposite": true,
+	"feConvolveMatrix": true, "feDiffuseLighting": true, "feDisplacementMap": true,
+	"feDistantLight": true, "feDropShadow": true, "feFlood": true, "feFuncA": true,
+	"feFuncB": true, "feFuncG": true, "feFuncR": true, "feGaussianBlur": true, "feImage": true,
+	"feMerge": true, "feMergeNode": true, "feMorphology": true, "feOffset": true,
+	"fePointLight": true, "feSpecularLighting": true, "feSpotLight": true, "feTile": true,
+	"feTurbulence": true,
+}
+
+// isSVGPrimitive checks if a match is an SVG element rather than a component
+// camelCase SVG tags (<linearGradient>) and components of SVG libraries
+// (<ClipPath> from react-native-svg) are SVG primitives
+func isSVGPrimitive(match types.ComponentMatch) bool {
+	return svgCamelCaseElements[match.ComponentName] || svgLibraries[match.Library]
+}
+
+// applySVGPolicy excludes or classifies SVG primitives according to mode
+func applySVGPolicy(matches []types.ComponentMatch, mode string) []types.ComponentMatch {
+	var kept []types.ComponentMatch
+	for _, match := range matches {
+		if isSVGPrimitive(match) {
+			if mode != SVGModeClassify {
+				continue
+			}
+			match.UsageKind = types.UsageKindSVG
+		}
+		kept = append(kept, match)
+	}
+	return kept
+}
@@ -0,0 +1,84 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ui-elf/internal/registry"
+	"ui-elf/internal/types"
+)
+
+func TestApplySVGPolicy(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{ComponentName: "linearGradient"},
+		{ComponentName: "ClipPath", Library: "react-native-svg"},
+		{ComponentName: "ClipPath", Library: "./shapes"},
+		{ComponentName: "q-btn"},
+	}
+
+	t.Run("exclude", func(t *testing.T) {
+		for _, mode := range []string{"", SVGModeExclude} {
+			result := applySVGPolicy(matches, mode)
+			if len(result) != 2 || result[0].Library != "./shapes" || result[1].ComponentName != "q-btn" {
+				t.Errorf("applySVGPolicy(%q) = %+v, want the local ClipPath and q-btn", mode, result)
+			}
+		}
+	})
+
+	t.Run("classify", func(t *testing.T) {
+		result := applySVGPolicy(matches, SVGModeClassify)
+		expected := []string{types.UsageKindSVG, types.UsageKindSVG, "", ""}
+		if len(result) != len(expected) {
+			t.Fatalf("applySVGPolicy() returned %d matches, want %d", len(result), len(expected))
+		}
+		for i, kind := range expected {
+			if result[i].UsageKind != kind {
+				t.Errorf("Match %d (%s): UsageKind = %q, want %q", i, result[i].ComponentName, result[i].UsageKind, kind)
+			}
+		}
+	})
+}
+
+func TestComponentScanner_Scan_SVGPrimitives(t *testing.T) {
+	tempDir := t.TempDir()
+
+	reactFile := filepath.Join(tempDir, "Logo.tsx")
+	content := `import Svg, { ClipPath, LinearGradient, Stop } from 'react-native-svg';
+import { Button } from './Button';
+
+export const Logo = () => (
+  <Svg>
+    <LinearGradient id="fade"><Stop offset="0" /></LinearGradient>
+    <ClipPath id="clip" />
+    <Button />
+  </Svg>
+);
+`
+	if err := os.WriteFile(reactFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner := NewComponentScanner([]ComponentParser{NewReactParser()}, registry.NewComponentMappingRegistry())
+	result, err := scanner.Scan([]string{reactFile}, types.ComponentTypeCustom)
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 1 || result.Matches[0].ComponentName != "Button" {
+		t.Errorf("Expected only Button, got %+v", result.Matches)
+	}
+
+	scanner.SetOptions(types.ScanOptions{TagPolicy: types.TagPolicy{SVG: SVGModeClassify}})
+	result, err = scanner.Scan([]string{reactFile}, types.ComponentTypeCustom)
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if result.TotalCount != 5 {
+		t.Fatalf("Expected 5 matches when classifying SVG primitives, got %d: %+v", result.TotalCount, result.Matches)
+	}
+	for _, match := range result.Matches {
+		if isSVG := match.UsageKind == types.UsageKindSVG; isSVG == (match.ComponentName == "Button") {
+			t.Errorf("Unexpected usage kind %q for %s", match.UsageKind, match.ComponentName)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package scanner
+
+import (
+	"maps"
+	"path/filepath"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// builtinHTMLTags lists the standard HTML elements skipped by the template parsers
+var builtinHTMLTags = htmlTagSet{
+	"div": true, "span": true, "p": true, "a": true, "img": true,
+	"ul": true, "ol": true, "li": true, "table": true, "tr": true,
+	"td": true, "th": true, "thead": true, "tbody": true, "tfoot": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "footer": true, "nav": true, "section": true, "article": true,
+	"aside": true, "main": true, "input": true, "textarea": true, "select": true,
+	"option": true, "label": true, "fieldset": true, "legend": true,
+	"strong": true, "em": true, "b": true, "i": true, "u": true,
+	"br": true, "hr": true, "pre": true, "code": true, "blockquote": true,
+	"iframe": true, "video": true, "audio": true, "canvas": true, "svg": true,
+	"path": true, "circle": true, "rect": true, "line": true, "polygon": true,
+	"template": true, "slot": true, "script": true, "style": true, "link": true,
+	"meta": true, "title": true, "head": true, "body": true, "html": true,
+	"button": true, "form": true, "dialog": true,
+}
+
+// htmlTagSet is a set of tag names treated as plain HTML
+type htmlTagSet map[string]bool
+
+// contains checks if a tag name is in the set; a nil set holds the built-in tags
+// Names are matched as written, so <DIV> and <Div> are not HTML
+func (s htmlTagSet) contains(tagName string) bool {
+	if s == nil {
+		s = builtinHTMLTags
+	}
+	return s[tagName]
+}
+
+// htmlTagParser is implemented by the parsers skipping plain HTML tags while parsing
+type htmlTagParser interface {
+	setHTMLTags(tags htmlTagSet)
+}
+
+// newHTMLTagSet returns the HTML tags of the policy: the built-in tags, or none when replaced,
+// with the configured tags added and the "!tag" entries removed
+// Returns nil (the built-in tags) for a policy without HTML tags
+func newHTMLTagSet(policy types.TagPolicy) htmlTagSet {
+	if len(policy.HTMLTags) == 0 && !policy.ReplaceHTMLTags {
+		return nil
+	}
+
+	tags := make(htmlTagSet)
+	if !policy.ReplaceHTMLTags {
+		maps.Copy(tags, builtinHTMLTags)
+	}
+	for _, tag := range policy.HTMLTags {
+		if name, removed := strings.CutPrefix(tag, "!"); removed {
+			delete(tags, name)
+		} else {
+			tags[tag] = true
+		}
+	}
+	return tags
+}
+
+// applyTagPolicy drops matches of tags the policy does not report as components
+// Configured HTML tags are never reported; hyphenated custom element tags must match
+// the allow list, if any, and must not match the deny list
+func applyTagPolicy(matches []types.ComponentMatch, policy types.TagPolicy) []types.ComponentMatch {
+	if len(policy.HTMLTags) == 0 && len(policy.AllowCustomElements) == 0 && len(policy.DenyCustomElements) == 0 {
+		return matches
+	}
+
+	htmlTags := make(map[string]bool, len(policy.HTMLTags))
+	for _, tag := range policy.HTMLTags {
+		if !strings.HasPrefix(tag, "!") {
+			htmlTags[tag] = true
+		}
+	}
+
+	var kept []types.ComponentMatch
+	for _, match := range matches {
+		name := match.ComponentName
+		if htmlTags[name] {
+			continue
+		}
+		if customElementNameRegex.MatchString(name) {
+			if len(policy.AllowCustomElements) > 0 && !matchesAnyPattern(name, policy.AllowCustomElements) {
+				continue
+			}
+			if matchesAnyPattern(name, policy.DenyCustomElements) {
+				continue
+			}
+		}
+		kept = append(kept, match)
+	}
+	return kept
+}
+
+// matchesAnyPattern checks if name matches one of the glob patterns
+func matchesAnyPattern(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/registry"
+	"ui-elf/internal/types"
+)
+
+func TestApplyTagPolicy(t *testing.T) {
+	matches := []types.ComponentMatch{
+		{ComponentName: "q-btn"},
+		{ComponentName: "font-awesome-icon"},
+		{ComponentName: "font"},
+		{ComponentName: "my-dialog"},
+		{ComponentName: "Button"},
+	}
+
+	tests := []struct {
+		name     string
+		policy   types.TagPolicy
+		expected []string
+	}{
+		{
+			name:     "empty policy",
+			policy:   types.TagPolicy{},
+			expected: []string{"q-btn", "font-awesome-icon", "font", "my-dialog", "Button"},
+		},
+		{
+			name:     "html tags",
+			policy:   types.TagPolicy{HTMLTags: []string{"font"}},
+			expected: []string{"q-btn", "font-awesome-icon", "my-dialog", "Button"},
+		},
+		{
+			name:     "removed html tag",
+			policy:   types.TagPolicy{HTMLTags: []string{"!font", "font-awesome-icon"}},
+			expected: []string{"q-btn", "font", "my-dialog", "Button"},
+		},
+		{
+			name:     "deny list",
+			policy:   types.TagPolicy{DenyCustomElements: []string{"font-awesome-*"}},
+			expected: []string{"q-btn", "font", "my-dialog", "Button"},
+		},
+		{
+			name:     "allow list",
+			policy:   types.TagPolicy{AllowCustomElements: []string{"q-*", "font-*"}, DenyCustomElements: []string{"font-awesome-*"}},
+			expected: []string{"q-btn", "font", "Button"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := applyTagPolicy(matches, tt.policy)
+			if len(result) != len(tt.expected) {
+				t.Fatalf("applyTagPolicy() returned %d matches, want %d: %+v", len(result), len(tt.expected), result)
+			}
+			for i, name := range tt.expected {
+				if result[i].ComponentName != name {
+					t.Errorf("Match %d: got %q, want %q", i, result[i].ComponentName, name)
+				}
+			}
+		})
+	}
+}
+
+func TestNewHTMLTagSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  types.TagPolicy
+		html    []string // Tags expected in the set
+		notHTML []string // Tags expected outside of it
+		builtin bool     // Whether the set is the built-in one (nil)
+	}{
+		{
+			name:    "empty policy",
+			policy:  types.TagPolicy{},
+			html:    []string{"div", "button"},
+			notHTML: []string{"font", "Div"},
+			builtin: true,
+		},
+		{
+			name:    "added tags",
+			policy:  types.TagPolicy{HTMLTags: []string{"font", "center"}},
+			html:    []string{"div", "button", "font", "center"},
+			notHTML: []string{"q-btn"},
+		},
+		{
+			name:    "removed tags",
+			policy:  types.TagPolicy{HTMLTags: []string{"!button", "!dialog", "font"}},
+			html:    []string{"div", "font"},
+			notHTML: []string{"button", "dialog"},
+		},
+		{
+			name:    "replaced tags",
+			policy:  types.TagPolicy{HTMLTags: []string{"div", "span"}, ReplaceHTMLTags: true},
+			html:    []string{"div", "span"},
+			notHTML: []string{"button", "form", "p"},
+		},
+		{
+			name:    "replaced with no tags",
+			policy:  types.TagPolicy{ReplaceHTMLTags: true},
+			notHTML: []string{"div", "button"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags := newHTMLTagSet(tt.policy)
+			if (tags == nil) != tt.builtin {
+				t.Errorf("newHTMLTagSet() = %v, want the built-in set: %v", tags, tt.builtin)
+			}
+			for _, tag := range tt.html {
+				if !tags.contains(tag) {
+					t.Errorf("contains(%q) = false, want true", tag)
+				}
+			}
+			for _, tag := range tt.notHTML {
+				if tags.contains(tag) {
+					t.Errorf("contains(%q) = true, want false", tag)
+				}
+			}
+		})
+	}
+}
+
+func TestComponentScanner_SetOptions_HTMLTags(t *testing.T) {
+	vueParser := NewVueParser()
+	bladeParser := NewBladeParser()
+	scanner := NewComponentScanner([]ComponentParser{vueParser, bladeParser}, registry.NewComponentMappingRegistry())
+	scanner.SetOptions(types.ScanOptions{TagPolicy: types.TagPolicy{HTMLTags: []string{"!button", "font"}}})
+
+	vueMatches, err := vueParser.Parse("<template>\n  <div><button>Save</button><font>x</font></div>\n</template>\n", "App.vue")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	bladeMatches, err := bladeParser.Parse("<div><button>Save</button><font>x</font></div>\n", "page.blade.php")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	for _, matches := range [][]types.ComponentMatch{vueMatches, bladeMatches} {
+		if len(matches) != 1 || matches[0].ComponentName != "button" {
+			t.Errorf("Parse() = %+v, want only the removed built-in tag button", matches)
+		}
+	}
+}
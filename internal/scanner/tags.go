@@ -0,0 +1,220 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// collectTagMatches runs the given tag regexes over content line by line
+// Each regex must capture the component name in its first group. Names for which
+// skip returns true are ignored, and a component is reported at most once per line;
+// repeated tags on the same line are counted in Occurrences.
+func collectTagMatches(content string, filePath string, baseLineNumber int, skip func(string) bool, tagRegexes ...*regexp.Regexp) []types.ComponentMatch {
+	var matches []types.ComponentMatch
+
+	lines := strings.Split(content, "\n")
+	seenComponents := make(map[string]map[int]int) // Index of the match reported for component:line
+
+	for lineIdx, line := range lines {
+		for _, tagRegex := range tagRegexes {
+			for _, match := range tagRegex.FindAllStringSubmatch(line, -1) {
+				if len(match) < 2 {
+					continue
+				}
+				componentName := match[1]
+
+				if skip != nil && skip(componentName) {
+					continue
+				}
+
+				// Count repeated occurrences if we've already seen this component on this line
+				if seenComponents[componentName] == nil {
+					seenComponents[componentName] = make(map[int]int)
+				}
+				if idx, seen := seenComponents[componentName][lineIdx]; seen {
+					matches[idx].Occurrences++
+					continue
+				}
+				seenComponents[componentName][lineIdx] = len(matches)
+
+				matches = append(matches, types.ComponentMatch{
+					FilePath:      filePath,
+					Line:          baseLineNumber + lineIdx,
+					ComponentName: componentName,
+					ComponentType: "", // Will be set by scanner based on registry
+					Occurrences:   1,
+				})
+			}
+		}
+	}
+
+	return matches
+}
+
+// maskRegions blanks out every region delimited by open and close (inclusive)
+// Newlines are preserved so line numbers computed on the result stay accurate.
+// An unterminated region is masked up to the end of the content.
+func maskRegions(content string, open string, close string) string {
+	if !strings.Contains(content, open) {
+		return content
+	}
+
+	buf := []byte(content)
+	offset := 0
+	for {
+		start := strings.Index(content[offset:], open)
+		if start < 0 {
+			break
+		}
+		start += offset
+
+		end := strings.Index(content[start+len(open):], close)
+		if end < 0 {
+			end = len(content)
+		} else {
+			end += start + len(open) + len(close)
+		}
+
+		blank(buf, start, end)
+		offset = end
+	}
+
+	return string(buf)
+}
+
+// blank replaces buf[start:end] with spaces, keeping newlines intact
+func blank(buf []byte, start int, end int) {
+	for i := start; i < end && i < len(buf); i++ {
+		if buf[i] != '\n' {
+			buf[i] = ' '
+		}
+	}
+}
+
+// maskStringLiterals blanks the content of JavaScript string and template literals
+// Quotes only start a string after an operator or opening punctuation, so that
+// apostrophes in JSX text ("Don't") are left alone. Template literal
+// interpolations (${...}) are kept, as they may contain JSX.
+func maskStringLiterals(content string) string {
+	if !strings.ContainsAny(content, "'\"`") {
+		return content
+	}
+
+	buf := []byte(content)
+	for i := 0; i < len(buf); i++ {
+		switch c := content[i]; c {
+		case '\'', '"':
+			if !startsStringLiteral(content, i) {
+				continue
+			}
+			end := i + 1
+			for end < len(content) && content[end] != c && content[end] != '\n' {
+				if content[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			blank(buf, i+1, end)
+			i = end
+
+		case '`':
+			end := findTemplateLiteralEnd(content, i+1)
+			if end < 0 {
+				end = len(content)
+			}
+			copy(buf[i+1:end], maskTemplateLiteralText(content[i+1:end]))
+			i = end
+		}
+	}
+
+	return string(buf)
+}
+
+// startsStringLiteral checks if the quote at index i opens a string literal
+// The previous non-blank character must be one after which an expression may follow
+func startsStringLiteral(content string, i int) bool {
+	j := i - 1
+	for j >= 0 && (content[j] == ' ' || content[j] == '\t') {
+		j--
+	}
+	if j < 0 || content[j] == '\n' {
+		return true
+	}
+	return strings.IndexByte("=([{,:?+!&|;", content[j]) >= 0
+}
+
+// maskTemplateLiteralText blanks the static text of a template literal body, keeping interpolations
+func maskTemplateLiteralText(literal string) string {
+	buf := []byte(literal)
+	textStart := 0
+	for i := 0; i < len(literal); i++ {
+		if literal[i] == '\\' {
+			i++
+			continue
+		}
+		if literal[i] != '$' || i+1 >= len(literal) || literal[i+1] != '{' {
+			continue
+		}
+		blank(buf, textStart, i)
+		end := findInterpolationEnd(literal, i+2)
+		if end < 0 {
+			return string(buf)
+		}
+		copy(buf[i+2:end], maskStringLiterals(literal[i+2:end]))
+		textStart = end + 1
+		i = end
+	}
+	blank(buf, textStart, len(buf))
+
+	return string(buf)
+}
+
+// maskInterpolations blanks the expressions of mustache interpolations ({{ ... }})
+// Their string literals may hold tag-like text ({{ "<q-dialog>" }}), which is not markup
+// Unterminated interpolations are left untouched
+func maskInterpolations(content string) string {
+	buf := []byte(content)
+	for i := 0; i+1 < len(content); i++ {
+		if content[i] != '{' || content[i+1] != '{' {
+			continue
+		}
+		end := strings.Index(content[i+2:], "}}")
+		if end < 0 {
+			break
+		}
+		end += i + 2
+		blank(buf, i+2, end)
+		i = end + 1
+	}
+
+	return string(buf)
+}
+
+// maskAttributeValues blanks quoted attribute values inside HTML-like tags
+// Text content outside of tags is left untouched, so apostrophes in text are safe
+func maskAttributeValues(content string) string {
+	buf := []byte(content)
+	inTag := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case !inTag:
+			inTag = c == '<' && i+1 < len(content) && (isIdentStart(content[i+1]) || content[i+1] == '/')
+		case c == '>':
+			inTag = false
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(content[i+1:], c)
+			if end < 0 {
+				end = len(content)
+			} else {
+				end += i + 1
+			}
+			blank(buf, i+1, end)
+			i = end
+		}
+	}
+
+	return string(buf)
+}
@@ -0,0 +1,83 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+)
+
+// genericParamsRegex matches the start of a generic arrow function parameter list (<T extends U>)
+var genericParamsRegex = regexp.MustCompile(`^<[A-Za-z_$][\w$]*\s+extends\s`)
+
+// maskTypeArguments blanks TypeScript type argument and type parameter lists in script content
+// so the regex engine does not report type names as components. A "<" directly after an
+// identifier (useRef<Map<K, V>>, Array<Item>, function id<T>) opens type arguments, as does
+// the parameter list of a generic arrow function (<T extends Item>(x: T) => x).
+// Comparisons such as a<B without a closing ">" on the same statement are left untouched.
+func maskTypeArguments(content string) string {
+	if !strings.Contains(content, "<") {
+		return content
+	}
+
+	var buf []byte
+	for i := 0; i < len(content); i++ {
+		if content[i] != '<' {
+			continue
+		}
+		afterIdent := i > 0 && isIdentPart(content[i-1])
+		if !afterIdent && !genericParamsRegex.MatchString(content[i:]) {
+			continue
+		}
+
+		end := findTypeArgumentsEnd(content, i)
+		if end < 0 {
+			continue
+		}
+		if buf == nil {
+			buf = []byte(content)
+		}
+		blank(buf, i, end)
+		i = end - 1
+	}
+
+	if buf == nil {
+		return content
+	}
+	return string(buf)
+}
+
+// findTypeArgumentsEnd returns the offset after the ">" closing the type argument list at pos, or -1
+// Object and function types nested in the list are skipped; statement punctuation or
+// logical operators outside of them mean the "<" was a comparison
+func findTypeArgumentsEnd(content string, pos int) int {
+	depth, nested := 0, 0
+	for i := pos; i < len(content); i++ {
+		switch c := content[i]; c {
+		case '<':
+			depth++
+		case '>':
+			if content[i-1] == '=' {
+				continue // Function type: (x: T) => U
+			}
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case '(', '{', '[':
+			nested++
+		case ')', '}', ']':
+			if nested == 0 {
+				return -1
+			}
+			nested--
+		case ';':
+			if nested == 0 {
+				return -1
+			}
+		case '&', '|':
+			if nested == 0 && i+1 < len(content) && content[i+1] == c {
+				return -1
+			}
+		}
+	}
+	return -1
+}
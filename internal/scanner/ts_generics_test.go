@@ -0,0 +1,28 @@
+package scanner
+
+import "testing"
+
+func TestMaskTypeArguments(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"nested type arguments", "useRef<Map<string, number>>()", "useRef                     ()"},
+		{"object type", "useState<{ open: boolean; item?: Item }>(init)", "useState                                (init)"},
+		{"function type", "useCallback<(e: Event) => void>(fn)", "useCallback                    (fn)"},
+		{"generic arrow function", "const f = <T extends Item>(x: T) => x", "const f =                 (x: T) => x"},
+		{"comparison", "if (a<B) {", "if (a<B) {"},
+		{"comparison with logical operator", "a<B && c>d", "a<B && c>d"},
+		{"jsx element", "return <Button />", "return <Button />"},
+		{"multi-line type arguments", "Record<\n  string,\n  Item\n>", "Record \n         \n      \n "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := maskTypeArguments(tt.content); result != tt.expected {
+				t.Errorf("maskTypeArguments(%q) = %q, want %q", tt.content, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// TwigParser parses Twig templates (.twig and .html.twig files)
+// Finds custom element and Vue component tags, plus Symfony UX Twig components
+// (<twig:Alert> and {{ component('Alert') }}), while skipping Twig expressions
+type TwigParser struct {
+	htmlTags htmlTagSet // Tags skipped as plain HTML, the built-in tags when nil
+}
+
+// NewTwigParser creates a new TwigParser instance
+func NewTwigParser() *TwigParser {
+	return &TwigParser{}
+}
+
+// setHTMLTags sets the tags skipped as plain HTML
+func (p *TwigParser) setHTMLTags(tags htmlTagSet) {
+	p.htmlTags = tags
+}
+
+var (
+	// twigTagRegex matches tags, allowing the twig: namespace of Twig components
+	twigTagRegex = regexp.MustCompile(`<([A-Za-z][A-Za-z0-9-]*(?::[A-Za-z][A-Za-z0-9:_-]*)?)(?:[\s>/]|$)`)
+
+	// twigComponentFuncRegex matches the component('Name') Twig function
+	twigComponentFuncRegex = regexp.MustCompile(`\bcomponent\(\s*['"]([A-Za-z][A-Za-z0-9:_-]*)['"]`)
+)
+
+// SupportsFile checks if the file is a .twig file (including .html.twig)
+func (p *TwigParser) SupportsFile(filePath string) bool {
+	return strings.HasSuffix(strings.ToLower(filePath), ".twig")
+}
+
+// Parse extracts component matches from Twig template content
+// {# #} comments, {% %} tags and {{ }} expressions are not scanned for markup
+func (p *TwigParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	content := maskRegions(fileContent, "{#", "#}")
+
+	// component('Name') calls live inside expressions, so look for them before masking
+	var functionMatches []types.ComponentMatch
+	for lineIdx, line := range strings.Split(content, "\n") {
+		for _, match := range twigComponentFuncRegex.FindAllStringSubmatch(line, -1) {
+			functionMatches = append(functionMatches, types.ComponentMatch{
+				FilePath:      filePath,
+				Line:          lineIdx + 1,
+				ComponentName: match[1],
+				ComponentType: "", // Will be set by scanner based on registry
+			})
+		}
+	}
+
+	content = maskRegions(content, "{%", "%}")
+	content = maskRegions(content, "{{", "}}")
+
+	matches := collectTagMatches(content, filePath, 1, p.htmlTags.contains, twigTagRegex)
+	return append(matches, functionMatches...), nil
+}
@@ -0,0 +1,73 @@
+package scanner
+
+import (
+	"testing"
+)
+
+func TestTwigParser_SupportsFile(t *testing.T) {
+	parser := NewTwigParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"twig file", "templates/base.twig", true},
+		{"html twig file", "templates/user/show.html.twig", true},
+		{"uppercase extension", "templates/Show.HTML.TWIG", true},
+		{"php file", "src/Controller/UserController.php", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parser.SupportsFile(tt.filePath)
+			if result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTwigParser_Parse(t *testing.T) {
+	parser := NewTwigParser()
+
+	content := `{% extends 'base.html.twig' %}
+
+{% block body %}
+  {# <legacy-dialog></legacy-dialog> #}
+  <div id="app">
+    <q-btn label="{{ 'save'|trans }}"></q-btn>
+  </div>
+  {% if items|length < 3 %}
+    <twig:Alert type="success" message="{{ '<x-fake>' }}" />
+  {% endif %}
+  {{ component('UserCard', { user: app.user }) }}
+{% endblock %}`
+
+	matches, err := parser.Parse(content, "show.html.twig")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"q-btn", 6},
+		{"twig:Alert", 9},
+		{"UserCard", 11},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, exp.name)
+		}
+		if matches[i].Line != exp.line {
+			t.Errorf("Match %d (%s): got line %d, want %d", i, exp.name, matches[i].Line, exp.line)
+		}
+	}
+}
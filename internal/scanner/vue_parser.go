@@ -2,6 +2,7 @@ package scanner
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 
 	"ui-elf/internal/types"
@@ -9,11 +10,26 @@ import (
 
 // VueParser parses Vue.js single-file components (.vue files)
 // Extracts component usage from both template and script sections
-type VueParser struct{}
+type VueParser struct {
+	react    *ReactParser // Parses <script lang="tsx"> and <script lang="jsx"> blocks
+	htmlTags htmlTagSet   // Tags skipped as plain HTML, the built-in tags when nil
+}
 
 // NewVueParser creates a new VueParser instance
 func NewVueParser() *VueParser {
-	return &VueParser{}
+	return &VueParser{
+		react: NewReactParser(),
+	}
+}
+
+// SetEngine selects the parser engine used for JSX script blocks (EngineTokenizer or EngineRegex)
+func (p *VueParser) SetEngine(engine string) {
+	p.react.SetEngine(engine)
+}
+
+// setHTMLTags sets the tags skipped as plain HTML
+func (p *VueParser) setHTMLTags(tags htmlTagSet) {
+	p.htmlTags = tags
 }
 
 // SupportsFile checks if the file is a .vue file
@@ -25,173 +41,109 @@ func (p *VueParser) SupportsFile(filePath string) bool {
 // Handles both template syntax and JSX in script sections
 func (p *VueParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
 	var matches []types.ComponentMatch
-
-	// Extract template section
-	templateContent, templateStartLine := extractTemplateSection(fileContent)
-	if templateContent != "" {
-		templateMatches := parseTemplateComponents(templateContent, filePath, templateStartLine)
+	blocks := parseSFCBlocks(fileContent)
+
+	// Template block
+	if template, ok := findSFCBlock(blocks, "template"); ok {
+		var templateMatches []types.ComponentMatch
+		if template.lang() == "pug" {
+			templateMatches = parsePugTemplateComponents(template.content, filePath, template.startLine, p.htmlTags)
+		} else {
+			templateMatches = parseTemplateComponents(maskAttributeValues(maskInterpolations(template.content)), filePath, template.startLine, p.htmlTags)
+
+			// <component :is> tags are reported through their resolved target instead
+			if dynamicMatches := parseDynamicComponents(template.content, scriptImports(blocks), filePath, template.startLine); dynamicMatches != nil {
+				templateMatches = append(removeComponentName(templateMatches, dynamicComponentName), dynamicMatches...)
+				sort.SliceStable(templateMatches, func(i, j int) bool {
+					return templateMatches[i].Line < templateMatches[j].Line
+				})
+			}
+		}
 		matches = append(matches, templateMatches...)
 	}
 
-	// Extract script section and look for JSX
-	scriptContent, scriptStartLine := extractScriptSection(fileContent)
-	if scriptContent != "" {
-		jsxMatches := parseJSXComponents(scriptContent, filePath, scriptStartLine)
-		matches = append(matches, jsxMatches...)
+	// Script blocks (<script> and <script setup>), look for JSX
+	// Blocks declared as JSX/TSX go through the full React parsing path
+	for _, block := range blocks {
+		if block.tag != "script" {
+			continue
+		}
+		if lang := block.lang(); lang == "tsx" || lang == "jsx" {
+			matches = append(matches, p.react.parseJSX(block.content, filePath, block.startLine)...)
+		} else {
+			matches = append(matches, parseJSXComponents(block.content, filePath, block.startLine)...)
+		}
 	}
 
 	return matches, nil
 }
 
-// extractTemplateSection extracts the content within <template> tags
-// Returns the template content and the line number where the template starts
-func extractTemplateSection(content string) (string, int) {
-	// Match <template> or <template lang="..."> tags
-	templateRegex := regexp.MustCompile(`(?s)<template[^>]*>(.*?)</template>`)
-	match := templateRegex.FindStringSubmatchIndex(content)
-
-	if len(match) < 4 {
-		return "", 0
+// scriptImports collects the import bindings of all script blocks
+func scriptImports(blocks []sfcBlock) []importBinding {
+	var imports []importBinding
+	for _, block := range blocks {
+		if block.tag == "script" {
+			imports = append(imports, parseImports(block.content)...)
+		}
 	}
+	return imports
+}
 
-	// Extract the template content (first capture group)
-	templateContent := content[match[2]:match[3]]
-
-	// Calculate the starting line number
-	startLine := strings.Count(content[:match[2]], "\n") + 1
+// removeComponentName drops all matches with the given component name
+func removeComponentName(matches []types.ComponentMatch, componentName string) []types.ComponentMatch {
+	var kept []types.ComponentMatch
+	for _, match := range matches {
+		if match.ComponentName != componentName {
+			kept = append(kept, match)
+		}
+	}
+	return kept
+}
 
-	return templateContent, startLine
+// extractTemplateSection extracts the content of the top-level <template> block
+// Returns the template content and the line number where the template starts
+func extractTemplateSection(content string) (string, int) {
+	return extractBlockSection(content, "template")
 }
 
-// extractScriptSection extracts the content within <script> tags
+// extractScriptSection extracts the content of the first <script> block
 // Returns the script content and the line number where the script starts
 func extractScriptSection(content string) (string, int) {
-	// Match <script> or <script lang="..."> or <script setup> tags
-	scriptRegex := regexp.MustCompile(`(?s)<script[^>]*>(.*?)</script>`)
-	match := scriptRegex.FindStringSubmatchIndex(content)
+	return extractBlockSection(content, "script")
+}
 
-	if len(match) < 4 {
+// extractBlockSection returns the content and start line of the first block with the given tag
+func extractBlockSection(content string, tag string) (string, int) {
+	block, ok := findSFCBlock(parseSFCBlocks(content), tag)
+	if !ok {
 		return "", 0
 	}
+	return block.content, block.startLine
+}
 
-	// Extract the script content (first capture group)
-	scriptContent := content[match[2]:match[3]]
-
-	// Calculate the starting line number
-	startLine := strings.Count(content[:match[2]], "\n") + 1
+// templateTagRegex matches opening tags - <tagname followed by whitespace, >, /, or end of line
+// This handles multi-line tags where attributes span multiple lines
+var templateTagRegex = regexp.MustCompile(`<([A-Za-z][A-Za-z0-9-]*)(?:[\s>/]|$)`)
 
-	return scriptContent, startLine
-}
+// jsxTagRegex matches JSX component tags
+// JSX components must start with uppercase letter; member expressions
+// such as <Form.Item> are captured with their full dotted name
+var jsxTagRegex = regexp.MustCompile(`<([A-Z][A-Za-z0-9]*(?:\.[A-Za-z][A-Za-z0-9]*)*)(?:[\s>/]|$)`)
 
 // parseTemplateComponents extracts component usage from template content
 // Matches both self-closing and paired tags: <ComponentName /> and <ComponentName>
-func parseTemplateComponents(templateContent string, filePath string, baseLineNumber int) []types.ComponentMatch {
-	var matches []types.ComponentMatch
-
-	// Regex to match opening tags - <tagname followed by whitespace, >, /, or end of line
-	// This handles multi-line tags where attributes span multiple lines
-	componentRegex := regexp.MustCompile(`<([A-Za-z][A-Za-z0-9-]*)(?:[\s>/]|$)`)
-
-	lines := strings.Split(templateContent, "\n")
-	seenComponents := make(map[string]map[int]bool) // Track component:line to avoid duplicates
-
-	for lineIdx, line := range lines {
-		componentMatches := componentRegex.FindAllStringSubmatch(line, -1)
-
-		for _, match := range componentMatches {
-			if len(match) >= 2 {
-				componentName := match[1]
-
-				// Skip HTML tags (lowercase only, no hyphens or uppercase)
-				if isHTMLTag(componentName) {
-					continue
-				}
-
-				// Skip if we've already seen this component on this line
-				if seenComponents[componentName] == nil {
-					seenComponents[componentName] = make(map[int]bool)
-				}
-				if seenComponents[componentName][lineIdx] {
-					continue
-				}
-				seenComponents[componentName][lineIdx] = true
-
-				matches = append(matches, types.ComponentMatch{
-					FilePath:      filePath,
-					Line:          baseLineNumber + lineIdx,
-					ComponentName: componentName,
-					ComponentType: "", // Will be set by scanner based on registry
-				})
-			}
-		}
-	}
-
-	return matches
+// Tags of the HTML tag set are skipped
+func parseTemplateComponents(templateContent string, filePath string, baseLineNumber int, htmlTags htmlTagSet) []types.ComponentMatch {
+	return collectTagMatches(templateContent, filePath, baseLineNumber, htmlTags.contains, templateTagRegex)
 }
 
 // parseJSXComponents extracts component usage from JSX syntax in script sections
-// Handles JSX elements like <Component /> or <Component>
+// Handles JSX elements like <Component /> or <Component>, ignoring string literals and type arguments
 func parseJSXComponents(scriptContent string, filePath string, baseLineNumber int) []types.ComponentMatch {
-	var matches []types.ComponentMatch
-
-	// Regex to match JSX component tags
-	// JSX components must start with uppercase letter
-	componentRegex := regexp.MustCompile(`<([A-Z][A-Za-z0-9]*)(?:[\s>/]|$)`)
-
-	lines := strings.Split(scriptContent, "\n")
-	seenComponents := make(map[string]map[int]bool) // Track component:line to avoid duplicates
-
-	for lineIdx, line := range lines {
-		componentMatches := componentRegex.FindAllStringSubmatch(line, -1)
-
-		for _, match := range componentMatches {
-			if len(match) >= 2 {
-				componentName := match[1]
-
-				// Skip if we've already seen this component on this line
-				if seenComponents[componentName] == nil {
-					seenComponents[componentName] = make(map[int]bool)
-				}
-				if seenComponents[componentName][lineIdx] {
-					continue
-				}
-				seenComponents[componentName][lineIdx] = true
-
-				matches = append(matches, types.ComponentMatch{
-					FilePath:      filePath,
-					Line:          baseLineNumber + lineIdx,
-					ComponentName: componentName,
-					ComponentType: "", // Will be set by scanner based on registry
-				})
-			}
-		}
-	}
-
-	return matches
+	return collectTagMatches(maskTypeArguments(maskStringLiterals(scriptContent)), filePath, baseLineNumber, nil, jsxTagRegex)
 }
 
-// isHTMLTag checks if a tag name is a standard HTML element
-// Returns true for common HTML tags that should be ignored
+// isHTMLTag checks if a tag name is a built-in HTML element
 func isHTMLTag(tagName string) bool {
-	// Common HTML tags (lowercase only)
-	htmlTags := map[string]bool{
-		"div": true, "span": true, "p": true, "a": true, "img": true,
-		"ul": true, "ol": true, "li": true, "table": true, "tr": true,
-		"td": true, "th": true, "thead": true, "tbody": true, "tfoot": true,
-		"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
-		"header": true, "footer": true, "nav": true, "section": true, "article": true,
-		"aside": true, "main": true, "input": true, "textarea": true, "select": true,
-		"option": true, "label": true, "fieldset": true, "legend": true,
-		"strong": true, "em": true, "b": true, "i": true, "u": true,
-		"br": true, "hr": true, "pre": true, "code": true, "blockquote": true,
-		"iframe": true, "video": true, "audio": true, "canvas": true, "svg": true,
-		"path": true, "circle": true, "rect": true, "line": true, "polygon": true,
-		"template": true, "slot": true, "script": true, "style": true, "link": true,
-		"meta": true, "title": true, "head": true, "body": true, "html": true,
-		"button": true, "form": true, "dialog": true,
-	}
-
-	// Check if it's a standard HTML tag (must be all lowercase and in the map)
-	lowerTag := strings.ToLower(tagName)
-	return lowerTag == tagName && htmlTags[lowerTag]
+	return builtinHTMLTags.contains(tagName)
 }
@@ -2,6 +2,8 @@ package scanner
 
 import (
 	"testing"
+
+	"ui-elf/internal/types"
 )
 
 func TestVueParser_SupportsFile(t *testing.T) {
@@ -435,6 +437,182 @@ export default {
 	}
 }
 
+func TestVueParser_Parse_NestedTemplates(t *testing.T) {
+	parser := NewVueParser()
+
+	content := `<template>
+  <q-table :rows="rows">
+    <template v-slot:body-cell-actions="props">
+      <q-btn @click="edit(props.row)" />
+    </template>
+  </q-table>
+  <q-dialog v-model="open" />
+</template>`
+
+	matches, err := parser.Parse(content, "Table.vue")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expectedNames := []string{"q-table", "q-btn", "q-dialog"}
+	if len(matches) != len(expectedNames) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expectedNames), matches)
+	}
+	for i, expectedName := range expectedNames {
+		if matches[i].ComponentName != expectedName {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+		}
+	}
+	if matches[2].Line != 7 {
+		t.Errorf("q-dialog line = %d, want 7", matches[2].Line)
+	}
+}
+
+func TestVueParser_Parse_StringLiterals(t *testing.T) {
+	parser := NewVueParser()
+
+	content := `<template>
+  <q-btn :title="'<q-dialog> opens on click'" label="<q-menu>" />
+  <p>Don't close the <q-card /></p>
+  <span>{{ "<q-dialog>" }} {{ open ? '<q-tooltip>' : "" }}</span>
+</template>
+
+<script>
+const warning = "<Button> is deprecated"
+const render = () => <Spinner />
+</script>`
+
+	matches, err := parser.Parse(content, "Actions.vue")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expectedNames := []string{"q-btn", "q-card", "Spinner"}
+	if len(matches) != len(expectedNames) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expectedNames), matches)
+	}
+	for i, expectedName := range expectedNames {
+		if matches[i].ComponentName != expectedName {
+			t.Errorf("Match %d: got component name %q, want %q", i, matches[i].ComponentName, expectedName)
+		}
+	}
+}
+
+func TestVueParser_Parse_MultipleScriptBlocks(t *testing.T) {
+	parser := NewVueParser()
+
+	content := `<script lang="tsx">
+export const Toolbar = () => <QBtn label="Save" />
+</script>
+
+<script setup lang="tsx">
+const renderDialog = () => <QDialog />
+</script>
+
+<template>
+  <Toolbar />
+</template>`
+
+	matches, err := parser.Parse(content, "Editor.vue")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name string
+		line int
+	}{
+		{"Toolbar", 10},
+		{"QBtn", 2},
+		{"QDialog", 6},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+	for i, exp := range expected {
+		if matches[i].ComponentName != exp.name || matches[i].Line != exp.line {
+			t.Errorf("Match %d: got %s at line %d, want %s at line %d",
+				i, matches[i].ComponentName, matches[i].Line, exp.name, exp.line)
+		}
+	}
+}
+
+func TestVueParser_Parse_TSXScriptBlock(t *testing.T) {
+	parser := NewVueParser()
+
+	content := `<script setup lang="tsx">
+import { ref } from 'vue'
+// <Legacy /> is no longer rendered
+const items = ref<Array<Item>>([])
+const Cell = () => <QTd>{items.value.length}</QTd>
+</script>`
+
+	matches, err := parser.Parse(content, "Grid.vue")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(matches) != 1 {
+		t.Fatalf("Parse() returned %d matches, want 1: %+v", len(matches), matches)
+	}
+	if matches[0].ComponentName != "QTd" || matches[0].Line != 5 {
+		t.Errorf("Got %s at line %d, want QTd at line 5", matches[0].ComponentName, matches[0].Line)
+	}
+}
+
+func TestVueParser_Parse_DynamicComponents(t *testing.T) {
+	parser := NewVueParser()
+
+	content := `<template>
+  <component :is="MyDialog" v-model="open" />
+  <component is="q-btn" label="Save" />
+  <component
+    v-bind:is="'q-card'"
+  />
+  <component :is="step > 1 ? StepTwo : StepOne" />
+  <component :is="currentView" />
+  <q-input />
+</template>
+
+<script setup>
+import MyDialog from './MyDialog.vue'
+const currentView = ref('home')
+</script>`
+
+	matches, err := parser.Parse(content, "Wizard.vue")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	expected := []struct {
+		name       string
+		line       int
+		expression string
+	}{
+		{"MyDialog", 2, "MyDialog"},
+		{"q-btn", 3, "q-btn"},
+		{"q-card", 4, "'q-card'"},
+		{"component", 7, "step > 1 ? StepTwo : StepOne"},
+		{"component", 8, "currentView"},
+		{"q-input", 9, ""},
+	}
+
+	if len(matches) != len(expected) {
+		t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(expected), matches)
+	}
+	for i, exp := range expected {
+		match := matches[i]
+		if match.ComponentName != exp.name || match.Line != exp.line || match.Expression != exp.expression {
+			t.Errorf("Match %d: got %s (line %d, expression %q), want %s (line %d, expression %q)",
+				i, match.ComponentName, match.Line, match.Expression, exp.name, exp.line, exp.expression)
+		}
+		if (exp.expression != "") != (match.UsageKind == types.UsageKindDynamic) {
+			t.Errorf("Match %d: unexpected usage kind %q", i, match.UsageKind)
+		}
+	}
+}
+
 func TestExtractTemplateSection(t *testing.T) {
 	tests := []struct {
 		name              string
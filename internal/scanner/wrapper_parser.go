@@ -0,0 +1,90 @@
+package scanner
+
+import (
+	"regexp"
+	"strings"
+
+	"ui-elf/internal/types"
+)
+
+// WrapperParser detects components wrapped by styling functions and higher-order components
+// styled(Button), withTheme(Dialog), withStyles(styles)(Button), connect(mapState)(Dialog),
+// React.memo(Card) and observer(Form) indirectly use the wrapped component, which is
+// reported with usage kind "wrapper"
+type WrapperParser struct{}
+
+// NewWrapperParser creates a new WrapperParser instance
+func NewWrapperParser() *WrapperParser {
+	return &WrapperParser{}
+}
+
+var (
+	// wrapperCallRegex matches a wrapper call whose first argument is a component, capturing the component
+	wrapperCallRegex = regexp.MustCompile(`\b(?:styled|React\.memo|memo|observer|inject\([^()]*\)|connect\([^()]*\)|with[A-Z][\w$]*(?:\([^()]*\))?)\s*\(\s*([A-Z][\w$]*(?:\.[A-Za-z][\w$]*)*)\s*[,)]`)
+
+	// wrapperAliasRegex matches the variable declaration a wrapper call is assigned to
+	// The text between "=" and the wrapper call may only contain further wrapper calls
+	wrapperAliasRegex = regexp.MustCompile(`(?:const|let|var)\s+([A-Z][\w$]*)\s*(?::[^=]*)?=\s*[\w$.(\s]*$`)
+)
+
+// SupportsFile checks if the file is a script file or a Vue SFC
+func (p *WrapperParser) SupportsFile(filePath string) bool {
+	lowerPath := strings.ToLower(filePath)
+	return isPlainScriptFile(lowerPath) || strings.HasSuffix(lowerPath, ".jsx") ||
+		strings.HasSuffix(lowerPath, ".tsx") || strings.HasSuffix(lowerPath, ".vue")
+}
+
+// Parse extracts wrapped component usage from file content
+func (p *WrapperParser) Parse(fileContent string, filePath string) ([]types.ComponentMatch, error) {
+	var matches []types.ComponentMatch
+	for _, wrapper := range findWrappers(fileContent) {
+		matches = append(matches, types.ComponentMatch{
+			FilePath:      filePath,
+			Line:          wrapper.line,
+			ComponentName: wrapper.component,
+			ComponentType: "", // Will be set by scanner based on registry
+			UsageKind:     types.UsageKindWrapper,
+		})
+	}
+	return matches, nil
+}
+
+// componentWrapper is a wrapper call around a component
+type componentWrapper struct {
+	component string // Wrapped component (e.g. "Button")
+	alias     string // Variable the wrapped component is assigned to (e.g. "FancyButton"), if any
+	line      int
+}
+
+// findWrappers finds all wrapper calls around components in content
+func findWrappers(content string) []componentWrapper {
+	if !strings.Contains(content, "(") {
+		return nil
+	}
+
+	var wrappers []componentWrapper
+	for _, loc := range wrapperCallRegex.FindAllStringSubmatchIndex(content, -1) {
+		lineStart := strings.LastIndexByte(content[:loc[0]], '\n') + 1
+
+		wrapper := componentWrapper{
+			component: content[loc[2]:loc[3]],
+			line:      strings.Count(content[:loc[0]], "\n") + 1,
+		}
+		if alias := wrapperAliasRegex.FindStringSubmatch(content[lineStart:loc[0]]); alias != nil {
+			wrapper.alias = alias[1]
+		}
+		wrappers = append(wrappers, wrapper)
+	}
+	return wrappers
+}
+
+// wrapperAliases maps the aliases of wrapped components to the component they wrap
+func wrapperAliases(content string) map[string]string {
+	aliases := make(map[string]string)
+	for _, wrapper := range findWrappers(content) {
+		if wrapper.alias != "" {
+			aliases[wrapper.alias] = wrapper.component
+		}
+	}
+	return aliases
+}
@@ -0,0 +1,129 @@
+package scanner
+
+import (
+	"testing"
+
+	"ui-elf/internal/types"
+)
+
+func TestWrapperParser_SupportsFile(t *testing.T) {
+	parser := NewWrapperParser()
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected bool
+	}{
+		{"tsx file", "src/Button.tsx", true},
+		{"jsx file", "src/Button.jsx", true},
+		{"ts file", "src/styles.ts", true},
+		{"js file", "src/styles.js", true},
+		{"vue file", "src/App.vue", true},
+		{"html file", "index.html", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := parser.SupportsFile(tt.filePath); result != tt.expected {
+				t.Errorf("SupportsFile(%q) = %v, want %v", tt.filePath, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWrapperParser_Parse(t *testing.T) {
+	parser := NewWrapperParser()
+
+	type wrapperMatch struct {
+		name string
+		line int
+	}
+
+	tests := []struct {
+		name     string
+		content  string
+		expected []wrapperMatch
+	}{
+		{
+			name: "styled components",
+			content: "import styled from 'styled-components';\n" +
+				"const FancyButton = styled(Button)`\n  color: red;\n`;\n" +
+				"const Wide = styled(Dialog).attrs({ fullWidth: true })``;\n" +
+				"const Title = styled.h1`font-size: 2em;`;",
+			expected: []wrapperMatch{
+				{"Button", 2},
+				{"Dialog", 5},
+			},
+		},
+		{
+			name: "higher-order components",
+			content: `export default withTheme(Dialog);
+const StyledForm = withStyles(styles)(Form);
+export const Connected = withRouter(connect(mapState)(Modal));
+const Memo = React.memo(Card);
+const Observed = observer(Table);`,
+			expected: []wrapperMatch{
+				{"Dialog", 1},
+				{"Form", 2},
+				{"Modal", 3},
+				{"Card", 4},
+				{"Table", 5},
+			},
+		},
+		{
+			name:    "member expressions",
+			content: `const Item = styled(Form.Item)({ margin: 0 });`,
+			expected: []wrapperMatch{
+				{"Form.Item", 1},
+			},
+		},
+		{
+			name:     "calls with lowercase arguments are ignored",
+			content:  `const value = withDefault(options); memo(fn);`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := parser.Parse(tt.content, "test.tsx")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if len(matches) != len(tt.expected) {
+				t.Fatalf("Parse() returned %d matches, want %d: %+v", len(matches), len(tt.expected), matches)
+			}
+			for i, exp := range tt.expected {
+				if matches[i].ComponentName != exp.name || matches[i].Line != exp.line {
+					t.Errorf("match %d = %s:%d, want %s:%d", i, matches[i].ComponentName, matches[i].Line, exp.name, exp.line)
+				}
+				if matches[i].UsageKind != types.UsageKindWrapper {
+					t.Errorf("match %d UsageKind = %q, want %q", i, matches[i].UsageKind, types.UsageKindWrapper)
+				}
+			}
+		})
+	}
+}
+
+func TestWrapperAliases(t *testing.T) {
+	content := `const FancyButton = styled(Button)` + "`color: red;`" + `;
+export const ThemedDialog: React.FC<Props> = withTheme(Dialog);
+const Connected = withRouter(connect(mapState)(Modal));
+export default withTheme(Card);`
+
+	expected := map[string]string{
+		"FancyButton":  "Button",
+		"ThemedDialog": "Dialog",
+		"Connected":    "Modal",
+	}
+
+	aliases := wrapperAliases(content)
+	if len(aliases) != len(expected) {
+		t.Fatalf("wrapperAliases() = %v, want %v", aliases, expected)
+	}
+	for alias, component := range expected {
+		if aliases[alias] != component {
+			t.Errorf("wrapperAliases()[%q] = %q, want %q", alias, aliases[alias], component)
+		}
+	}
+}
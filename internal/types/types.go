@@ -1,29 +1,243 @@
 // Package types defines the data structures used throughout the application.
 package types
 
+import "time"
+
 // ComponentMatch represents a single component found in the codebase
 type ComponentMatch struct {
-	FilePath      string `json:"filePath"`      // Relative path to the file
-	Line          int    `json:"line"`          // Line number where component appears
-	ComponentName string `json:"componentName"` // Actual component name (e.g., "q-form")
-	ComponentType string `json:"componentType"` // Normalized type (e.g., "form")
+	FilePath        string            `json:"filePath"`                  // Relative path to the file
+	Line            int               `json:"line"`                      // Line number where component appears
+	ComponentName   string            `json:"componentName"`             // Actual component name (e.g., "q-form")
+	CanonicalName   string            `json:"canonicalName,omitempty"`   // PascalCase form of the name shared by all casings (e.g., "QForm")
+	ComponentType   string            `json:"componentType"`             // Normalized type (e.g., "form")
+	Story           bool              `json:"story,omitempty"`           // True if the match is inside a Storybook stories file
+	Test            bool              `json:"test,omitempty"`            // True if the match is inside a test file (scanned with --include-tests)
+	Docs            bool              `json:"docs,omitempty"`            // True if the match is inside a Markdown code block
+	UsageKind       string            `json:"usageKind,omitempty"`       // How the component appears; empty for regular tag usage
+	Namespace       string            `json:"namespace,omitempty"`       // Object of a member-expression component (e.g. "Form" for "Form.Item")
+	Expression      string            `json:"expression,omitempty"`      // Bound expression of a dynamic component (e.g. <component :is="...">)
+	ImportPath      string            `json:"importPath,omitempty"`      // Module path of a lazily loaded component (e.g. "./Dialog")
+	ResolvedName    string            `json:"resolvedName,omitempty"`    // Original exported name of an aliased import (e.g. "Button" for PrimaryBtn)
+	Library         string            `json:"library,omitempty"`         // Module the component is imported from (e.g. "@mui/material")
+	RegistryLibrary string            `json:"registryLibrary,omitempty"` // Registry library whose pattern matched (e.g. "quasar"); empty if ambiguous
+	Props           map[string]string `json:"props,omitempty"`           // Attributes of the component tag, when requested (--with-props)
+	Snippet         string            `json:"snippet,omitempty"`         // Source line(s) of the match, when requested (--snippet, --context)
+	Occurrences     int               `json:"occurrences,omitempty"`     // Times the component appears on the line, when requested (--count-duplicates)
+	Fingerprint     string            `json:"fingerprint,omitempty"`     // Stable identifier of the usage across line shifts and edits around it (hash of the repo-root relative path, component, usage kind and trimmed line)
+	Registered      bool              `json:"registered,omitempty"`      // True if the component is imported or registered (Vue components option) in the file
+	Replacement     string            `json:"replacement,omitempty"`     // Suggested replacement of a deprecated component (e.g. "AppModal" for OldDialog)
+	Rule            *MatchRule        `json:"rule,omitempty"`            // Registry rule that matched the component, when requested (--explain)
+}
+
+// MatchRule is the registry rule matching a component: a pattern or API call of a library for a type
+// Types matched without patterns (custom, deprecated) have no library
+type MatchRule struct {
+	Type    string `json:"type"`              // Component type of the rule (e.g. "button")
+	Library string `json:"library,omitempty"` // Library listing the pattern, as in the registry (e.g. "quasar", "vuetify@2")
+	Pattern string `json:"pattern,omitempty"` // Pattern or API call that matched (e.g. "q-btn")
+}
+
+// RuleCount is the number of component usages matched by a registry rule
+type RuleCount struct {
+	MatchRule
+	Count int `json:"count"`
+}
+
+// RootCount is the number of component usages and files scanned in a scan root
+type RootCount struct {
+	Root         string `json:"root"`
+	ScannedFiles int    `json:"scannedFiles"`
+	Count        int    `json:"count"`
+}
+
+// DirectoryCount is the number of component usages and files with matches in a directory
+type DirectoryCount struct {
+	Directory string `json:"directory"`
+	Count     int    `json:"count"`
+	Files     int    `json:"files"`
+}
+
+// RankedCount is the number of component usages of a component or file, with its position in a ranking
+type RankedCount struct {
+	Rank  int    `json:"rank"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// TopUsage ranks the most used components and the files with the most usages
+type TopUsage struct {
+	Components []RankedCount `json:"components"` // Canonical component names with the most usages, most used first
+	Files      []RankedCount `json:"files"`      // Files with the most usages, most used first
+}
+
+// ScanSummary holds the aggregate statistics of a scan, reported instead of the matches with --summary
+type ScanSummary struct {
+	UniqueComponents int              `json:"uniqueComponents"` // Distinct canonical component names found
+	FilesAffected    int              `json:"filesAffected"`    // Files with at least one match
+	TopDirectories   []DirectoryCount `json:"topDirectories"`   // Directories with the most usages, most used first
+}
+
+// MatchGroup is a group of matches sharing a file, component, directory or library
+type MatchGroup struct {
+	Key     string           `json:"key"`
+	Count   int              `json:"count"`
+	Matches []ComponentMatch `json:"matches"`
 }
 
+// ComponentTypeCustom matches every component imported or registered in the scanned files
+const ComponentTypeCustom = "custom"
+
+// ComponentTypeDeprecated matches every component marked deprecated in the registry
+const ComponentTypeDeprecated = "deprecated"
+
+// ComponentTypeAll matches every component found, for a full inventory; HTML elements are skipped
+const ComponentTypeAll = "all"
+
+// Usage kinds describing how a component appears in the code
+const (
+	UsageKindDefinition = "definition" // Component declaration (e.g. customElements.define)
+	UsageKindDirective  = "directive"  // Component attached through a directive (e.g. Alpine x-data)
+	UsageKindDynamic    = "dynamic"    // Component rendered dynamically (e.g. Vue <component :is>)
+	UsageKindLazy       = "lazy"       // Component loaded lazily (e.g. React.lazy, defineAsyncComponent)
+	UsageKindImperative = "imperative" // Component created through an API call (e.g. Modal.confirm)
+	UsageKindWrapper    = "wrapper"    // Component wrapped by a styling function or HOC (e.g. styled(Button))
+	UsageKindSVG        = "svg"        // SVG primitive, when classified (e.g. <linearGradient>, react-native-svg <ClipPath>)
+)
+
+// SchemaVersion is the version of the JSON scan result format, written as its schemaVersion
+// The minor version grows with added fields, the major version with changes breaking readers
+const SchemaVersion = "1.0"
+
 // ScanResult contains aggregated results from scanning the codebase
 type ScanResult struct {
-	Matches       []ComponentMatch `json:"matches"`
-	TotalCount    int              `json:"totalCount"`
-	ScanTimeMs    int64            `json:"scanTimeMs"`
-	ComponentType string           `json:"componentType"`
-	ScannedFiles  int              `json:"scannedFiles"`
+	SchemaVersion   string           `json:"schemaVersion"` // Version of the result format (SchemaVersion); empty in results written before versioning
+	Matches         []ComponentMatch `json:"matches"`
+	TotalCount      int              `json:"totalCount"`
+	ScanTimeMs      int64            `json:"scanTimeMs"`
+	ComponentType   string           `json:"componentType"`
+	ScannedFiles    int              `json:"scannedFiles"`
+	ComponentCounts map[string]int   `json:"componentCounts,omitempty"` // Usages per canonical component name (q-btn and QBtn count as QBtn), aliases counted under the component they resolve to
+	TypeCounts      map[string]int   `json:"typeCounts,omitempty"`      // Usages per member type, when scanning a type group
+	MatchMode       string           `json:"matchMode,omitempty"`       // How component names were compared to the patterns (exact, prefix, fuzzy)
+	RuleCounts      []RuleCount      `json:"ruleCounts,omitempty"`      // Usages per registry rule, most used first, when requested (--explain)
+	RootCounts      []RootCount      `json:"rootCounts,omitempty"`      // Usages and files per scan root, when scanning several roots
+	GroupBy         string           `json:"groupBy,omitempty"`         // Key of the groups (file, component, directory, library), when grouped
+	Groups          []MatchGroup     `json:"groups,omitempty"`          // Matches grouped by GroupBy, replacing the match list in JSON output
+	DirectoryCounts []DirectoryCount `json:"directoryCounts,omitempty"` // Usages and files per directory, most used first, when requested (--by-directory)
+	Summary         *ScanSummary     `json:"summary,omitempty"`         // Aggregate statistics replacing the match list in output, when requested (--summary)
+	Top             *TopUsage        `json:"top,omitempty"`             // Most used components and files with the most usages, when requested (--top)
+	Warnings        []string         `json:"warnings,omitempty"`        // Problems found while scanning (e.g. imports of packages not installed)
+}
+
+// ComponentDelta is the change of the usages of a component between two scan results
+type ComponentDelta struct {
+	Component string `json:"component,omitempty"`
+	Before    int    `json:"before"`
+	After     int    `json:"after"`
+	Delta     int    `json:"delta"`
+}
+
+// ResultDiff holds the differences between two scan results, from Before to After
+type ResultDiff struct {
+	Before     string           `json:"before"`     // Source of the earlier result (e.g. its file path)
+	After      string           `json:"after"`      // Source of the later result
+	TotalCount ComponentDelta   `json:"totalCount"` // Change of the total count; Component is empty
+	Added      []ComponentMatch `json:"added"`      // Matches of After missing from Before
+	Removed    []ComponentMatch `json:"removed"`    // Matches of Before missing from After
+	Components []ComponentDelta `json:"components"` // Components whose usages changed, largest change first
+}
+
+// HistoryEntry is the summary of a scan recorded in a history file, one JSON line per scan
+type HistoryEntry struct {
+	Time            time.Time      `json:"time"`
+	Commit          string         `json:"commit,omitempty"` // SHA of the git commit scanned, if in a repository
+	ComponentType   string         `json:"componentType"`
+	TotalCount      int            `json:"totalCount"`
+	ScannedFiles    int            `json:"scannedFiles"`
+	ComponentCounts map[string]int `json:"componentCounts,omitempty"`
 }
 
 // CLIOptions holds parsed command-line arguments
 type CLIOptions struct {
-	ComponentType string
-	Directory     string
-	Filter        []string
-	OutputFormat  string // "terminal", "json", or "both"
+	ComponentType    string
+	ComponentNames   []string // Components searched with the custom type (e.g. MyWidget)
+	Directory        string
+	Directories      []string // Scan roots given as arguments; Directory is the first
+	Filter           []string
+	Exclude          []string            // Additional patterns of paths not scanned
+	MaxDepth         int                 // Directory levels scanned below each root; 0 for no limit
+	Concurrency      int                 // Files parsed in parallel
+	PathStyle        string              // "relative", "absolute" or "repo-root" file paths of matches
+	NoIgnore         bool                // Scan the files ignored by .gitignore and .ui-elfignore files
+	OutputFormat     string              // "terminal", "json", "both", "stdout", "xml" or "xlsx"
+	OutputFile       string              // JSON, XML or XLSX output path, "-" for stdout; the default file if empty
+	Compress         bool                // Gzip the JSON or XML output file
+	Sort             string              // Order of the matches: "path", "line", "component" or "count"
+	Desc             bool                // Sort in descending order
+	GroupBy          string              // Group matches by "file", "component", "directory" or "library"; flat list if empty
+	Layout           string              // Terminal layout of the matches: "flat" or "file"
+	Hyperlinks       string              // When terminal output links matches to their files: "auto", "always" or "never"
+	LinkTemplate     string              // URL template of the hyperlinks, with {path}, {relpath} and {line}
+	Summary          bool                // Report aggregate statistics only, without the matches
+	ByDirectory      bool                // Count the usages per directory
+	DirectoryDepth   int                 // Path segments the directories are rolled up to; 0 for the full directory
+	Top              int                 // Components and files ranked by usage; 0 for no ranking
+	ExcludeStories   bool                // Skip Storybook *.stories.* files
+	IncludeTests     bool                // Scan test files, excluded by default
+	IncludeMarkdown  bool                // Scan fenced code blocks in .md files
+	Profile          string              // "web" or "react-native"
+	IncludeAlpine    bool                // Scan HTML for Alpine.js x-data/x-component widgets
+	ParserEngine     string              // "tokenizer" or "regex" JSX parsing
+	WithProps        bool                // Capture the attributes of matched component tags
+	Snippet          bool                // Include the source line of each match
+	ContextLines     int                 // Lines of context around the snippet
+	IncludeGenerated bool                // Scan minified and generated files as well
+	ConfigFile       string              // Path of the configuration file; looked up in Directory if empty
+	CountDuplicates  bool                // Count repeated components on the same line
+	Registries       []string            // Registry sources (file paths, URLs, cmd: commands) merged before the registry file of Directory
+	AllLibraries     bool                // Match all registry libraries instead of those installed per package.json
+	Mappings         map[string][]string // Additional component names per type, given with --map
+	Libraries        []string            // Only match the components of these registry libraries
+	MatchMode        string              // "exact", "prefix" or "fuzzy" comparison of component names to patterns
+	Explain          bool                // Annotate matches with the registry rule that matched them
+	Extensions       []string            // --ext values: scanned file extensions, "+" extensions and ext=ext aliases
+	FailIfFound      bool                // Fail with the threshold exit code if any component is found
+	MaxCount         int                 // Most components found without failing; -1 for no limit
+	MinCount         int                 // Fewest components found without failing
+	Badge            string              // Path of the shields.io endpoint badge JSON written; none if empty
+	BadgeLabel       string              // Label of the badge; "<type> components" if empty
+	Stdin            bool                // Scan the newline-separated files read from stdin instead of discovering files
+	Stdin0           bool                // Scan the NUL-separated files read from stdin instead of discovering files
+	ListFiles        bool                // Print the discovered and skipped files instead of scanning
+	Verbose          bool                // Log per-file progress and skipped files
+	Quiet            bool                // Print only the results
+	LogFormat        string              // "text" or "json" logs on stderr
+}
+
+// ScanOptions holds optional scanner behavior
+type ScanOptions struct {
+	WithProps        bool              // Capture the attributes of matched component tags
+	Snippet          bool              // Include the source line of each match
+	ContextLines     int               // Lines of context before and after the snippet line
+	IncludeGenerated bool              // Scan minified and generated files, which are skipped by default
+	CountDuplicates  bool              // Report how often a component appears on its line and count every occurrence
+	Explain          bool              // Record the registry rule matching each component
+	TagPolicy        TagPolicy         // Configured HTML tags and custom element allow/deny lists
+	ExtensionAliases map[string]string // Extensions parsed by the parsers of another extension (e.g. .svelte -> .vue)
+	Concurrency      int               // Files parsed in parallel; 0 for the number of CPUs
+	PathStyle        string            // Style of the file paths of matches: relative, absolute, repo-root, or "" as discovered
+	RepoRoot         string            // Base of the repo-root path style
+	ComponentNames   []string          // Components matched by the custom type, by name, instead of every imported component
+}
+
+// TagPolicy controls which tags are reported as components
+// Custom element patterns are globs matched against hyphenated tag names (e.g. "font-awesome-*")
+type TagPolicy struct {
+	HTMLTags            []string // Tag names treated as plain HTML in addition to the built-in list; "!tag" removes one
+	ReplaceHTMLTags     bool     // HTMLTags replace the built-in list instead of extending it
+	AllowCustomElements []string // When set, only matching custom elements are reported
+	DenyCustomElements  []string // Custom elements never reported
+	SVG                 string   // Handling of SVG primitives: "exclude" (default) or "classify"
 }
 
 // FileFilter defines criteria for filtering files during discovery
@@ -31,4 +245,6 @@ type FileFilter struct {
 	ExcludePatterns    []string
 	IncludeDirectories []string
 	FileExtensions     []string
+	IgnoreFiles        []string // Names of gitignore-style files honored during the walk (e.g. .gitignore)
+	MaxDepth           int      // Directory levels walked, 1 for the files of the root only; 0 for no limit
 }